@@ -2,9 +2,9 @@ package erc721
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math/big"
-	"sync/atomic"
 	"time"
 
 	"github.com/chenzhijie/go-web3"
@@ -199,49 +199,7 @@ func (e *ERC721) SyncSendEIP1559Tx(
 		return nil, err
 	}
 
-	type ReceiptCh struct {
-		ret *eTypes.Receipt
-		err error
-	}
-
-	var timeoutFlag int32
-	ch := make(chan *ReceiptCh, 1)
-
-	go func() {
-		for {
-			receipt, err := e.w3.Eth.GetTransactionReceipt(hash)
-			if err != nil && err.Error() != "not found" {
-				ch <- &ReceiptCh{
-					err: err,
-				}
-				break
-			}
-			if receipt != nil {
-				ch <- &ReceiptCh{
-					ret: receipt,
-					err: nil,
-				}
-				break
-			}
-			if atomic.LoadInt32(&timeoutFlag) == 1 {
-				break
-			}
-		}
-		// fmt.Println("send tx done")
-	}()
-
-	select {
-	case result := <-ch:
-		if result.err != nil {
-			return nil, err
-		}
-
-		return result.ret, nil
-	case <-time.After(time.Duration(e.txPollTimeout) * time.Second):
-		atomic.StoreInt32(&timeoutFlag, 1)
-		return nil, fmt.Errorf("transaction was not mined within %v seconds, "+
-			"please make sure your transaction was properly sent. Be aware that it might still be mined!", e.txPollTimeout)
-	}
+	return e.waitMined(hash)
 }
 
 func (e *ERC721) SyncSendRawTransactionForTx(
@@ -256,49 +214,7 @@ func (e *ERC721) SyncSendRawTransactionForTx(
 		return nil, err
 	}
 
-	type ReceiptCh struct {
-		ret *eTypes.Receipt
-		err error
-	}
-
-	var timeoutFlag int32
-	ch := make(chan *ReceiptCh, 1)
-
-	go func() {
-		for {
-			receipt, err := e.w3.Eth.GetTransactionReceipt(hash)
-			if err != nil && err.Error() != "not found" {
-				ch <- &ReceiptCh{
-					err: err,
-				}
-				break
-			}
-			if receipt != nil {
-				ch <- &ReceiptCh{
-					ret: receipt,
-					err: nil,
-				}
-				break
-			}
-			if atomic.LoadInt32(&timeoutFlag) == 1 {
-				break
-			}
-		}
-		// fmt.Println("send tx done")
-	}()
-
-	select {
-	case result := <-ch:
-		if result.err != nil {
-			return nil, err
-		}
-
-		return result.ret, nil
-	case <-time.After(time.Duration(e.txPollTimeout) * time.Second):
-		atomic.StoreInt32(&timeoutFlag, 1)
-		return nil, fmt.Errorf("transaction was not mined within %v seconds, "+
-			"please make sure your transaction was properly sent. Be aware that it might still be mined!", e.txPollTimeout)
-	}
+	return e.waitMined(hash)
 }
 
 func (e *ERC721) invokeAndWait(code []byte, gasPrice, gasTipCap, gasFeeCap *big.Int) (common.Hash, error) {
@@ -307,6 +223,19 @@ func (e *ERC721) invokeAndWait(code []byte, gasPrice, gasTipCap, gasFeeCap *big.
 		return common.Hash{}, err
 	}
 
+	// no fees given, ask the gas oracle and send a legacy tx on chains without EIP-1559
+	if gasPrice == nil && gasTipCap == nil && gasFeeCap == nil {
+		fee, err := e.w3.Eth.SuggestFee()
+		if err != nil {
+			return common.Hash{}, err
+		}
+		if fee.IsLegacy() {
+			gasPrice = fee.GasPrice
+		} else {
+			gasTipCap, gasFeeCap = fee.MaxPriorityFeePerGas, fee.MaxFeePerGas
+		}
+	}
+
 	var tx *eTypes.Receipt
 	if gasPrice != nil {
 		tx, err = e.SyncSendRawTransactionForTx(gasPrice, gasLimit, e.contr.Address(), code, nil)
@@ -318,13 +247,18 @@ func (e *ERC721) invokeAndWait(code []byte, gasPrice, gasTipCap, gasFeeCap *big.
 		return common.Hash{}, err
 	}
 
-	if e.confirmation == 0 {
-		return tx.TxHash, nil
-	}
+	return tx.TxHash, nil
+}
 
-	if err := e.WaitBlock(uint64(e.confirmation)); err != nil {
-		return common.Hash{}, err
-	}
+// waitMined waits for the tx receipt with the configured confirmations and poll timeout
+func (e *ERC721) waitMined(hash common.Hash) (*eTypes.Receipt, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(e.txPollTimeout)*time.Second)
+	defer cancel()
 
-	return tx.TxHash, nil
+	receipt, err := e.w3.Eth.WaitMined(ctx, hash, uint64(e.confirmation))
+	if err == context.DeadlineExceeded {
+		return nil, fmt.Errorf("transaction was not mined within %v seconds, "+
+			"please make sure your transaction was properly sent. Be aware that it might still be mined!", e.txPollTimeout)
+	}
+	return receipt, err
 }
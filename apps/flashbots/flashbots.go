@@ -1,7 +1,6 @@
 package flashbots
 
 import (
-	"crypto/ecdsa"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -11,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/chenzhijie/go-web3/eth"
 	"github.com/chenzhijie/go-web3/rpc/transport"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	eTypes "github.com/ethereum/go-ethereum/core/types"
@@ -19,9 +19,9 @@ import (
 )
 
 type FlashBot struct {
-	httpClient       *transport.HTTP
-	providerURL      string
-	signerPrivateKey *ecdsa.PrivateKey
+	httpClient  *transport.HTTP
+	providerURL string
+	signer      eth.Signer
 }
 
 // NewFlashBot, init flashbot instance
@@ -39,13 +39,17 @@ func NewFlashBot(providerURL, rpcRequestSigner string) (*FlashBot, error) {
 		return nil, err
 	}
 
+	return NewFlashBotWithSigner(providerURL, eth.NewLocalSigner(signerPrivateKey)), nil
+}
+
+// NewFlashBotWithSigner, init flashbot instance with a signer for signing request message
+func NewFlashBotWithSigner(providerURL string, signer eth.Signer) *FlashBot {
 	httpClient := transport.NewHTTP(providerURL, os.Getenv("http_proxy"))
-	fb := &FlashBot{
-		httpClient:       httpClient,
-		providerURL:      providerURL,
-		signerPrivateKey: signerPrivateKey,
+	return &FlashBot{
+		httpClient:  httpClient,
+		providerURL: providerURL,
+		signer:      signer,
 	}
-	return fb, nil
 }
 
 func (fb *FlashBot) SendBundle(txs []*eTypes.Transaction, targetBlockNumber *big.Int) (*BundleResult, error) {
@@ -280,14 +284,13 @@ func (fb *FlashBot) sendRequest(relay string, method string, params []interface{
 func (fb *FlashBot) flashbotHeader(payload []byte) (string, error) {
 
 	hashedPayload := crypto.Keccak256Hash(payload).Hex()
-	signature, err := crypto.Sign(
-		crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n"+strconv.Itoa(len(hashedPayload))+hashedPayload)),
-		fb.signerPrivateKey,
+	signature, err := fb.signer.SignHash(
+		crypto.Keccak256([]byte("\x19Ethereum Signed Message:\n" + strconv.Itoa(len(hashedPayload)) + hashedPayload)),
 	)
 	if err != nil {
 		return "", err
 	}
 
-	return crypto.PubkeyToAddress(fb.signerPrivateKey.PublicKey).Hex() +
+	return fb.signer.Address().Hex() +
 		":" + hexutil.Encode(signature), nil
 }
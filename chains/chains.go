@@ -0,0 +1,109 @@
+// Package chains is a registry of well known EVM chains and their metadata
+package chains
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NativeCurrency is the currency gas is paid with
+type NativeCurrency struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// Chain describes an EVM network
+type Chain struct {
+	ChainId        int64
+	Name           string
+	NativeCurrency NativeCurrency
+	// London reports whether the chain supports EIP-1559 dynamic fee transactions
+	London   bool
+	Testnet  bool
+	Explorer string
+}
+
+// TxURL returns the explorer URL of a transaction, empty if the chain has no explorer
+func (c Chain) TxURL(txHash string) string {
+	if c.Explorer == "" {
+		return ""
+	}
+	return strings.TrimSuffix(c.Explorer, "/") + "/tx/" + txHash
+}
+
+// AddressURL returns the explorer URL of an account, empty if the chain has no explorer
+func (c Chain) AddressURL(address string) string {
+	if c.Explorer == "" {
+		return ""
+	}
+	return strings.TrimSuffix(c.Explorer, "/") + "/address/" + address
+}
+
+var ether = NativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18}
+
+var (
+	Mainnet         = Chain{ChainId: 1, Name: "Ethereum Mainnet", NativeCurrency: ether, London: true, Explorer: "https://etherscan.io"}
+	Goerli          = Chain{ChainId: 5, Name: "Goerli", NativeCurrency: NativeCurrency{"Goerli Ether", "ETH", 18}, London: true, Testnet: true, Explorer: "https://goerli.etherscan.io"}
+	Sepolia         = Chain{ChainId: 11155111, Name: "Sepolia", NativeCurrency: NativeCurrency{"Sepolia Ether", "ETH", 18}, London: true, Testnet: true, Explorer: "https://sepolia.etherscan.io"}
+	Holesky         = Chain{ChainId: 17000, Name: "Holesky", NativeCurrency: NativeCurrency{"Holesky Ether", "ETH", 18}, London: true, Testnet: true, Explorer: "https://holesky.etherscan.io"}
+	EthereumClassic = Chain{ChainId: 61, Name: "Ethereum Classic", NativeCurrency: NativeCurrency{"Ether Classic", "ETC", 18}, London: false, Explorer: "https://etc.blockscout.com"}
+	BSC             = Chain{ChainId: 56, Name: "BNB Smart Chain", NativeCurrency: NativeCurrency{"BNB", "BNB", 18}, London: true, Explorer: "https://bscscan.com"}
+	BSCTestnet      = Chain{ChainId: 97, Name: "BNB Smart Chain Testnet", NativeCurrency: NativeCurrency{"Test BNB", "tBNB", 18}, London: true, Testnet: true, Explorer: "https://testnet.bscscan.com"}
+	Polygon         = Chain{ChainId: 137, Name: "Polygon", NativeCurrency: NativeCurrency{"POL", "POL", 18}, London: true, Explorer: "https://polygonscan.com"}
+	PolygonAmoy     = Chain{ChainId: 80002, Name: "Polygon Amoy", NativeCurrency: NativeCurrency{"POL", "POL", 18}, London: true, Testnet: true, Explorer: "https://amoy.polygonscan.com"}
+	Arbitrum        = Chain{ChainId: 42161, Name: "Arbitrum One", NativeCurrency: ether, London: true, Explorer: "https://arbiscan.io"}
+	ArbitrumSepolia = Chain{ChainId: 421614, Name: "Arbitrum Sepolia", NativeCurrency: ether, London: true, Testnet: true, Explorer: "https://sepolia.arbiscan.io"}
+	Optimism        = Chain{ChainId: 10, Name: "OP Mainnet", NativeCurrency: ether, London: true, Explorer: "https://optimistic.etherscan.io"}
+	OptimismSepolia = Chain{ChainId: 11155420, Name: "OP Sepolia", NativeCurrency: ether, London: true, Testnet: true, Explorer: "https://sepolia-optimism.etherscan.io"}
+	Base            = Chain{ChainId: 8453, Name: "Base", NativeCurrency: ether, London: true, Explorer: "https://basescan.org"}
+	BaseSepolia     = Chain{ChainId: 84532, Name: "Base Sepolia", NativeCurrency: ether, London: true, Testnet: true, Explorer: "https://sepolia.basescan.org"}
+	Avalanche       = Chain{ChainId: 43114, Name: "Avalanche C-Chain", NativeCurrency: NativeCurrency{"Avalanche", "AVAX", 18}, London: true, Explorer: "https://snowtrace.io"}
+	Gnosis          = Chain{ChainId: 100, Name: "Gnosis", NativeCurrency: NativeCurrency{"xDAI", "xDAI", 18}, London: true, Explorer: "https://gnosisscan.io"}
+	Linea           = Chain{ChainId: 59144, Name: "Linea", NativeCurrency: ether, London: true, Explorer: "https://lineascan.build"}
+	Fantom          = Chain{ChainId: 250, Name: "Fantom Opera", NativeCurrency: NativeCurrency{"Fantom", "FTM", 18}, London: true, Explorer: "https://ftmscan.com"}
+)
+
+var (
+	mu       sync.RWMutex
+	registry = map[int64]Chain{}
+)
+
+func init() {
+	for _, c := range []Chain{
+		Mainnet, Goerli, Sepolia, Holesky, EthereumClassic,
+		BSC, BSCTestnet, Polygon, PolygonAmoy,
+		Arbitrum, ArbitrumSepolia, Optimism, OptimismSepolia, Base, BaseSepolia,
+		Avalanche, Gnosis, Linea, Fantom,
+	} {
+		Register(c)
+	}
+}
+
+// Register adds or replaces a chain in the registry
+func Register(c Chain) {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[c.ChainId] = c
+}
+
+// Get returns the registered chain with chainId
+func Get(chainId int64) (Chain, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	c, ok := registry[chainId]
+	return c, ok
+}
+
+// All returns the registered chains sorted by chain id
+func All() []Chain {
+	mu.RLock()
+	defer mu.RUnlock()
+	all := make([]Chain, 0, len(registry))
+	for _, c := range registry {
+		all = append(all, c)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ChainId < all[j].ChainId })
+	return all
+}
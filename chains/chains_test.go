@@ -0,0 +1,25 @@
+package chains
+
+import "testing"
+
+func TestRegistry(t *testing.T) {
+	c, ok := Get(8453)
+	if !ok || c.Name != "Base" || !c.London {
+		t.Fatalf("unexpected chain %+v", c)
+	}
+	if url := c.TxURL("0x01"); url != "https://basescan.org/tx/0x01" {
+		t.Fatalf("unexpected tx url %s", url)
+	}
+
+	Register(Chain{ChainId: 31337, Name: "Hardhat", NativeCurrency: ether, London: true})
+	if c, ok := Get(31337); !ok || c.Name != "Hardhat" || c.AddressURL("0x01") != "" {
+		t.Fatalf("unexpected chain %+v", c)
+	}
+
+	all := All()
+	for i := 1; i < len(all); i++ {
+		if all[i-1].ChainId >= all[i].ChainId {
+			t.Fatal("chains are not sorted")
+		}
+	}
+}
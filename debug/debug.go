@@ -0,0 +1,129 @@
+package debug
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/chenzhijie/go-web3/rpc"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/chenzhijie/go-web3/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Debug is the debug namespace
+type Debug struct {
+	c *rpc.Client
+}
+
+// Create a debug instance
+func NewDebug(c *rpc.Client) *Debug {
+	return &Debug{c: c}
+}
+
+// TraceTransaction traces a mined tx, the raw result depends on the tracer of config
+func (d *Debug) TraceTransaction(hash common.Hash, config *TraceConfig) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := d.c.Call("debug_traceTransaction", &out, hash, traceConfigArg(config)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceCall traces a call executed on top of block, the raw result depends on the tracer of config
+func (d *Debug) TraceCall(msg *types.CallMsg, block *big.Int, config *TraceConfig) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := d.c.Call("debug_traceCall", &out, msg, utils.ToBlockNumArg(block), traceConfigArg(config)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceBlockByNumber traces all txs of the block
+func (d *Debug) TraceBlockByNumber(number *big.Int, config *TraceConfig) ([]*TxTraceResult, error) {
+	var out []*TxTraceResult
+	if err := d.c.Call("debug_traceBlockByNumber", &out, utils.ToBlockNumArg(number), traceConfigArg(config)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceBlockByHash traces all txs of the block
+func (d *Debug) TraceBlockByHash(hash common.Hash, config *TraceConfig) ([]*TxTraceResult, error) {
+	var out []*TxTraceResult
+	if err := d.c.Call("debug_traceBlockByHash", &out, hash, traceConfigArg(config)); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceTransactionCalls traces a mined tx with callTracer
+func (d *Debug) TraceTransactionCalls(hash common.Hash, config *CallTracerConfig) (*CallFrame, error) {
+	var out *CallFrame
+	if err := d.traceTransaction(hash, newTraceConfig(CallTracer, config), &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceCallCalls traces a call with callTracer
+func (d *Debug) TraceCallCalls(msg *types.CallMsg, block *big.Int, config *CallTracerConfig) (*CallFrame, error) {
+	raw, err := d.TraceCall(msg, block, newTraceConfig(CallTracer, config))
+	if err != nil {
+		return nil, err
+	}
+	var out *CallFrame
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceTransactionPrestate traces a mined tx with prestateTracer
+func (d *Debug) TraceTransactionPrestate(hash common.Hash, config *PrestateTracerConfig) (*PrestateResult, error) {
+	var out *PrestateResult
+	if err := d.traceTransaction(hash, newTraceConfig(PrestateTracer, config), &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TraceTransactionFourByte traces a mined tx with 4byteTracer,
+// keys are "selector-calldata size" and values the number of calls
+func (d *Debug) TraceTransactionFourByte(hash common.Hash) (map[string]int, error) {
+	var out map[string]int
+	if err := d.traceTransaction(hash, &TraceConfig{Tracer: FourByteTracer}, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (d *Debug) traceTransaction(hash common.Hash, config *TraceConfig, out interface{}) error {
+	raw, err := d.TraceTransaction(hash, config)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, out)
+}
+
+func newTraceConfig(tracer string, tracerConfig interface{}) *TraceConfig {
+	config := &TraceConfig{Tracer: tracer}
+	switch c := tracerConfig.(type) {
+	case *CallTracerConfig:
+		if c != nil {
+			config.TracerConfig = c
+		}
+	case *PrestateTracerConfig:
+		if c != nil {
+			config.TracerConfig = c
+		}
+	}
+	return config
+}
+
+// traceConfigArg avoids sending null, which some nodes reject
+func traceConfigArg(config *TraceConfig) *TraceConfig {
+	if config == nil {
+		return &TraceConfig{}
+	}
+	return config
+}
@@ -0,0 +1,207 @@
+package debug
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/rpc"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type mockHandler func(params []json.RawMessage) (interface{}, error)
+
+func newMockDebug(t *testing.T, handlers map[string]mockHandler) *Debug {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if h, ok := handlers[req.Method]; !ok {
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		} else if result, err := h(req.Params); err != nil {
+			resp["error"] = map[string]interface{}{"code": -32000, "message": err.Error()}
+		} else {
+			resp["result"] = result
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(s.Close)
+
+	c, err := rpc.NewClient(s.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewDebug(c)
+}
+
+func revertData(t *testing.T, reason string) hexutil.Bytes {
+	typ, err := abi.NewType("string", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := abi.Arguments{{Type: typ}}.Pack(reason)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(common.FromHex("0x08c379a0"), data...)
+}
+
+func TestTraceTransactionCalls(t *testing.T) {
+	token := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	var config TraceConfig
+	d := newMockDebug(t, map[string]mockHandler{
+		"debug_traceTransaction": func(params []json.RawMessage) (interface{}, error) {
+			if err := json.Unmarshal(params[1], &config); err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{
+				"type":    "CALL",
+				"from":    "0x1000000000000000000000000000000000000001",
+				"to":      "0x3000000000000000000000000000000000000003",
+				"value":   "0x0",
+				"gas":     "0x10000",
+				"gasUsed": "0x5000",
+				"input":   "0x12345678",
+				"error":   "execution reverted",
+				"calls": []interface{}{
+					map[string]interface{}{
+						"type":    "STATICCALL",
+						"from":    "0x3000000000000000000000000000000000000003",
+						"to":      token,
+						"gas":     "0x8000",
+						"gasUsed": "0x100",
+						"input":   "0x70a08231",
+						"output":  "0x01",
+					},
+					map[string]interface{}{
+						"type":    "CALL",
+						"from":    "0x3000000000000000000000000000000000000003",
+						"to":      token,
+						"gas":     "0x8000",
+						"gasUsed": "0x200",
+						"input":   "0xa9059cbb",
+						"output":  revertData(t, "insufficient balance"),
+						"error":   "execution reverted",
+					},
+				},
+			}, nil
+		},
+	})
+
+	frame, err := d.TraceTransactionCalls(common.Hash{1}, &CallTracerConfig{WithLog: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Tracer != CallTracer {
+		t.Fatalf("unexpected tracer %q", config.Tracer)
+	}
+	if cfg, ok := config.TracerConfig.(map[string]interface{}); !ok || cfg["withLog"] != true {
+		t.Fatalf("unexpected tracer config %v", config.TracerConfig)
+	}
+
+	if !frame.Failed() || len(frame.Calls) != 2 {
+		t.Fatalf("unexpected frame %+v", frame)
+	}
+	failed := frame.FailedCall()
+	if failed == nil || *failed.To != token || failed.RevertReason != "insufficient balance" {
+		t.Fatalf("unexpected failed call %+v", failed)
+	}
+
+	var depths []int
+	frame.Walk(func(f *CallFrame, depth int) bool {
+		depths = append(depths, depth)
+		return true
+	})
+	if len(depths) != 3 || depths[2] != 1 {
+		t.Fatalf("unexpected walk %v", depths)
+	}
+}
+
+func TestTraceTransactionPrestate(t *testing.T) {
+	addr := "0x1000000000000000000000000000000000000001"
+	diffMode := false
+	d := newMockDebug(t, map[string]mockHandler{
+		"debug_traceTransaction": func(params []json.RawMessage) (interface{}, error) {
+			pre := map[string]interface{}{addr: map[string]interface{}{"balance": "0x10", "nonce": 1}}
+			if !diffMode {
+				return pre, nil
+			}
+			return map[string]interface{}{
+				"pre":  pre,
+				"post": map[string]interface{}{addr: map[string]interface{}{"balance": "0x5", "nonce": 2}},
+			}, nil
+		},
+	})
+
+	res, err := d.TraceTransactionPrestate(common.Hash{1}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.IsDiff() || res.Pre[common.HexToAddress(addr)].Nonce != 1 {
+		t.Fatalf("unexpected prestate %+v", res)
+	}
+
+	diffMode = true
+	res, err = d.TraceTransactionPrestate(common.Hash{1}, &PrestateTracerConfig{DiffMode: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.IsDiff() || res.Post[common.HexToAddress(addr)].Balance.ToInt().Int64() != 5 {
+		t.Fatalf("unexpected prestate diff %+v", res)
+	}
+}
+
+func TestTraceBlockAndCustomTracer(t *testing.T) {
+	d := newMockDebug(t, map[string]mockHandler{
+		"debug_traceBlockByNumber": func(params []json.RawMessage) (interface{}, error) {
+			return []interface{}{
+				map[string]interface{}{"txHash": common.Hash{1}, "result": map[string]int{"0xa9059cbb-64": 1}},
+				map[string]interface{}{"txHash": common.Hash{2}, "error": "execution timeout"},
+			}, nil
+		},
+		"debug_traceCall": func(params []json.RawMessage) (interface{}, error) {
+			var config TraceConfig
+			if err := json.Unmarshal(params[2], &config); err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{"tracer": config.Tracer}, nil
+		},
+	})
+
+	results, err := d.TraceBlockByNumber(big.NewInt(10), &TraceConfig{Tracer: FourByteTracer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("unexpected results %v", results)
+	}
+	selectors, err := results[0].FourByte()
+	if err != nil || selectors["0xa9059cbb-64"] != 1 {
+		t.Fatalf("unexpected 4byte result %v %v", selectors, err)
+	}
+	if _, err := results[1].FourByte(); err == nil {
+		t.Fatal("expected trace error")
+	}
+
+	js := "{data: [], fault: function() {}, step: function() {}, result: function() { return this.data; }}"
+	raw, err := d.TraceCall(&types.CallMsg{To: common.Address{1}}, nil, &TraceConfig{Tracer: js})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out map[string]string
+	if err := json.Unmarshal(raw, &out); err != nil || out["tracer"] != js {
+		t.Fatalf("unexpected raw result %s %v", raw, err)
+	}
+}
@@ -0,0 +1,193 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	CallTracer     = "callTracer"
+	PrestateTracer = "prestateTracer"
+	FourByteTracer = "4byteTracer"
+)
+
+// TraceConfig is the config of debug_trace* methods.
+// Tracer is a built-in tracer name or a JS tracer; empty means the struct logger.
+type TraceConfig struct {
+	Tracer       string      `json:"tracer,omitempty"`
+	TracerConfig interface{} `json:"tracerConfig,omitempty"`
+	Timeout      string      `json:"timeout,omitempty"`
+	Reexec       *uint64     `json:"reexec,omitempty"`
+
+	// struct logger options
+	EnableMemory     bool `json:"enableMemory,omitempty"`
+	DisableStack     bool `json:"disableStack,omitempty"`
+	DisableStorage   bool `json:"disableStorage,omitempty"`
+	EnableReturnData bool `json:"enableReturnData,omitempty"`
+}
+
+// CallTracerConfig is the tracerConfig of callTracer
+type CallTracerConfig struct {
+	OnlyTopCall bool `json:"onlyTopCall,omitempty"`
+	WithLog     bool `json:"withLog,omitempty"`
+}
+
+// PrestateTracerConfig is the tracerConfig of prestateTracer
+type PrestateTracerConfig struct {
+	DiffMode       bool `json:"diffMode,omitempty"`
+	DisableCode    bool `json:"disableCode,omitempty"`
+	DisableStorage bool `json:"disableStorage,omitempty"`
+}
+
+// CallLog is a log emitted in a call frame, traced with CallTracerConfig.WithLog
+type CallLog struct {
+	Address  common.Address `json:"address"`
+	Topics   []common.Hash  `json:"topics"`
+	Data     hexutil.Bytes  `json:"data"`
+	Position hexutil.Uint   `json:"position"`
+}
+
+// CallFrame is a call of the callTracer result tree
+type CallFrame struct {
+	Type         string          `json:"type"`
+	From         common.Address  `json:"from"`
+	To           *common.Address `json:"to,omitempty"`
+	Value        *hexutil.Big    `json:"value,omitempty"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Input        hexutil.Bytes   `json:"input"`
+	Output       hexutil.Bytes   `json:"output,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Calls        []*CallFrame    `json:"calls,omitempty"`
+	Logs         []CallLog       `json:"logs,omitempty"`
+}
+
+// UnmarshalJSON decodes the frame and the revert reason of reverted frames
+// from Error(string) or Panic(uint256) output when the node did not
+func (f *CallFrame) UnmarshalJSON(data []byte) error {
+	type frame CallFrame
+	if err := json.Unmarshal(data, (*frame)(f)); err != nil {
+		return err
+	}
+	if f.Failed() && f.RevertReason == "" && len(f.Output) > 0 {
+		if reason, err := abi.UnpackRevert(f.Output); err == nil {
+			f.RevertReason = reason
+		}
+	}
+	return nil
+}
+
+// Failed reports whether the call reverted or failed
+func (f *CallFrame) Failed() bool {
+	return f.Error != ""
+}
+
+// Walk visits the frame and its sub calls depth first until fn returns false
+func (f *CallFrame) Walk(fn func(frame *CallFrame, depth int) bool) {
+	f.walk(fn, 0)
+}
+
+func (f *CallFrame) walk(fn func(frame *CallFrame, depth int) bool, depth int) bool {
+	if !fn(f, depth) {
+		return false
+	}
+	for _, call := range f.Calls {
+		if !call.walk(fn, depth+1) {
+			return false
+		}
+	}
+	return true
+}
+
+// FailedCall returns the deepest failed frame along the first failing path, nil if no call failed
+func (f *CallFrame) FailedCall() *CallFrame {
+	if !f.Failed() {
+		return nil
+	}
+	for _, call := range f.Calls {
+		if failed := call.FailedCall(); failed != nil {
+			return failed
+		}
+	}
+	return f
+}
+
+// PrestateAccount is an account state of the prestateTracer result
+type PrestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+// PrestateResult is the prestateTracer result. Without diff mode only Pre is set,
+// in diff mode Pre holds the touched state before and Post the modified state after the tx.
+type PrestateResult struct {
+	Pre  map[common.Address]*PrestateAccount `json:"pre"`
+	Post map[common.Address]*PrestateAccount `json:"post,omitempty"`
+}
+
+func (r *PrestateResult) UnmarshalJSON(data []byte) error {
+	var diff struct {
+		Pre  *map[common.Address]*PrestateAccount `json:"pre"`
+		Post *map[common.Address]*PrestateAccount `json:"post"`
+	}
+	// object keys of prestate mode are addresses, so pre/post only appear in diff mode
+	if err := json.Unmarshal(data, &diff); err == nil && diff.Pre != nil && diff.Post != nil {
+		r.Pre, r.Post = *diff.Pre, *diff.Post
+		return nil
+	}
+	r.Post = nil
+	return json.Unmarshal(data, &r.Pre)
+}
+
+// IsDiff reports whether the result is traced in diff mode
+func (r *PrestateResult) IsDiff() bool {
+	return r.Post != nil
+}
+
+// TxTraceResult is the trace of a tx in a block trace
+type TxTraceResult struct {
+	TxHash common.Hash     `json:"txHash"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// CallFrame decodes a callTracer result
+func (r *TxTraceResult) CallFrame() (*CallFrame, error) {
+	var out *CallFrame
+	if err := r.decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Prestate decodes a prestateTracer result
+func (r *TxTraceResult) Prestate() (*PrestateResult, error) {
+	var out *PrestateResult
+	if err := r.decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FourByte decodes a 4byteTracer result, keys are "selector-calldata size"
+func (r *TxTraceResult) FourByte() (map[string]int, error) {
+	var out map[string]int
+	if err := r.decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *TxTraceResult) decode(out interface{}) error {
+	if r.Error != "" {
+		return fmt.Errorf("trace tx %v failed: %s", r.TxHash, r.Error)
+	}
+	return json.Unmarshal(r.Result, out)
+}
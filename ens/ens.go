@@ -0,0 +1,307 @@
+package ens
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/chenzhijie/go-web3/eth"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RegistryAddress is the ENS registry, deployed at the same address on mainnet and testnets
+var RegistryAddress = common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+
+// CoinTypeETH is the SLIP-44 coin type of ether addresses
+const CoinTypeETH = 60
+
+// extendedResolverID is the ERC-165 interface id of the ENSIP-10 resolve(bytes,bytes)
+var extendedResolverID = [4]byte{0x90, 0x61, 0xb9, 0x23}
+
+var (
+	ErrNoResolver      = errors.New("ens: no resolver")
+	ErrNoAddress       = errors.New("ens: no address")
+	ErrNoName          = errors.New("ens: no reverse record")
+	ErrReverseMismatch = errors.New("ens: reverse record does not resolve to the address")
+)
+
+// errEmptyResult is the empty output of calls to accounts without code
+var errEmptyResult = errors.New("ens: empty call result")
+
+// EVMCoinType returns the ENSIP-11 coin type of the addresses of an EVM chain
+func EVMCoinType(chainId uint64) uint64 {
+	if chainId == 1 {
+		return CoinTypeETH
+	}
+	return 0x80000000 | chainId
+}
+
+// ENS resolves names through the ENS registry
+type ENS struct {
+	e        *eth.Eth
+	registry *eth.Contract
+}
+
+// New creates an ENS client of the registry at RegistryAddress
+func New(e *eth.Eth) (*ENS, error) {
+	return NewWithRegistry(e, RegistryAddress)
+}
+
+// NewWithRegistry creates an ENS client of the registry at registry
+func NewWithRegistry(e *eth.Eth, registry common.Address) (*ENS, error) {
+	contr, err := e.NewContract(REGISTRY_ABI, registry.String())
+	if err != nil {
+		return nil, err
+	}
+	return &ENS{e: e, registry: contr}, nil
+}
+
+// Resolver is the resolver of a name. Resolvers found on a parent name (ENSIP-10
+// wildcards) and resolvers implementing resolve(bytes,bytes) are queried through resolve().
+type Resolver struct {
+	Name     string
+	Node     common.Hash
+	Address  common.Address
+	Wildcard bool
+
+	ens      *ENS
+	contr    *eth.Contract
+	extended bool
+}
+
+// Resolver finds the resolver of name, walking up to the parent names when the name
+// has no resolver
+func (s *ENS) Resolver(name string) (*Resolver, error) {
+	name, err := Normalize(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var addr common.Address
+	parent := name
+	for {
+		ret, err := s.call(s.registry, "resolver", NameHash(parent))
+		if err != nil {
+			return nil, err
+		}
+		addr = ret[0].(common.Address)
+		if addr != (common.Address{}) {
+			break
+		}
+		if parent == "" {
+			return nil, fmt.Errorf("%w for %v", ErrNoResolver, name)
+		}
+		if i := strings.IndexByte(parent, '.'); i >= 0 {
+			parent = parent[i+1:]
+		} else {
+			parent = ""
+		}
+	}
+
+	contr, err := s.e.NewContract(RESOLVER_ABI, addr.String())
+	if err != nil {
+		return nil, err
+	}
+	r := &Resolver{
+		Name:     name,
+		Node:     NameHash(name),
+		Address:  addr,
+		Wildcard: parent != name,
+		ens:      s,
+		contr:    contr,
+	}
+	ret, err := s.call(contr, "supportsInterface", extendedResolverID)
+	var rev *eth.RevertError
+	switch {
+	case err == nil:
+		r.extended = ret[0].(bool)
+	case errors.Is(err, errEmptyResult) || errors.As(err, &rev):
+		// resolvers without ERC-165
+	default:
+		return nil, err
+	}
+	if r.Wildcard && !r.extended {
+		return nil, fmt.Errorf("%w for %v, the resolver of %v does not support wildcards", ErrNoResolver, name, parent)
+	}
+	return r, nil
+}
+
+// Addr returns the ether address of the name
+func (r *Resolver) Addr() (common.Address, error) {
+	ret, err := r.resolve("addr")
+	if err != nil {
+		return common.Address{}, err
+	}
+	addr := ret[0].(common.Address)
+	if addr == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("%w for %v", ErrNoAddress, r.Name)
+	}
+	return addr, nil
+}
+
+// AddrForCoin returns the address of the name for a SLIP-44 or ENSIP-11 coin type in the
+// binary format of the coin, nil if not set
+func (r *Resolver) AddrForCoin(coinType uint64) ([]byte, error) {
+	ret, err := r.resolve("addr0", new(big.Int).SetUint64(coinType))
+	if err != nil {
+		return nil, err
+	}
+	addr := ret[0].([]byte)
+	if len(addr) == 0 {
+		return nil, nil
+	}
+	return addr, nil
+}
+
+// Text returns the text record of key, empty if not set
+func (r *Resolver) Text(key string) (string, error) {
+	ret, err := r.resolve("text", key)
+	if err != nil {
+		return "", err
+	}
+	return ret[0].(string), nil
+}
+
+// Contenthash returns the ENSIP-7 content hash, nil if not set
+func (r *Resolver) Contenthash() ([]byte, error) {
+	ret, err := r.resolve("contenthash")
+	if err != nil {
+		return nil, err
+	}
+	hash := ret[0].([]byte)
+	if len(hash) == 0 {
+		return nil, nil
+	}
+	return hash, nil
+}
+
+// resolve calls a record method with the node of the name, through resolve() on
+// extended resolvers
+func (r *Resolver) resolve(method string, args ...interface{}) ([]interface{}, error) {
+	args = append([]interface{}{r.Node}, args...)
+	if !r.extended {
+		return r.ens.call(r.contr, method, args...)
+	}
+	data, err := r.contr.EncodeABI(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := r.ens.call(r.contr, "resolve", DNSEncode(r.Name), data)
+	if err != nil {
+		return nil, err
+	}
+	out := ret[0].([]byte)
+	if len(out) == 0 {
+		return nil, errEmptyResult
+	}
+	return r.contr.Methods(method).Outputs.Unpack(out)
+}
+
+// Address resolves the ether address of name
+func (s *ENS) Address(name string) (common.Address, error) {
+	r, err := s.Resolver(name)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return r.Addr()
+}
+
+// AddressOf returns hex addresses as is and resolves the ether address of names, so both
+// can be accepted where an address is expected
+func (s *ENS) AddressOf(nameOrAddress string) (common.Address, error) {
+	if common.IsHexAddress(nameOrAddress) {
+		return common.HexToAddress(nameOrAddress), nil
+	}
+	return s.Address(nameOrAddress)
+}
+
+// AddressForCoin resolves the address of name for a coin type, see Resolver.AddrForCoin
+func (s *ENS) AddressForCoin(name string, coinType uint64) ([]byte, error) {
+	r, err := s.Resolver(name)
+	if err != nil {
+		return nil, err
+	}
+	return r.AddrForCoin(coinType)
+}
+
+// Text resolves the text record key of name
+func (s *ENS) Text(name, key string) (string, error) {
+	r, err := s.Resolver(name)
+	if err != nil {
+		return "", err
+	}
+	return r.Text(key)
+}
+
+// Contenthash resolves the content hash of name
+func (s *ENS) Contenthash(name string) ([]byte, error) {
+	r, err := s.Resolver(name)
+	if err != nil {
+		return nil, err
+	}
+	return r.Contenthash()
+}
+
+// ReverseName returns the reverse record name of addr, <addr>.addr.reverse
+func ReverseName(addr common.Address) string {
+	return strings.ToLower(addr.Hex()[2:]) + ".addr.reverse"
+}
+
+// Name returns the primary name of addr from its reverse record. The name is verified
+// to be normalized and to resolve back to addr, ErrReverseMismatch is returned otherwise.
+func (s *ENS) Name(addr common.Address) (string, error) {
+	r, err := s.Resolver(ReverseName(addr))
+	if errors.Is(err, ErrNoResolver) {
+		return "", fmt.Errorf("%w for %v", ErrNoName, addr)
+	}
+	if err != nil {
+		return "", err
+	}
+	ret, err := r.resolve("name")
+	if err != nil {
+		return "", err
+	}
+	name := ret[0].(string)
+	if name == "" {
+		return "", fmt.Errorf("%w for %v", ErrNoName, addr)
+	}
+
+	normalized, err := Normalize(name)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrReverseMismatch, err)
+	}
+	if normalized != name {
+		return "", fmt.Errorf("%w: %v is not normalized", ErrReverseMismatch, name)
+	}
+	forward, err := s.Address(name)
+	if err != nil && !errors.Is(err, ErrNoAddress) && !errors.Is(err, ErrNoResolver) {
+		return "", err
+	}
+	if forward != addr {
+		return "", fmt.Errorf("%w: %v resolves to %v, not %v", ErrReverseMismatch, name, forward, addr)
+	}
+	return name, nil
+}
+
+// call calls a view method, errEmptyResult is returned for accounts without code
+func (s *ENS) call(contr *eth.Contract, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := contr.EncodeABI(method, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.e.Call(&types.CallMsg{To: contr.Address(), Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	output, err := hexutil.Decode(out)
+	if err != nil {
+		return nil, err
+	}
+	if len(output) == 0 {
+		return nil, errEmptyResult
+	}
+	return contr.Methods(method).Outputs.Unpack(output)
+}
@@ -0,0 +1,279 @@
+package ens
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/eth"
+	"github.com/chenzhijie/go-web3/rpc"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	registry         = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	publicResolver   = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	wildcardResolver = common.HexToAddress("0x3000000000000000000000000000000000000003")
+
+	vitalik  = common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+	impostor = common.HexToAddress("0x4000000000000000000000000000000000000004")
+	sub      = common.HexToAddress("0x5000000000000000000000000000000000000005")
+	// homoglyph owns vit\u0430lik.eth, spelled with a Cyrillic a
+	homoglyph     = common.HexToAddress("0x6000000000000000000000000000000000000006")
+	homoglyphName = "vit\u0430lik.eth"
+)
+
+// mockENS serves the registry, a public resolver and a wildcard resolver of *.base.eth
+type mockENS struct {
+	t         *testing.T
+	registry  abi.ABI
+	resolver  abi.ABI
+	resolvers map[common.Hash]common.Address
+}
+
+func newMockENS(t *testing.T) *mockENS {
+	m := &mockENS{t: t, resolvers: map[common.Hash]common.Address{
+		NameHash("vitalik.eth"):          publicResolver,
+		NameHash(ReverseName(vitalik)):   publicResolver,
+		NameHash(ReverseName(impostor)):  publicResolver,
+		NameHash("base.eth"):             wildcardResolver,
+		NameHash("plain.eth"):            publicResolver,
+		NameHash("nowildcard.eth"):       publicResolver,
+		NameHash(ReverseName(sub)):       wildcardResolver,
+		NameHash(ReverseName(homoglyph)): publicResolver,
+		NameHash(homoglyphName):          publicResolver,
+	}}
+	var err error
+	if m.registry, err = abi.JSON(strings.NewReader(REGISTRY_ABI)); err != nil {
+		t.Fatal(err)
+	}
+	if m.resolver, err = abi.JSON(strings.NewReader(RESOLVER_ABI)); err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func (m *mockENS) call(to common.Address, data []byte) ([]byte, error) {
+	switch to {
+	case registry:
+		args, err := m.registry.Methods["resolver"].Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, err
+		}
+		return m.registry.Methods["resolver"].Outputs.Pack(m.resolvers[args[0].([32]byte)])
+	case publicResolver:
+		return m.publicRecord(data)
+	case wildcardResolver:
+		method, err := m.resolver.MethodById(data[:4])
+		if err != nil {
+			return nil, err
+		}
+		args, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, err
+		}
+		switch method.Name {
+		case "supportsInterface":
+			return method.Outputs.Pack(args[0].([4]byte) == extendedResolverID)
+		case "resolve":
+			name, inner := args[0].([]byte), args[1].([]byte)
+			if !bytes.HasSuffix(name, DNSEncode("base.eth")) && !bytes.Equal(name, DNSEncode(ReverseName(sub))) {
+				return nil, fmt.Errorf("unexpected name %x", name)
+			}
+			innerMethod, err := m.resolver.MethodById(inner[:4])
+			if err != nil {
+				return nil, err
+			}
+			var ret []byte
+			switch innerMethod.Name {
+			case "addr":
+				ret, err = innerMethod.Outputs.Pack(sub)
+			case "name":
+				ret, err = innerMethod.Outputs.Pack("sub.base.eth")
+			default:
+				return nil, fmt.Errorf("unexpected method %v", innerMethod.Name)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return method.Outputs.Pack(ret)
+		}
+	}
+	// accounts without code
+	return nil, nil
+}
+
+func (m *mockENS) publicRecord(data []byte) ([]byte, error) {
+	method, err := m.resolver.MethodById(data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	if method.Name == "supportsInterface" {
+		return method.Outputs.Pack(false)
+	}
+	node := common.Hash(args[0].([32]byte))
+	switch {
+	case method.Name == "addr" && node == NameHash("vitalik.eth"):
+		return method.Outputs.Pack(vitalik)
+	case method.Name == "addr" && node == NameHash(homoglyphName):
+		return method.Outputs.Pack(homoglyph)
+	case method.Name == "addr":
+		return method.Outputs.Pack(common.Address{})
+	case method.Name == "addr0" && args[1].(*big.Int).Uint64() == EVMCoinType(137):
+		return method.Outputs.Pack(vitalik.Bytes())
+	case method.Name == "addr0":
+		return method.Outputs.Pack([]byte{})
+	case method.Name == "text" && args[1].(string) == "url":
+		return method.Outputs.Pack("https://vitalik.ca")
+	case method.Name == "text":
+		return method.Outputs.Pack("")
+	case method.Name == "contenthash":
+		return method.Outputs.Pack(hexutil.MustDecode("0xe301017012200102"))
+	case method.Name == "name" && node == NameHash(ReverseName(vitalik)):
+		return method.Outputs.Pack("vitalik.eth")
+	case method.Name == "name" && node == NameHash(ReverseName(impostor)):
+		return method.Outputs.Pack("vitalik.eth")
+	case method.Name == "name" && node == NameHash(ReverseName(homoglyph)):
+		return method.Outputs.Pack(homoglyphName)
+	}
+	return nil, fmt.Errorf("unexpected method %v", method.Name)
+}
+
+func (m *mockENS) eth() *eth.Eth {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		var msg struct {
+			To   common.Address `json:"to"`
+			Data hexutil.Bytes  `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_call" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if err := json.Unmarshal(req.Params[0], &msg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if ret, err := m.call(msg.To, msg.Data); err != nil {
+			resp["error"] = map[string]interface{}{"code": 3, "message": "execution reverted", "data": "0x"}
+		} else {
+			resp["result"] = hexutil.Bytes(ret)
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	m.t.Cleanup(s.Close)
+
+	c, err := rpc.NewClient(s.URL, "")
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return eth.NewEth(c)
+}
+
+func newTestENS(t *testing.T) *ENS {
+	s, err := NewWithRegistry(newMockENS(t).eth(), registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestResolve(t *testing.T) {
+	s := newTestENS(t)
+
+	addr, err := s.Address("Vitalik.eth")
+	if err != nil || addr != vitalik {
+		t.Fatalf("unexpected address %v %v", addr, err)
+	}
+	if addr, err = s.AddressOf(vitalik.Hex()); err != nil || addr != vitalik {
+		t.Fatalf("unexpected address %v %v", addr, err)
+	}
+
+	r, err := s.Resolver("vitalik.eth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Address != publicResolver || r.Wildcard || r.Node != NameHash("vitalik.eth") {
+		t.Fatalf("unexpected resolver %+v", r)
+	}
+	if url, err := r.Text("url"); err != nil || url != "https://vitalik.ca" {
+		t.Fatalf("unexpected text %q %v", url, err)
+	}
+	if hash, err := r.Contenthash(); err != nil || hexutil.Encode(hash) != "0xe301017012200102" {
+		t.Fatalf("unexpected contenthash %x %v", hash, err)
+	}
+	if polygon, err := r.AddrForCoin(EVMCoinType(137)); err != nil || common.BytesToAddress(polygon) != vitalik {
+		t.Fatalf("unexpected polygon address %x %v", polygon, err)
+	}
+	if btc, err := r.AddrForCoin(0); err != nil || btc != nil {
+		t.Fatalf("unexpected btc address %x %v", btc, err)
+	}
+
+	if _, err := s.Address("plain.eth"); !errors.Is(err, ErrNoAddress) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := s.Address("missing.xyz"); !errors.Is(err, ErrNoResolver) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := s.Address("a..eth"); err == nil {
+		t.Fatal("expected normalization error")
+	}
+}
+
+func TestResolveWildcard(t *testing.T) {
+	s := newTestENS(t)
+
+	r, err := s.Resolver("sub.base.eth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Wildcard || r.Address != wildcardResolver {
+		t.Fatalf("unexpected resolver %+v", r)
+	}
+	if addr, err := r.Addr(); err != nil || addr != sub {
+		t.Fatalf("unexpected address %v %v", addr, err)
+	}
+
+	// the parent resolver does not implement resolve()
+	if _, err := s.Resolver("sub.nowildcard.eth"); !errors.Is(err, ErrNoResolver) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestReverseResolve(t *testing.T) {
+	s := newTestENS(t)
+
+	if name, err := s.Name(vitalik); err != nil || name != "vitalik.eth" {
+		t.Fatalf("unexpected name %q %v", name, err)
+	}
+	if name, err := s.Name(sub); err != nil || name != "sub.base.eth" {
+		t.Fatalf("unexpected name %q %v", name, err)
+	}
+	if _, err := s.Name(impostor); !errors.Is(err, ErrReverseMismatch) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// the name resolves back but is rejected by normalization
+	if _, err := s.Name(homoglyph); !errors.Is(err, ErrReverseMismatch) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := s.Name(registry); !errors.Is(err, ErrNoName) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,241 @@
+//go:build ignore
+
+// gen generates tables.go, the ENSIP-15 text and emoji tables, from the UTS-46 mapping
+// table and the emoji test data of the Unicode version of ENSIP-15.
+//
+//	go run gen.go [-idna IdnaMappingTable.txt] [-emoji emoji-test.txt]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const unicodeVersion = "15.1.0"
+
+var (
+	idnaPath  = flag.String("idna", "https://www.unicode.org/Public/idna/"+unicodeVersion+"/IdnaMappingTable.txt", "UTS-46 mapping table path or URL")
+	emojiPath = flag.String("emoji", "https://www.unicode.org/Public/emoji/15.1/emoji-test.txt", "emoji test data path or URL")
+	out       = flag.String("out", "tables.go", "output file")
+)
+
+type mapping struct {
+	lo, hi rune
+	to     string
+}
+
+func main() {
+	flag.Parse()
+
+	var valid, ignored []rune
+	var mapped []mapping
+	uts46 := map[rune]string{}
+	err := readData(*idnaPath, func(fields []string) error {
+		lo, hi, err := parseRange(fields[0])
+		if err != nil {
+			return err
+		}
+		status := fields[1]
+		var to string
+		if len(fields) > 2 {
+			if to, err = parseRunes(fields[2]); err != nil {
+				return err
+			}
+		}
+		// NV8 and XV8 characters are valid in UTS-46 but not in IDNA2008
+		idna2008 := len(fields) < 4 || fields[3] == ""
+		for r := lo; r <= hi; r++ {
+			uts46[r] = status
+			switch {
+			case status == "valid" && idna2008:
+				valid = append(valid, r)
+			case status == "deviation" && to != "":
+				// nontransitional processing keeps the deviations
+				valid = append(valid, r)
+			case status == "ignored":
+				ignored = append(ignored, r)
+			}
+		}
+		if status == "mapped" {
+			mapped = append(mapped, mapping{lo, hi, to})
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	seen := map[string]bool{}
+	var emoji []string
+	err = readData(*emojiPath, func(fields []string) error {
+		if fields[1] != "fully-qualified" {
+			return nil
+		}
+		seq, err := parseRunes(fields[0])
+		if err != nil {
+			return err
+		}
+		seq = strings.ReplaceAll(seq, "\ufe0f", "")
+		runes := []rune(seq)
+		// single characters mapped by UTS-46 like ™ are text
+		if len(runes) == 1 && uts46[runes[0]] == "mapped" {
+			return nil
+		}
+		if !seen[seq] {
+			seen[seq] = true
+			emoji = append(emoji, seq)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(emoji)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by \"go run gen.go\"; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package ens\n\nimport \"unicode\"\n\n")
+	fmt.Fprintf(&b, "// unicodeVersion is the Unicode version of the tables\n")
+	fmt.Fprintf(&b, "const unicodeVersion = %q\n\n", unicodeVersion)
+	fmt.Fprintf(&b, "// textValid are the characters valid in UTS-46 and IDNA2008\n")
+	writeRangeTable(&b, "textValid", valid)
+	fmt.Fprintf(&b, "// textIgnored are the characters ignored by UTS-46\n")
+	writeRangeTable(&b, "textIgnored", ignored)
+	fmt.Fprintf(&b, "// textMapped are the UTS-46 mappings sorted by range\n")
+	fmt.Fprintf(&b, "var textMapped = []textMapping{\n")
+	for _, m := range mapped {
+		fmt.Fprintf(&b, "{0x%04x, 0x%04x, %+q},\n", m.lo, m.hi, m.to)
+	}
+	fmt.Fprintf(&b, "}\n\n")
+	fmt.Fprintf(&b, "// emojiSequences are the fully-qualified emoji without presentation selectors\n")
+	fmt.Fprintf(&b, "var emojiSequences = []string{\n")
+	for _, seq := range emoji {
+		fmt.Fprintf(&b, "%+q,\n", seq)
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readData calls fn with the fields of the data lines of a Unicode data file
+func readData(path string, fn func(fields []string) error) error {
+	var r io.Reader
+	if strings.HasPrefix(path, "https://") {
+		resp, err := http.Get(path)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("get %v: %v", path, resp.Status)
+		}
+		r = resp.Body
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if err := fn(fields); err != nil {
+			return fmt.Errorf("%v: %q: %w", path, s.Text(), err)
+		}
+	}
+	return s.Err()
+}
+
+func parseRange(s string) (rune, rune, error) {
+	loStr, hiStr, isRange := strings.Cut(s, "..")
+	lo, err := strconv.ParseUint(loStr, 16, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !isRange {
+		return rune(lo), rune(lo), nil
+	}
+	hi, err := strconv.ParseUint(hiStr, 16, 32)
+	return rune(lo), rune(hi), err
+}
+
+func parseRunes(s string) (string, error) {
+	var runes []rune
+	for _, field := range strings.Fields(s) {
+		r, err := strconv.ParseUint(field, 16, 32)
+		if err != nil {
+			return "", err
+		}
+		runes = append(runes, rune(r))
+	}
+	return string(runes), nil
+}
+
+// writeRangeTable writes sorted runes as a unicode.RangeTable of stride 1 ranges
+func writeRangeTable(b *bytes.Buffer, name string, runes []rune) {
+	var r16 []unicode.Range16
+	var r32 []unicode.Range32
+	for i := 0; i < len(runes); {
+		j := i
+		for j+1 < len(runes) && runes[j+1] == runes[j]+1 {
+			j++
+		}
+		lo, hi := runes[i], runes[j]
+		switch {
+		case hi <= 0xffff:
+			r16 = append(r16, unicode.Range16{Lo: uint16(lo), Hi: uint16(hi), Stride: 1})
+		case lo > 0xffff:
+			r32 = append(r32, unicode.Range32{Lo: uint32(lo), Hi: uint32(hi), Stride: 1})
+		default:
+			r16 = append(r16, unicode.Range16{Lo: uint16(lo), Hi: 0xffff, Stride: 1})
+			r32 = append(r32, unicode.Range32{Lo: 0x10000, Hi: uint32(hi), Stride: 1})
+		}
+		i = j + 1
+	}
+	latinOffset := 0
+	for _, r := range r16 {
+		if r.Hi <= unicode.MaxLatin1 {
+			latinOffset++
+		}
+	}
+	fmt.Fprintf(b, "var %v = &unicode.RangeTable{\n", name)
+	fmt.Fprintf(b, "R16: []unicode.Range16{\n")
+	for _, r := range r16 {
+		fmt.Fprintf(b, "{0x%04x, 0x%04x, 1},\n", r.Lo, r.Hi)
+	}
+	fmt.Fprintf(b, "},\n")
+	if len(r32) > 0 {
+		fmt.Fprintf(b, "R32: []unicode.Range32{\n")
+		for _, r := range r32 {
+			fmt.Fprintf(b, "{0x%x, 0x%x, 1},\n", r.Lo, r.Hi)
+		}
+		fmt.Fprintf(b, "},\n")
+	}
+	fmt.Fprintf(b, "LatinOffset: %d,\n}\n\n", latinOffset)
+}
@@ -0,0 +1,382 @@
+package ens
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	variationSelector = '\ufe0f'
+	apostrophe        = '’'
+)
+
+//go:generate go run gen.go
+
+// Normalize normalizes a name following ENSIP-15 with tables generated from the UTS-46
+// mapping table and the emoji test data of Unicode 15.1, the Unicode version of ENSIP-15.
+// Fully-qualified emoji sequences are matched first, longest first, and kept without
+// presentation selectors. The text between them is mapped by UTS-46 with the underscore,
+// the dollar sign and the apostrophe added, must be valid in IDNA2008 and is put in NFC.
+// Labels are rejected for misplaced underscores, hyphens, apostrophes or combining marks,
+// letters of mixed scripts, and Cyrillic or Greek labels made only of Latin lookalikes.
+// The script groups and confusables of the ENSIP-15 spec data are not applied.
+func Normalize(name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	labels := strings.Split(name, ".")
+	for i, label := range labels {
+		normalized, err := normalizeLabel(label)
+		if err != nil {
+			return "", fmt.Errorf("invalid ens name %q: %w", name, err)
+		}
+		labels[i] = normalized
+	}
+	return strings.Join(labels, "."), nil
+}
+
+// labelToken is an emoji sequence or a run of text of a label
+type labelToken struct {
+	emoji bool
+	runes []rune
+}
+
+func normalizeLabel(label string) (string, error) {
+	tokens, err := tokenizeLabel([]rune(label))
+	if err != nil {
+		return "", fmt.Errorf("label %q: %w", label, err)
+	}
+	var out []rune
+	for _, tok := range tokens {
+		out = append(out, tok.runes...)
+	}
+	if len(out) == 0 {
+		return "", fmt.Errorf("empty label")
+	}
+	normalized := string(out)
+	if err := validateLabel(tokens, out); err != nil {
+		return "", fmt.Errorf("label %q: %w", normalized, err)
+	}
+	return normalized, nil
+}
+
+// tokenizeLabel splits label into emoji sequences and mapped text
+func tokenizeLabel(label []rune) ([]labelToken, error) {
+	var (
+		tokens []labelToken
+		text   []rune
+	)
+	flush := func() {
+		if len(text) > 0 {
+			tokens = append(tokens, labelToken{runes: []rune(norm.NFC.String(string(text)))})
+			text = nil
+		}
+	}
+	for i := 0; i < len(label); {
+		if n, emoji := matchEmoji(label[i:]); n > 0 {
+			flush()
+			tokens = append(tokens, labelToken{emoji: true, runes: emoji})
+			i += n
+			continue
+		}
+		mapped, err := mapRune(label[i])
+		if err != nil {
+			return nil, err
+		}
+		text = append(text, mapped...)
+		i++
+	}
+	flush()
+	return tokens, nil
+}
+
+// emojiNode is a node of the trie of the emoji sequences
+type emojiNode struct {
+	next  map[rune]*emojiNode
+	emoji bool
+}
+
+var emojiTrie = newEmojiTrie()
+
+func newEmojiTrie() *emojiNode {
+	root := &emojiNode{}
+	for _, seq := range emojiSequences {
+		node := root
+		for _, r := range seq {
+			if node.next == nil {
+				node.next = map[rune]*emojiNode{}
+			}
+			if node.next[r] == nil {
+				node.next[r] = &emojiNode{}
+			}
+			node = node.next[r]
+		}
+		node.emoji = true
+	}
+	return root
+}
+
+// matchEmoji matches the longest emoji sequence at the start of s ignoring presentation
+// selectors, returning the number of runes consumed and the sequence without selectors
+func matchEmoji(s []rune) (int, []rune) {
+	var n int
+	var seq, match []rune
+	node := emojiTrie
+	for i, r := range s {
+		if r == variationSelector {
+			if len(seq) > 0 && n == i {
+				n = i + 1
+			}
+			continue
+		}
+		if node = node.next[r]; node == nil {
+			break
+		}
+		seq = append(seq, r)
+		if node.emoji {
+			n, match = i+1, seq
+		}
+	}
+	return n, match
+}
+
+// mapRune maps a text rune with UTS-46: valid runes are kept, ignored runes removed and
+// mapped runes replaced, other runes are disallowed
+func mapRune(r rune) ([]rune, error) {
+	switch {
+	case r == '_' || r == '$' || r == apostrophe || unicode.Is(textValid, r):
+		return []rune{r}, nil
+	case r == '\'':
+		return []rune{apostrophe}, nil
+	case unicode.Is(textIgnored, r):
+		return nil, nil
+	}
+	i := sort.Search(len(textMapped), func(i int) bool { return textMapped[i].hi >= r })
+	if i < len(textMapped) && textMapped[i].lo <= r {
+		if strings.ContainsRune(textMapped[i].to, '.') {
+			return nil, fmt.Errorf("character %q mapped to a label separator", r)
+		}
+		return []rune(textMapped[i].to), nil
+	}
+	return nil, fmt.Errorf("disallowed character %q", r)
+}
+
+// textMapping maps the runes from lo to hi to the same text
+type textMapping struct {
+	lo, hi rune
+	to     string
+}
+
+func validateLabel(tokens []labelToken, label []rune) error {
+	if len(label) >= 4 && label[2] == '-' && label[3] == '-' {
+		return fmt.Errorf("hyphens at positions 3 and 4")
+	}
+	for i, r := range label {
+		if r == '_' && i > 0 && label[i-1] != '_' {
+			return fmt.Errorf("underscore allowed only at the start")
+		}
+	}
+	var text []rune
+	for i, tok := range tokens {
+		if tok.emoji {
+			continue
+		}
+		if unicode.In(tok.runes[0], unicode.Mn, unicode.Me, unicode.Mc) {
+			if i == 0 {
+				return fmt.Errorf("leading combining mark")
+			}
+			return fmt.Errorf("combining mark after an emoji")
+		}
+		for _, r := range tok.runes {
+			if !validTextRune(r) {
+				return fmt.Errorf("disallowed character %q", r)
+			}
+		}
+		text = append(text, tok.runes...)
+	}
+	if len(text) == 0 {
+		return nil
+	}
+	if err := checkApostrophes(label); err != nil {
+		return err
+	}
+	if err := checkMarks(text); err != nil {
+		return err
+	}
+	return checkScripts(text)
+}
+
+// checkApostrophes rejects apostrophes at the label edges or next to each other
+func checkApostrophes(label []rune) error {
+	for i, r := range label {
+		if r != apostrophe {
+			continue
+		}
+		if i == 0 || i == len(label)-1 || label[i-1] == apostrophe {
+			return fmt.Errorf("misplaced apostrophe")
+		}
+	}
+	return nil
+}
+
+// maxMarks is the maximum number of consecutive nonspacing marks of a decomposed label
+const maxMarks = 4
+
+// checkMarks rejects more than maxMarks or repeated nonspacing marks on a character
+func checkMarks(text []rune) error {
+	decomposed := []rune(norm.NFD.String(string(text)))
+	for i := 0; i < len(decomposed); i++ {
+		j := i
+		for j < len(decomposed) && unicode.Is(unicode.Mn, decomposed[j]) {
+			for _, prev := range decomposed[i:j] {
+				if prev == decomposed[j] {
+					return fmt.Errorf("repeated combining mark %q", decomposed[j])
+				}
+			}
+			j++
+		}
+		if j-i > maxMarks {
+			return fmt.Errorf("more than %d combining marks on a character", maxMarks)
+		}
+		i = j
+	}
+	return nil
+}
+
+// scriptGroups are the scripts allowed together in a label besides a single script
+var scriptGroups = [][]*unicode.RangeTable{
+	{unicode.Han, unicode.Hiragana, unicode.Katakana},
+	{unicode.Han, unicode.Hangul},
+	{unicode.Han, unicode.Bopomofo},
+}
+
+// checkScripts rejects letters of mixed scripts and whole-script confusables
+func checkScripts(text []rune) error {
+	var scripts []*unicode.RangeTable
+	var names []string
+	for _, r := range text {
+		name, script := runeScript(r)
+		if script == nil {
+			continue
+		}
+		found := false
+		for _, s := range scripts {
+			found = found || s == script
+		}
+		if !found {
+			scripts = append(scripts, script)
+			names = append(names, name)
+		}
+	}
+	if len(scripts) > 1 && !inScriptGroup(scripts) {
+		return fmt.Errorf("mixed scripts %v", strings.Join(names, " + "))
+	}
+	if len(scripts) == 1 && (scripts[0] == unicode.Cyrillic || scripts[0] == unicode.Greek) {
+		for _, r := range text {
+			if _, ok := latinLookalikes[r]; !ok && unicode.IsLetter(r) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%v label confusable with a Latin label", names[0])
+	}
+	return nil
+}
+
+// runeScript returns the script of r, nil for the Common and Inherited scripts
+func runeScript(r rune) (string, *unicode.RangeTable) {
+	if r < utf8.RuneSelf {
+		if r >= 'a' && r <= 'z' {
+			return "Latin", unicode.Latin
+		}
+		return "", nil
+	}
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return "", nil
+	}
+	for name, script := range unicode.Scripts {
+		if unicode.Is(script, r) {
+			return name, script
+		}
+	}
+	return "", nil
+}
+
+func inScriptGroup(scripts []*unicode.RangeTable) bool {
+	for _, group := range scriptGroups {
+		all := true
+		for _, script := range scripts {
+			in := false
+			for _, s := range group {
+				in = in || s == script
+			}
+			all = all && in
+		}
+		if all {
+			return true
+		}
+	}
+	return false
+}
+
+// latinLookalikes are the lowercase Cyrillic and Greek letters confusable with Latin letters
+var latinLookalikes = map[rune]rune{
+	'а': 'a', 'с': 'c', 'ԁ': 'd', 'е': 'e', 'һ': 'h', 'і': 'i', 'ј': 'j', 'ӏ': 'l',
+	'о': 'o', 'р': 'p', 'ԛ': 'q', 'ѕ': 's', 'ԝ': 'w', 'х': 'x', 'у': 'y', 'ү': 'y',
+	'α': 'a', 'γ': 'y', 'ι': 'i', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'υ': 'u',
+}
+
+func validTextRune(r rune) bool {
+	return r == '_' || r == '$' || r == apostrophe || unicode.Is(textValid, r)
+}
+
+// LabelHash returns the keccak256 hash of a label
+func LabelHash(label string) common.Hash {
+	return crypto.Keccak256Hash([]byte(label))
+}
+
+// NameHash returns the namehash (EIP-137) of a normalized name, the node of its records
+func NameHash(name string) common.Hash {
+	var node common.Hash
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		node = crypto.Keccak256Hash(node[:], labelHash(labels[i]).Bytes())
+	}
+	return node
+}
+
+// labelHash hashes a label, labels of the form [hash] are already hashed
+func labelHash(label string) common.Hash {
+	if len(label) == 66 && label[0] == '[' && label[65] == ']' {
+		if hash, err := hex.DecodeString(label[1:65]); err == nil {
+			return common.BytesToHash(hash)
+		}
+	}
+	return LabelHash(label)
+}
+
+// DNSEncode encodes a normalized name in DNS wire format for the ENSIP-10 resolve(),
+// labels longer than 255 bytes are encoded by their hash
+func DNSEncode(name string) []byte {
+	var out []byte
+	if name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if len(label) > 255 {
+				label = "[" + hex.EncodeToString(LabelHash(label).Bytes()) + "]"
+			}
+			out = append(out, byte(len(label)))
+			out = append(out, label...)
+		}
+	}
+	return append(out, 0)
+}
@@ -0,0 +1,156 @@
+package ens
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestNormalize(t *testing.T) {
+	valid := map[string]string{
+		"":                     "",
+		"Vitalik.ETH":          "vitalik.eth",
+		"ｖｉｔａｌｉｋ.eth":          "vitalik.eth",
+		"_dev.eth":             "_dev.eth",
+		"__x.eth":              "__x.eth",
+		"a-b.eth":              "a-b.eth",
+		"ß.eth":                "ß.eth",
+		"\U0001f680\ufe0f.eth": "\U0001f680.eth",
+		"1\u20e3.eth":          "1\u20e3.eth",
+		"$1.eth":               "$1.eth",
+		// the ENSIP-15 example
+		"RaFFY\U0001f6b4\u200d\u2642\ufe0f.eTh":          "raffy\U0001f6b4\u200d\u2642.eth",
+		"\U0001f44d\U0001f3fd.eth":                       "\U0001f44d\U0001f3fd.eth",
+		"\U0001f1fa\U0001f1f8.eth":                       "\U0001f1fa\U0001f1f8.eth",
+		"#\ufe0f\u20e3.eth":                              "#\u20e3.eth",
+		"a\u00adb.eth":                                   "ab.eth",
+		"o'neil.eth":                                     "o\u2019neil.eth",
+		"cafe\u0301.eth":                                 "caf\u00e9.eth",
+		"\u0432\u0438\u0442\u0430\u043b\u0438\u043a.eth": "\u0432\u0438\u0442\u0430\u043b\u0438\u043a.eth",
+		"\u6771\u4eac\u30bf\u30ef\u30fc.eth":             "\u6771\u4eac\u30bf\u30ef\u30fc.eth",
+		"\ud55c\uad6d\u8a9e.eth":                         "\ud55c\uad6d\u8a9e.eth",
+		"\u2122.eth":                                     "tm.eth",
+		"\u216b\ufb01.eth":                               "xiifi.eth",
+		"\u2764\ufe0f\u200d\U0001f525.eth":               "\u2764\u200d\U0001f525.eth",
+		"\U0001f468\u200d\U0001f469\u200d\U0001f467.eth": "\U0001f468\u200d\U0001f469\u200d\U0001f467.eth",
+	}
+	for name, want := range valid {
+		got, err := Normalize(name)
+		if err != nil || got != want {
+			t.Fatalf("normalize %q: got %q, %v, want %q", name, got, err, want)
+		}
+	}
+
+	invalid := []string{
+		"a..eth",
+		".eth",
+		"a_b.eth",
+		"ab--c.eth",
+		"a b.eth",
+		"a+b.eth",
+		"\u0301a.eth",
+		"\u200da.eth",
+		"a\u200db.eth",
+		"a\u200cb.eth",
+		"\U0001f680\u200d.eth",
+		"\U0001f680\u0301.eth",
+		"xn--ls8h.eth",
+		"\u2488.eth",
+		"a\u2019.eth",
+		"a\u2019\u2019b.eth",
+		"e\u0301\u0301.eth",
+		"a\u0300\u0301\u0302\u0303\u0304.eth",
+		"\u00a9.eth\u2192",
+		"a\u2192b.eth",
+		"\U0001f3f4\U000e0067.eth",
+		"\U0001f100x.eth",
+		"\u2713.eth",
+		"\u20ac.eth",
+		"\U0001f1fa.eth",
+		"\U0001f3fb.eth",
+		"a\uff0eb.eth",
+		// Latin and Cyrillic
+		"vit\u0430lik.eth",
+		// Latin and Greek
+		"\u03b1pple.eth",
+		// Cyrillic made of Latin lookalikes
+		"\u0430\u0440\u0440\u04cf\u0435.eth",
+		// Greek made of Latin lookalikes
+		"\u03bf.eth",
+		// Latin and Arabic-Indic digits
+		"a\u0661.eth",
+	}
+	for _, name := range invalid {
+		if got, err := Normalize(name); err == nil {
+			t.Fatalf("normalize %q: expected error, got %q", name, got)
+		}
+	}
+}
+
+// TestNormalizeVectors runs the ENSIP-15 test vectors of
+// https://github.com/adraffy/ens-normalize.js/blob/main/validate/tests.json saved as
+// testdata/ensip15-tests.json
+func TestNormalizeVectors(t *testing.T) {
+	data, err := os.ReadFile("testdata/ensip15-tests.json")
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skip("ENSIP-15 test vectors not found")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vectors []struct {
+		Name  string  `json:"name"`
+		Norm  *string `json:"norm"`
+		Error bool    `json:"error"`
+	}
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vectors {
+		got, err := Normalize(v.Name)
+		switch {
+		case v.Error && err == nil:
+			t.Errorf("normalize %+q: expected error, got %+q", v.Name, got)
+		case v.Error:
+		case err != nil:
+			t.Errorf("normalize %+q: %v", v.Name, err)
+		case v.Norm != nil && got != *v.Norm:
+			t.Errorf("normalize %+q: got %+q, want %+q", v.Name, got, *v.Norm)
+		case v.Norm == nil && got != v.Name:
+			t.Errorf("normalize %+q: got %+q, want it unchanged", v.Name, got)
+		}
+	}
+}
+
+func TestNameHash(t *testing.T) {
+	cases := map[string]string{
+		"":        "0x0000000000000000000000000000000000000000000000000000000000000000",
+		"eth":     "0x93cdeb708b7545dc668eb9280176169d1c33cfd8ed6f04690a0bcc88a93fc4ae",
+		"foo.eth": "0xde9b09fd7c5f901e23a3f19fecc54828e9c848539801e86591bd9801b019f84f",
+	}
+	for name, want := range cases {
+		if got := NameHash(name); got != common.HexToHash(want) {
+			t.Fatalf("namehash %q: got %v, want %v", name, got, want)
+		}
+	}
+
+	hashed := "[" + LabelHash("foo").Hex()[2:] + "].eth"
+	if NameHash(hashed) != NameHash("foo.eth") {
+		t.Fatal("hashed label not decoded")
+	}
+}
+
+func TestDNSEncode(t *testing.T) {
+	if got := DNSEncode("foo.eth"); !bytes.Equal(got, hexutil.MustDecode("0x03666f6f0365746800")) {
+		t.Fatalf("unexpected encoding %x", got)
+	}
+	if got := DNSEncode(""); !bytes.Equal(got, []byte{0}) {
+		t.Fatalf("unexpected root encoding %x", got)
+	}
+}
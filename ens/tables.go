@@ -0,0 +1,10698 @@
+// Code generated by "go run gen.go"; DO NOT EDIT.
+
+package ens
+
+import "unicode"
+
+// unicodeVersion is the Unicode version of the tables
+const unicodeVersion = "15.1.0"
+
+// textValid are the characters valid in UTS-46 and IDNA2008
+var textValid = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x002d, 0x002d, 1},
+		{0x0030, 0x0039, 1},
+		{0x0061, 0x007a, 1},
+		{0x00b7, 0x00b7, 1},
+		{0x00df, 0x00f6, 1},
+		{0x00f8, 0x00ff, 1},
+		{0x0101, 0x0101, 1},
+		{0x0103, 0x0103, 1},
+		{0x0105, 0x0105, 1},
+		{0x0107, 0x0107, 1},
+		{0x0109, 0x0109, 1},
+		{0x010b, 0x010b, 1},
+		{0x010d, 0x010d, 1},
+		{0x010f, 0x010f, 1},
+		{0x0111, 0x0111, 1},
+		{0x0113, 0x0113, 1},
+		{0x0115, 0x0115, 1},
+		{0x0117, 0x0117, 1},
+		{0x0119, 0x0119, 1},
+		{0x011b, 0x011b, 1},
+		{0x011d, 0x011d, 1},
+		{0x011f, 0x011f, 1},
+		{0x0121, 0x0121, 1},
+		{0x0123, 0x0123, 1},
+		{0x0125, 0x0125, 1},
+		{0x0127, 0x0127, 1},
+		{0x0129, 0x0129, 1},
+		{0x012b, 0x012b, 1},
+		{0x012d, 0x012d, 1},
+		{0x012f, 0x012f, 1},
+		{0x0131, 0x0131, 1},
+		{0x0135, 0x0135, 1},
+		{0x0137, 0x0138, 1},
+		{0x013a, 0x013a, 1},
+		{0x013c, 0x013c, 1},
+		{0x013e, 0x013e, 1},
+		{0x0142, 0x0142, 1},
+		{0x0144, 0x0144, 1},
+		{0x0146, 0x0146, 1},
+		{0x0148, 0x0148, 1},
+		{0x014b, 0x014b, 1},
+		{0x014d, 0x014d, 1},
+		{0x014f, 0x014f, 1},
+		{0x0151, 0x0151, 1},
+		{0x0153, 0x0153, 1},
+		{0x0155, 0x0155, 1},
+		{0x0157, 0x0157, 1},
+		{0x0159, 0x0159, 1},
+		{0x015b, 0x015b, 1},
+		{0x015d, 0x015d, 1},
+		{0x015f, 0x015f, 1},
+		{0x0161, 0x0161, 1},
+		{0x0163, 0x0163, 1},
+		{0x0165, 0x0165, 1},
+		{0x0167, 0x0167, 1},
+		{0x0169, 0x0169, 1},
+		{0x016b, 0x016b, 1},
+		{0x016d, 0x016d, 1},
+		{0x016f, 0x016f, 1},
+		{0x0171, 0x0171, 1},
+		{0x0173, 0x0173, 1},
+		{0x0175, 0x0175, 1},
+		{0x0177, 0x0177, 1},
+		{0x017a, 0x017a, 1},
+		{0x017c, 0x017c, 1},
+		{0x017e, 0x017e, 1},
+		{0x0180, 0x0180, 1},
+		{0x0183, 0x0183, 1},
+		{0x0185, 0x0185, 1},
+		{0x0188, 0x0188, 1},
+		{0x018c, 0x018d, 1},
+		{0x0192, 0x0192, 1},
+		{0x0195, 0x0195, 1},
+		{0x0199, 0x019b, 1},
+		{0x019e, 0x019e, 1},
+		{0x01a1, 0x01a1, 1},
+		{0x01a3, 0x01a3, 1},
+		{0x01a5, 0x01a5, 1},
+		{0x01a8, 0x01a8, 1},
+		{0x01aa, 0x01ab, 1},
+		{0x01ad, 0x01ad, 1},
+		{0x01b0, 0x01b0, 1},
+		{0x01b4, 0x01b4, 1},
+		{0x01b6, 0x01b6, 1},
+		{0x01b9, 0x01bb, 1},
+		{0x01bd, 0x01c3, 1},
+		{0x01ce, 0x01ce, 1},
+		{0x01d0, 0x01d0, 1},
+		{0x01d2, 0x01d2, 1},
+		{0x01d4, 0x01d4, 1},
+		{0x01d6, 0x01d6, 1},
+		{0x01d8, 0x01d8, 1},
+		{0x01da, 0x01da, 1},
+		{0x01dc, 0x01dd, 1},
+		{0x01df, 0x01df, 1},
+		{0x01e1, 0x01e1, 1},
+		{0x01e3, 0x01e3, 1},
+		{0x01e5, 0x01e5, 1},
+		{0x01e7, 0x01e7, 1},
+		{0x01e9, 0x01e9, 1},
+		{0x01eb, 0x01eb, 1},
+		{0x01ed, 0x01ed, 1},
+		{0x01ef, 0x01f0, 1},
+		{0x01f5, 0x01f5, 1},
+		{0x01f9, 0x01f9, 1},
+		{0x01fb, 0x01fb, 1},
+		{0x01fd, 0x01fd, 1},
+		{0x01ff, 0x01ff, 1},
+		{0x0201, 0x0201, 1},
+		{0x0203, 0x0203, 1},
+		{0x0205, 0x0205, 1},
+		{0x0207, 0x0207, 1},
+		{0x0209, 0x0209, 1},
+		{0x020b, 0x020b, 1},
+		{0x020d, 0x020d, 1},
+		{0x020f, 0x020f, 1},
+		{0x0211, 0x0211, 1},
+		{0x0213, 0x0213, 1},
+		{0x0215, 0x0215, 1},
+		{0x0217, 0x0217, 1},
+		{0x0219, 0x0219, 1},
+		{0x021b, 0x021b, 1},
+		{0x021d, 0x021d, 1},
+		{0x021f, 0x021f, 1},
+		{0x0221, 0x0221, 1},
+		{0x0223, 0x0223, 1},
+		{0x0225, 0x0225, 1},
+		{0x0227, 0x0227, 1},
+		{0x0229, 0x0229, 1},
+		{0x022b, 0x022b, 1},
+		{0x022d, 0x022d, 1},
+		{0x022f, 0x022f, 1},
+		{0x0231, 0x0231, 1},
+		{0x0233, 0x0239, 1},
+		{0x023c, 0x023c, 1},
+		{0x023f, 0x0240, 1},
+		{0x0242, 0x0242, 1},
+		{0x0247, 0x0247, 1},
+		{0x0249, 0x0249, 1},
+		{0x024b, 0x024b, 1},
+		{0x024d, 0x024d, 1},
+		{0x024f, 0x02af, 1},
+		{0x02b9, 0x02c1, 1},
+		{0x02c6, 0x02d1, 1},
+		{0x02ec, 0x02ec, 1},
+		{0x02ee, 0x02ee, 1},
+		{0x0300, 0x033f, 1},
+		{0x0342, 0x0342, 1},
+		{0x0346, 0x034e, 1},
+		{0x0350, 0x036f, 1},
+		{0x0371, 0x0371, 1},
+		{0x0373, 0x0373, 1},
+		{0x0375, 0x0375, 1},
+		{0x0377, 0x0377, 1},
+		{0x037b, 0x037d, 1},
+		{0x0390, 0x0390, 1},
+		{0x03ac, 0x03ce, 1},
+		{0x03d7, 0x03d7, 1},
+		{0x03d9, 0x03d9, 1},
+		{0x03db, 0x03db, 1},
+		{0x03dd, 0x03dd, 1},
+		{0x03df, 0x03df, 1},
+		{0x03e1, 0x03e1, 1},
+		{0x03e3, 0x03e3, 1},
+		{0x03e5, 0x03e5, 1},
+		{0x03e7, 0x03e7, 1},
+		{0x03e9, 0x03e9, 1},
+		{0x03eb, 0x03eb, 1},
+		{0x03ed, 0x03ed, 1},
+		{0x03ef, 0x03ef, 1},
+		{0x03f3, 0x03f3, 1},
+		{0x03f8, 0x03f8, 1},
+		{0x03fb, 0x03fc, 1},
+		{0x0430, 0x045f, 1},
+		{0x0461, 0x0461, 1},
+		{0x0463, 0x0463, 1},
+		{0x0465, 0x0465, 1},
+		{0x0467, 0x0467, 1},
+		{0x0469, 0x0469, 1},
+		{0x046b, 0x046b, 1},
+		{0x046d, 0x046d, 1},
+		{0x046f, 0x046f, 1},
+		{0x0471, 0x0471, 1},
+		{0x0473, 0x0473, 1},
+		{0x0475, 0x0475, 1},
+		{0x0477, 0x0477, 1},
+		{0x0479, 0x0479, 1},
+		{0x047b, 0x047b, 1},
+		{0x047d, 0x047d, 1},
+		{0x047f, 0x047f, 1},
+		{0x0481, 0x0481, 1},
+		{0x0483, 0x0487, 1},
+		{0x048b, 0x048b, 1},
+		{0x048d, 0x048d, 1},
+		{0x048f, 0x048f, 1},
+		{0x0491, 0x0491, 1},
+		{0x0493, 0x0493, 1},
+		{0x0495, 0x0495, 1},
+		{0x0497, 0x0497, 1},
+		{0x0499, 0x0499, 1},
+		{0x049b, 0x049b, 1},
+		{0x049d, 0x049d, 1},
+		{0x049f, 0x049f, 1},
+		{0x04a1, 0x04a1, 1},
+		{0x04a3, 0x04a3, 1},
+		{0x04a5, 0x04a5, 1},
+		{0x04a7, 0x04a7, 1},
+		{0x04a9, 0x04a9, 1},
+		{0x04ab, 0x04ab, 1},
+		{0x04ad, 0x04ad, 1},
+		{0x04af, 0x04af, 1},
+		{0x04b1, 0x04b1, 1},
+		{0x04b3, 0x04b3, 1},
+		{0x04b5, 0x04b5, 1},
+		{0x04b7, 0x04b7, 1},
+		{0x04b9, 0x04b9, 1},
+		{0x04bb, 0x04bb, 1},
+		{0x04bd, 0x04bd, 1},
+		{0x04bf, 0x04bf, 1},
+		{0x04c2, 0x04c2, 1},
+		{0x04c4, 0x04c4, 1},
+		{0x04c6, 0x04c6, 1},
+		{0x04c8, 0x04c8, 1},
+		{0x04ca, 0x04ca, 1},
+		{0x04cc, 0x04cc, 1},
+		{0x04ce, 0x04cf, 1},
+		{0x04d1, 0x04d1, 1},
+		{0x04d3, 0x04d3, 1},
+		{0x04d5, 0x04d5, 1},
+		{0x04d7, 0x04d7, 1},
+		{0x04d9, 0x04d9, 1},
+		{0x04db, 0x04db, 1},
+		{0x04dd, 0x04dd, 1},
+		{0x04df, 0x04df, 1},
+		{0x04e1, 0x04e1, 1},
+		{0x04e3, 0x04e3, 1},
+		{0x04e5, 0x04e5, 1},
+		{0x04e7, 0x04e7, 1},
+		{0x04e9, 0x04e9, 1},
+		{0x04eb, 0x04eb, 1},
+		{0x04ed, 0x04ed, 1},
+		{0x04ef, 0x04ef, 1},
+		{0x04f1, 0x04f1, 1},
+		{0x04f3, 0x04f3, 1},
+		{0x04f5, 0x04f5, 1},
+		{0x04f7, 0x04f7, 1},
+		{0x04f9, 0x04f9, 1},
+		{0x04fb, 0x04fb, 1},
+		{0x04fd, 0x04fd, 1},
+		{0x04ff, 0x04ff, 1},
+		{0x0501, 0x0501, 1},
+		{0x0503, 0x0503, 1},
+		{0x0505, 0x0505, 1},
+		{0x0507, 0x0507, 1},
+		{0x0509, 0x0509, 1},
+		{0x050b, 0x050b, 1},
+		{0x050d, 0x050d, 1},
+		{0x050f, 0x050f, 1},
+		{0x0511, 0x0511, 1},
+		{0x0513, 0x0513, 1},
+		{0x0515, 0x0515, 1},
+		{0x0517, 0x0517, 1},
+		{0x0519, 0x0519, 1},
+		{0x051b, 0x051b, 1},
+		{0x051d, 0x051d, 1},
+		{0x051f, 0x051f, 1},
+		{0x0521, 0x0521, 1},
+		{0x0523, 0x0523, 1},
+		{0x0525, 0x0525, 1},
+		{0x0527, 0x0527, 1},
+		{0x0529, 0x0529, 1},
+		{0x052b, 0x052b, 1},
+		{0x052d, 0x052d, 1},
+		{0x052f, 0x052f, 1},
+		{0x0559, 0x0559, 1},
+		{0x0560, 0x0586, 1},
+		{0x0588, 0x0588, 1},
+		{0x0591, 0x05bd, 1},
+		{0x05bf, 0x05bf, 1},
+		{0x05c1, 0x05c2, 1},
+		{0x05c4, 0x05c5, 1},
+		{0x05c7, 0x05c7, 1},
+		{0x05d0, 0x05ea, 1},
+		{0x05ef, 0x05f4, 1},
+		{0x0610, 0x061a, 1},
+		{0x0620, 0x063f, 1},
+		{0x0641, 0x0669, 1},
+		{0x066e, 0x0674, 1},
+		{0x0679, 0x06d3, 1},
+		{0x06d5, 0x06dc, 1},
+		{0x06df, 0x06e8, 1},
+		{0x06ea, 0x06ff, 1},
+		{0x0710, 0x074a, 1},
+		{0x074d, 0x07b1, 1},
+		{0x07c0, 0x07f5, 1},
+		{0x07fd, 0x07fd, 1},
+		{0x0800, 0x082d, 1},
+		{0x0840, 0x085b, 1},
+		{0x0860, 0x086a, 1},
+		{0x0870, 0x0887, 1},
+		{0x0889, 0x088e, 1},
+		{0x0898, 0x08e1, 1},
+		{0x08e3, 0x0957, 1},
+		{0x0960, 0x0963, 1},
+		{0x0966, 0x096f, 1},
+		{0x0971, 0x0983, 1},
+		{0x0985, 0x098c, 1},
+		{0x098f, 0x0990, 1},
+		{0x0993, 0x09a8, 1},
+		{0x09aa, 0x09b0, 1},
+		{0x09b2, 0x09b2, 1},
+		{0x09b6, 0x09b9, 1},
+		{0x09bc, 0x09c4, 1},
+		{0x09c7, 0x09c8, 1},
+		{0x09cb, 0x09ce, 1},
+		{0x09d7, 0x09d7, 1},
+		{0x09e0, 0x09e3, 1},
+		{0x09e6, 0x09f1, 1},
+		{0x09fc, 0x09fc, 1},
+		{0x09fe, 0x09fe, 1},
+		{0x0a01, 0x0a03, 1},
+		{0x0a05, 0x0a0a, 1},
+		{0x0a0f, 0x0a10, 1},
+		{0x0a13, 0x0a28, 1},
+		{0x0a2a, 0x0a30, 1},
+		{0x0a32, 0x0a32, 1},
+		{0x0a35, 0x0a35, 1},
+		{0x0a38, 0x0a39, 1},
+		{0x0a3c, 0x0a3c, 1},
+		{0x0a3e, 0x0a42, 1},
+		{0x0a47, 0x0a48, 1},
+		{0x0a4b, 0x0a4d, 1},
+		{0x0a51, 0x0a51, 1},
+		{0x0a5c, 0x0a5c, 1},
+		{0x0a66, 0x0a75, 1},
+		{0x0a81, 0x0a83, 1},
+		{0x0a85, 0x0a8d, 1},
+		{0x0a8f, 0x0a91, 1},
+		{0x0a93, 0x0aa8, 1},
+		{0x0aaa, 0x0ab0, 1},
+		{0x0ab2, 0x0ab3, 1},
+		{0x0ab5, 0x0ab9, 1},
+		{0x0abc, 0x0ac5, 1},
+		{0x0ac7, 0x0ac9, 1},
+		{0x0acb, 0x0acd, 1},
+		{0x0ad0, 0x0ad0, 1},
+		{0x0ae0, 0x0ae3, 1},
+		{0x0ae6, 0x0aef, 1},
+		{0x0af9, 0x0aff, 1},
+		{0x0b01, 0x0b03, 1},
+		{0x0b05, 0x0b0c, 1},
+		{0x0b0f, 0x0b10, 1},
+		{0x0b13, 0x0b28, 1},
+		{0x0b2a, 0x0b30, 1},
+		{0x0b32, 0x0b33, 1},
+		{0x0b35, 0x0b39, 1},
+		{0x0b3c, 0x0b44, 1},
+		{0x0b47, 0x0b48, 1},
+		{0x0b4b, 0x0b4d, 1},
+		{0x0b55, 0x0b57, 1},
+		{0x0b5f, 0x0b63, 1},
+		{0x0b66, 0x0b6f, 1},
+		{0x0b71, 0x0b71, 1},
+		{0x0b82, 0x0b83, 1},
+		{0x0b85, 0x0b8a, 1},
+		{0x0b8e, 0x0b90, 1},
+		{0x0b92, 0x0b95, 1},
+		{0x0b99, 0x0b9a, 1},
+		{0x0b9c, 0x0b9c, 1},
+		{0x0b9e, 0x0b9f, 1},
+		{0x0ba3, 0x0ba4, 1},
+		{0x0ba8, 0x0baa, 1},
+		{0x0bae, 0x0bb9, 1},
+		{0x0bbe, 0x0bc2, 1},
+		{0x0bc6, 0x0bc8, 1},
+		{0x0bca, 0x0bcd, 1},
+		{0x0bd0, 0x0bd0, 1},
+		{0x0bd7, 0x0bd7, 1},
+		{0x0be6, 0x0bef, 1},
+		{0x0c00, 0x0c0c, 1},
+		{0x0c0e, 0x0c10, 1},
+		{0x0c12, 0x0c28, 1},
+		{0x0c2a, 0x0c39, 1},
+		{0x0c3c, 0x0c44, 1},
+		{0x0c46, 0x0c48, 1},
+		{0x0c4a, 0x0c4d, 1},
+		{0x0c55, 0x0c56, 1},
+		{0x0c58, 0x0c5a, 1},
+		{0x0c5d, 0x0c5d, 1},
+		{0x0c60, 0x0c63, 1},
+		{0x0c66, 0x0c6f, 1},
+		{0x0c80, 0x0c83, 1},
+		{0x0c85, 0x0c8c, 1},
+		{0x0c8e, 0x0c90, 1},
+		{0x0c92, 0x0ca8, 1},
+		{0x0caa, 0x0cb3, 1},
+		{0x0cb5, 0x0cb9, 1},
+		{0x0cbc, 0x0cc4, 1},
+		{0x0cc6, 0x0cc8, 1},
+		{0x0cca, 0x0ccd, 1},
+		{0x0cd5, 0x0cd6, 1},
+		{0x0cdd, 0x0cde, 1},
+		{0x0ce0, 0x0ce3, 1},
+		{0x0ce6, 0x0cef, 1},
+		{0x0cf1, 0x0cf3, 1},
+		{0x0d00, 0x0d0c, 1},
+		{0x0d0e, 0x0d10, 1},
+		{0x0d12, 0x0d44, 1},
+		{0x0d46, 0x0d48, 1},
+		{0x0d4a, 0x0d4e, 1},
+		{0x0d54, 0x0d57, 1},
+		{0x0d5f, 0x0d63, 1},
+		{0x0d66, 0x0d6f, 1},
+		{0x0d7a, 0x0d7f, 1},
+		{0x0d81, 0x0d83, 1},
+		{0x0d85, 0x0d96, 1},
+		{0x0d9a, 0x0db1, 1},
+		{0x0db3, 0x0dbb, 1},
+		{0x0dbd, 0x0dbd, 1},
+		{0x0dc0, 0x0dc6, 1},
+		{0x0dca, 0x0dca, 1},
+		{0x0dcf, 0x0dd4, 1},
+		{0x0dd6, 0x0dd6, 1},
+		{0x0dd8, 0x0ddf, 1},
+		{0x0de6, 0x0def, 1},
+		{0x0df2, 0x0df3, 1},
+		{0x0e01, 0x0e32, 1},
+		{0x0e34, 0x0e3a, 1},
+		{0x0e40, 0x0e4e, 1},
+		{0x0e50, 0x0e59, 1},
+		{0x0e81, 0x0e82, 1},
+		{0x0e84, 0x0e84, 1},
+		{0x0e86, 0x0e8a, 1},
+		{0x0e8c, 0x0ea3, 1},
+		{0x0ea5, 0x0ea5, 1},
+		{0x0ea7, 0x0eb2, 1},
+		{0x0eb4, 0x0ebd, 1},
+		{0x0ec0, 0x0ec4, 1},
+		{0x0ec6, 0x0ec6, 1},
+		{0x0ec8, 0x0ece, 1},
+		{0x0ed0, 0x0ed9, 1},
+		{0x0ede, 0x0edf, 1},
+		{0x0f00, 0x0f00, 1},
+		{0x0f0b, 0x0f0b, 1},
+		{0x0f18, 0x0f19, 1},
+		{0x0f20, 0x0f29, 1},
+		{0x0f35, 0x0f35, 1},
+		{0x0f37, 0x0f37, 1},
+		{0x0f39, 0x0f39, 1},
+		{0x0f3e, 0x0f42, 1},
+		{0x0f44, 0x0f47, 1},
+		{0x0f49, 0x0f4c, 1},
+		{0x0f4e, 0x0f51, 1},
+		{0x0f53, 0x0f56, 1},
+		{0x0f58, 0x0f5b, 1},
+		{0x0f5d, 0x0f68, 1},
+		{0x0f6a, 0x0f6c, 1},
+		{0x0f71, 0x0f72, 1},
+		{0x0f74, 0x0f74, 1},
+		{0x0f7a, 0x0f80, 1},
+		{0x0f82, 0x0f84, 1},
+		{0x0f86, 0x0f92, 1},
+		{0x0f94, 0x0f97, 1},
+		{0x0f99, 0x0f9c, 1},
+		{0x0f9e, 0x0fa1, 1},
+		{0x0fa3, 0x0fa6, 1},
+		{0x0fa8, 0x0fab, 1},
+		{0x0fad, 0x0fb8, 1},
+		{0x0fba, 0x0fbc, 1},
+		{0x0fc6, 0x0fc6, 1},
+		{0x1000, 0x1049, 1},
+		{0x1050, 0x109d, 1},
+		{0x10d0, 0x10fa, 1},
+		{0x10fd, 0x10ff, 1},
+		{0x1200, 0x1248, 1},
+		{0x124a, 0x124d, 1},
+		{0x1250, 0x1256, 1},
+		{0x1258, 0x1258, 1},
+		{0x125a, 0x125d, 1},
+		{0x1260, 0x1288, 1},
+		{0x128a, 0x128d, 1},
+		{0x1290, 0x12b0, 1},
+		{0x12b2, 0x12b5, 1},
+		{0x12b8, 0x12be, 1},
+		{0x12c0, 0x12c0, 1},
+		{0x12c2, 0x12c5, 1},
+		{0x12c8, 0x12d6, 1},
+		{0x12d8, 0x1310, 1},
+		{0x1312, 0x1315, 1},
+		{0x1318, 0x135a, 1},
+		{0x135d, 0x135f, 1},
+		{0x1380, 0x138f, 1},
+		{0x13a0, 0x13f5, 1},
+		{0x1401, 0x166c, 1},
+		{0x166f, 0x167f, 1},
+		{0x1681, 0x169a, 1},
+		{0x16a0, 0x16ea, 1},
+		{0x16f1, 0x16f8, 1},
+		{0x1700, 0x1715, 1},
+		{0x171f, 0x1734, 1},
+		{0x1740, 0x1753, 1},
+		{0x1760, 0x176c, 1},
+		{0x176e, 0x1770, 1},
+		{0x1772, 0x1773, 1},
+		{0x1780, 0x17b3, 1},
+		{0x17b6, 0x17d3, 1},
+		{0x17d7, 0x17d7, 1},
+		{0x17dc, 0x17dd, 1},
+		{0x17e0, 0x17e9, 1},
+		{0x1810, 0x1819, 1},
+		{0x1820, 0x1878, 1},
+		{0x1880, 0x18aa, 1},
+		{0x18b0, 0x18f5, 1},
+		{0x1900, 0x191e, 1},
+		{0x1920, 0x192b, 1},
+		{0x1930, 0x193b, 1},
+		{0x1946, 0x196d, 1},
+		{0x1970, 0x1974, 1},
+		{0x1980, 0x19ab, 1},
+		{0x19b0, 0x19c9, 1},
+		{0x19d0, 0x19d9, 1},
+		{0x1a00, 0x1a1b, 1},
+		{0x1a20, 0x1a5e, 1},
+		{0x1a60, 0x1a7c, 1},
+		{0x1a7f, 0x1a89, 1},
+		{0x1a90, 0x1a99, 1},
+		{0x1aa7, 0x1aa7, 1},
+		{0x1ab0, 0x1abd, 1},
+		{0x1abf, 0x1ace, 1},
+		{0x1b00, 0x1b4c, 1},
+		{0x1b50, 0x1b59, 1},
+		{0x1b6b, 0x1b73, 1},
+		{0x1b80, 0x1bf3, 1},
+		{0x1c00, 0x1c37, 1},
+		{0x1c40, 0x1c49, 1},
+		{0x1c4d, 0x1c7d, 1},
+		{0x1cd0, 0x1cd2, 1},
+		{0x1cd4, 0x1cfa, 1},
+		{0x1d00, 0x1d2b, 1},
+		{0x1d2f, 0x1d2f, 1},
+		{0x1d3b, 0x1d3b, 1},
+		{0x1d4e, 0x1d4e, 1},
+		{0x1d6b, 0x1d77, 1},
+		{0x1d79, 0x1d9a, 1},
+		{0x1dc0, 0x1dff, 1},
+		{0x1e01, 0x1e01, 1},
+		{0x1e03, 0x1e03, 1},
+		{0x1e05, 0x1e05, 1},
+		{0x1e07, 0x1e07, 1},
+		{0x1e09, 0x1e09, 1},
+		{0x1e0b, 0x1e0b, 1},
+		{0x1e0d, 0x1e0d, 1},
+		{0x1e0f, 0x1e0f, 1},
+		{0x1e11, 0x1e11, 1},
+		{0x1e13, 0x1e13, 1},
+		{0x1e15, 0x1e15, 1},
+		{0x1e17, 0x1e17, 1},
+		{0x1e19, 0x1e19, 1},
+		{0x1e1b, 0x1e1b, 1},
+		{0x1e1d, 0x1e1d, 1},
+		{0x1e1f, 0x1e1f, 1},
+		{0x1e21, 0x1e21, 1},
+		{0x1e23, 0x1e23, 1},
+		{0x1e25, 0x1e25, 1},
+		{0x1e27, 0x1e27, 1},
+		{0x1e29, 0x1e29, 1},
+		{0x1e2b, 0x1e2b, 1},
+		{0x1e2d, 0x1e2d, 1},
+		{0x1e2f, 0x1e2f, 1},
+		{0x1e31, 0x1e31, 1},
+		{0x1e33, 0x1e33, 1},
+		{0x1e35, 0x1e35, 1},
+		{0x1e37, 0x1e37, 1},
+		{0x1e39, 0x1e39, 1},
+		{0x1e3b, 0x1e3b, 1},
+		{0x1e3d, 0x1e3d, 1},
+		{0x1e3f, 0x1e3f, 1},
+		{0x1e41, 0x1e41, 1},
+		{0x1e43, 0x1e43, 1},
+		{0x1e45, 0x1e45, 1},
+		{0x1e47, 0x1e47, 1},
+		{0x1e49, 0x1e49, 1},
+		{0x1e4b, 0x1e4b, 1},
+		{0x1e4d, 0x1e4d, 1},
+		{0x1e4f, 0x1e4f, 1},
+		{0x1e51, 0x1e51, 1},
+		{0x1e53, 0x1e53, 1},
+		{0x1e55, 0x1e55, 1},
+		{0x1e57, 0x1e57, 1},
+		{0x1e59, 0x1e59, 1},
+		{0x1e5b, 0x1e5b, 1},
+		{0x1e5d, 0x1e5d, 1},
+		{0x1e5f, 0x1e5f, 1},
+		{0x1e61, 0x1e61, 1},
+		{0x1e63, 0x1e63, 1},
+		{0x1e65, 0x1e65, 1},
+		{0x1e67, 0x1e67, 1},
+		{0x1e69, 0x1e69, 1},
+		{0x1e6b, 0x1e6b, 1},
+		{0x1e6d, 0x1e6d, 1},
+		{0x1e6f, 0x1e6f, 1},
+		{0x1e71, 0x1e71, 1},
+		{0x1e73, 0x1e73, 1},
+		{0x1e75, 0x1e75, 1},
+		{0x1e77, 0x1e77, 1},
+		{0x1e79, 0x1e79, 1},
+		{0x1e7b, 0x1e7b, 1},
+		{0x1e7d, 0x1e7d, 1},
+		{0x1e7f, 0x1e7f, 1},
+		{0x1e81, 0x1e81, 1},
+		{0x1e83, 0x1e83, 1},
+		{0x1e85, 0x1e85, 1},
+		{0x1e87, 0x1e87, 1},
+		{0x1e89, 0x1e89, 1},
+		{0x1e8b, 0x1e8b, 1},
+		{0x1e8d, 0x1e8d, 1},
+		{0x1e8f, 0x1e8f, 1},
+		{0x1e91, 0x1e91, 1},
+		{0x1e93, 0x1e93, 1},
+		{0x1e95, 0x1e99, 1},
+		{0x1e9c, 0x1e9d, 1},
+		{0x1e9f, 0x1e9f, 1},
+		{0x1ea1, 0x1ea1, 1},
+		{0x1ea3, 0x1ea3, 1},
+		{0x1ea5, 0x1ea5, 1},
+		{0x1ea7, 0x1ea7, 1},
+		{0x1ea9, 0x1ea9, 1},
+		{0x1eab, 0x1eab, 1},
+		{0x1ead, 0x1ead, 1},
+		{0x1eaf, 0x1eaf, 1},
+		{0x1eb1, 0x1eb1, 1},
+		{0x1eb3, 0x1eb3, 1},
+		{0x1eb5, 0x1eb5, 1},
+		{0x1eb7, 0x1eb7, 1},
+		{0x1eb9, 0x1eb9, 1},
+		{0x1ebb, 0x1ebb, 1},
+		{0x1ebd, 0x1ebd, 1},
+		{0x1ebf, 0x1ebf, 1},
+		{0x1ec1, 0x1ec1, 1},
+		{0x1ec3, 0x1ec3, 1},
+		{0x1ec5, 0x1ec5, 1},
+		{0x1ec7, 0x1ec7, 1},
+		{0x1ec9, 0x1ec9, 1},
+		{0x1ecb, 0x1ecb, 1},
+		{0x1ecd, 0x1ecd, 1},
+		{0x1ecf, 0x1ecf, 1},
+		{0x1ed1, 0x1ed1, 1},
+		{0x1ed3, 0x1ed3, 1},
+		{0x1ed5, 0x1ed5, 1},
+		{0x1ed7, 0x1ed7, 1},
+		{0x1ed9, 0x1ed9, 1},
+		{0x1edb, 0x1edb, 1},
+		{0x1edd, 0x1edd, 1},
+		{0x1edf, 0x1edf, 1},
+		{0x1ee1, 0x1ee1, 1},
+		{0x1ee3, 0x1ee3, 1},
+		{0x1ee5, 0x1ee5, 1},
+		{0x1ee7, 0x1ee7, 1},
+		{0x1ee9, 0x1ee9, 1},
+		{0x1eeb, 0x1eeb, 1},
+		{0x1eed, 0x1eed, 1},
+		{0x1eef, 0x1eef, 1},
+		{0x1ef1, 0x1ef1, 1},
+		{0x1ef3, 0x1ef3, 1},
+		{0x1ef5, 0x1ef5, 1},
+		{0x1ef7, 0x1ef7, 1},
+		{0x1ef9, 0x1ef9, 1},
+		{0x1efb, 0x1efb, 1},
+		{0x1efd, 0x1efd, 1},
+		{0x1eff, 0x1f07, 1},
+		{0x1f10, 0x1f15, 1},
+		{0x1f20, 0x1f27, 1},
+		{0x1f30, 0x1f37, 1},
+		{0x1f40, 0x1f45, 1},
+		{0x1f50, 0x1f57, 1},
+		{0x1f60, 0x1f67, 1},
+		{0x1f70, 0x1f70, 1},
+		{0x1f72, 0x1f72, 1},
+		{0x1f74, 0x1f74, 1},
+		{0x1f76, 0x1f76, 1},
+		{0x1f78, 0x1f78, 1},
+		{0x1f7a, 0x1f7a, 1},
+		{0x1f7c, 0x1f7c, 1},
+		{0x1fb0, 0x1fb1, 1},
+		{0x1fb6, 0x1fb6, 1},
+		{0x1fc6, 0x1fc6, 1},
+		{0x1fd0, 0x1fd2, 1},
+		{0x1fd6, 0x1fd7, 1},
+		{0x1fe0, 0x1fe2, 1},
+		{0x1fe4, 0x1fe7, 1},
+		{0x1ff6, 0x1ff6, 1},
+		{0x214e, 0x214e, 1},
+		{0x2184, 0x2184, 1},
+		{0x2c30, 0x2c5f, 1},
+		{0x2c61, 0x2c61, 1},
+		{0x2c65, 0x2c66, 1},
+		{0x2c68, 0x2c68, 1},
+		{0x2c6a, 0x2c6a, 1},
+		{0x2c6c, 0x2c6c, 1},
+		{0x2c71, 0x2c71, 1},
+		{0x2c73, 0x2c74, 1},
+		{0x2c76, 0x2c7b, 1},
+		{0x2c81, 0x2c81, 1},
+		{0x2c83, 0x2c83, 1},
+		{0x2c85, 0x2c85, 1},
+		{0x2c87, 0x2c87, 1},
+		{0x2c89, 0x2c89, 1},
+		{0x2c8b, 0x2c8b, 1},
+		{0x2c8d, 0x2c8d, 1},
+		{0x2c8f, 0x2c8f, 1},
+		{0x2c91, 0x2c91, 1},
+		{0x2c93, 0x2c93, 1},
+		{0x2c95, 0x2c95, 1},
+		{0x2c97, 0x2c97, 1},
+		{0x2c99, 0x2c99, 1},
+		{0x2c9b, 0x2c9b, 1},
+		{0x2c9d, 0x2c9d, 1},
+		{0x2c9f, 0x2c9f, 1},
+		{0x2ca1, 0x2ca1, 1},
+		{0x2ca3, 0x2ca3, 1},
+		{0x2ca5, 0x2ca5, 1},
+		{0x2ca7, 0x2ca7, 1},
+		{0x2ca9, 0x2ca9, 1},
+		{0x2cab, 0x2cab, 1},
+		{0x2cad, 0x2cad, 1},
+		{0x2caf, 0x2caf, 1},
+		{0x2cb1, 0x2cb1, 1},
+		{0x2cb3, 0x2cb3, 1},
+		{0x2cb5, 0x2cb5, 1},
+		{0x2cb7, 0x2cb7, 1},
+		{0x2cb9, 0x2cb9, 1},
+		{0x2cbb, 0x2cbb, 1},
+		{0x2cbd, 0x2cbd, 1},
+		{0x2cbf, 0x2cbf, 1},
+		{0x2cc1, 0x2cc1, 1},
+		{0x2cc3, 0x2cc3, 1},
+		{0x2cc5, 0x2cc5, 1},
+		{0x2cc7, 0x2cc7, 1},
+		{0x2cc9, 0x2cc9, 1},
+		{0x2ccb, 0x2ccb, 1},
+		{0x2ccd, 0x2ccd, 1},
+		{0x2ccf, 0x2ccf, 1},
+		{0x2cd1, 0x2cd1, 1},
+		{0x2cd3, 0x2cd3, 1},
+		{0x2cd5, 0x2cd5, 1},
+		{0x2cd7, 0x2cd7, 1},
+		{0x2cd9, 0x2cd9, 1},
+		{0x2cdb, 0x2cdb, 1},
+		{0x2cdd, 0x2cdd, 1},
+		{0x2cdf, 0x2cdf, 1},
+		{0x2ce1, 0x2ce1, 1},
+		{0x2ce3, 0x2ce4, 1},
+		{0x2cec, 0x2cec, 1},
+		{0x2cee, 0x2cf1, 1},
+		{0x2cf3, 0x2cf3, 1},
+		{0x2d00, 0x2d25, 1},
+		{0x2d27, 0x2d27, 1},
+		{0x2d2d, 0x2d2d, 1},
+		{0x2d30, 0x2d67, 1},
+		{0x2d7f, 0x2d96, 1},
+		{0x2da0, 0x2da6, 1},
+		{0x2da8, 0x2dae, 1},
+		{0x2db0, 0x2db6, 1},
+		{0x2db8, 0x2dbe, 1},
+		{0x2dc0, 0x2dc6, 1},
+		{0x2dc8, 0x2dce, 1},
+		{0x2dd0, 0x2dd6, 1},
+		{0x2dd8, 0x2dde, 1},
+		{0x2de0, 0x2dff, 1},
+		{0x2e2f, 0x2e2f, 1},
+		{0x3005, 0x3007, 1},
+		{0x302a, 0x302d, 1},
+		{0x303c, 0x303c, 1},
+		{0x3041, 0x3096, 1},
+		{0x3099, 0x309a, 1},
+		{0x309d, 0x309e, 1},
+		{0x30a1, 0x30fe, 1},
+		{0x3105, 0x312f, 1},
+		{0x31a0, 0x31bf, 1},
+		{0x31f0, 0x31ff, 1},
+		{0x3400, 0x4dbf, 1},
+		{0x4e00, 0xa48c, 1},
+		{0xa4d0, 0xa4fd, 1},
+		{0xa500, 0xa60c, 1},
+		{0xa610, 0xa62b, 1},
+		{0xa641, 0xa641, 1},
+		{0xa643, 0xa643, 1},
+		{0xa645, 0xa645, 1},
+		{0xa647, 0xa647, 1},
+		{0xa649, 0xa649, 1},
+		{0xa64b, 0xa64b, 1},
+		{0xa64d, 0xa64d, 1},
+		{0xa64f, 0xa64f, 1},
+		{0xa651, 0xa651, 1},
+		{0xa653, 0xa653, 1},
+		{0xa655, 0xa655, 1},
+		{0xa657, 0xa657, 1},
+		{0xa659, 0xa659, 1},
+		{0xa65b, 0xa65b, 1},
+		{0xa65d, 0xa65d, 1},
+		{0xa65f, 0xa65f, 1},
+		{0xa661, 0xa661, 1},
+		{0xa663, 0xa663, 1},
+		{0xa665, 0xa665, 1},
+		{0xa667, 0xa667, 1},
+		{0xa669, 0xa669, 1},
+		{0xa66b, 0xa66b, 1},
+		{0xa66d, 0xa66f, 1},
+		{0xa674, 0xa67d, 1},
+		{0xa67f, 0xa67f, 1},
+		{0xa681, 0xa681, 1},
+		{0xa683, 0xa683, 1},
+		{0xa685, 0xa685, 1},
+		{0xa687, 0xa687, 1},
+		{0xa689, 0xa689, 1},
+		{0xa68b, 0xa68b, 1},
+		{0xa68d, 0xa68d, 1},
+		{0xa68f, 0xa68f, 1},
+		{0xa691, 0xa691, 1},
+		{0xa693, 0xa693, 1},
+		{0xa695, 0xa695, 1},
+		{0xa697, 0xa697, 1},
+		{0xa699, 0xa699, 1},
+		{0xa69b, 0xa69b, 1},
+		{0xa69e, 0xa6e5, 1},
+		{0xa6f0, 0xa6f1, 1},
+		{0xa717, 0xa71f, 1},
+		{0xa723, 0xa723, 1},
+		{0xa725, 0xa725, 1},
+		{0xa727, 0xa727, 1},
+		{0xa729, 0xa729, 1},
+		{0xa72b, 0xa72b, 1},
+		{0xa72d, 0xa72d, 1},
+		{0xa72f, 0xa731, 1},
+		{0xa733, 0xa733, 1},
+		{0xa735, 0xa735, 1},
+		{0xa737, 0xa737, 1},
+		{0xa739, 0xa739, 1},
+		{0xa73b, 0xa73b, 1},
+		{0xa73d, 0xa73d, 1},
+		{0xa73f, 0xa73f, 1},
+		{0xa741, 0xa741, 1},
+		{0xa743, 0xa743, 1},
+		{0xa745, 0xa745, 1},
+		{0xa747, 0xa747, 1},
+		{0xa749, 0xa749, 1},
+		{0xa74b, 0xa74b, 1},
+		{0xa74d, 0xa74d, 1},
+		{0xa74f, 0xa74f, 1},
+		{0xa751, 0xa751, 1},
+		{0xa753, 0xa753, 1},
+		{0xa755, 0xa755, 1},
+		{0xa757, 0xa757, 1},
+		{0xa759, 0xa759, 1},
+		{0xa75b, 0xa75b, 1},
+		{0xa75d, 0xa75d, 1},
+		{0xa75f, 0xa75f, 1},
+		{0xa761, 0xa761, 1},
+		{0xa763, 0xa763, 1},
+		{0xa765, 0xa765, 1},
+		{0xa767, 0xa767, 1},
+		{0xa769, 0xa769, 1},
+		{0xa76b, 0xa76b, 1},
+		{0xa76d, 0xa76d, 1},
+		{0xa76f, 0xa76f, 1},
+		{0xa771, 0xa778, 1},
+		{0xa77a, 0xa77a, 1},
+		{0xa77c, 0xa77c, 1},
+		{0xa77f, 0xa77f, 1},
+		{0xa781, 0xa781, 1},
+		{0xa783, 0xa783, 1},
+		{0xa785, 0xa785, 1},
+		{0xa787, 0xa788, 1},
+		{0xa78c, 0xa78c, 1},
+		{0xa78e, 0xa78f, 1},
+		{0xa791, 0xa791, 1},
+		{0xa793, 0xa795, 1},
+		{0xa797, 0xa797, 1},
+		{0xa799, 0xa799, 1},
+		{0xa79b, 0xa79b, 1},
+		{0xa79d, 0xa79d, 1},
+		{0xa79f, 0xa79f, 1},
+		{0xa7a1, 0xa7a1, 1},
+		{0xa7a3, 0xa7a3, 1},
+		{0xa7a5, 0xa7a5, 1},
+		{0xa7a7, 0xa7a7, 1},
+		{0xa7a9, 0xa7a9, 1},
+		{0xa7af, 0xa7af, 1},
+		{0xa7b5, 0xa7b5, 1},
+		{0xa7b7, 0xa7b7, 1},
+		{0xa7b9, 0xa7b9, 1},
+		{0xa7bb, 0xa7bb, 1},
+		{0xa7bd, 0xa7bd, 1},
+		{0xa7bf, 0xa7bf, 1},
+		{0xa7c1, 0xa7c1, 1},
+		{0xa7c3, 0xa7c3, 1},
+		{0xa7c8, 0xa7c8, 1},
+		{0xa7ca, 0xa7ca, 1},
+		{0xa7d1, 0xa7d1, 1},
+		{0xa7d3, 0xa7d3, 1},
+		{0xa7d5, 0xa7d5, 1},
+		{0xa7d7, 0xa7d7, 1},
+		{0xa7d9, 0xa7d9, 1},
+		{0xa7f6, 0xa7f7, 1},
+		{0xa7fa, 0xa827, 1},
+		{0xa82c, 0xa82c, 1},
+		{0xa840, 0xa873, 1},
+		{0xa880, 0xa8c5, 1},
+		{0xa8d0, 0xa8d9, 1},
+		{0xa8e0, 0xa8f7, 1},
+		{0xa8fb, 0xa8fb, 1},
+		{0xa8fd, 0xa92d, 1},
+		{0xa930, 0xa953, 1},
+		{0xa980, 0xa9c0, 1},
+		{0xa9cf, 0xa9d9, 1},
+		{0xa9e0, 0xa9fe, 1},
+		{0xaa00, 0xaa36, 1},
+		{0xaa40, 0xaa4d, 1},
+		{0xaa50, 0xaa59, 1},
+		{0xaa60, 0xaa76, 1},
+		{0xaa7a, 0xaac2, 1},
+		{0xaadb, 0xaadd, 1},
+		{0xaae0, 0xaaef, 1},
+		{0xaaf2, 0xaaf6, 1},
+		{0xab01, 0xab06, 1},
+		{0xab09, 0xab0e, 1},
+		{0xab11, 0xab16, 1},
+		{0xab20, 0xab26, 1},
+		{0xab28, 0xab2e, 1},
+		{0xab30, 0xab5a, 1},
+		{0xab60, 0xab68, 1},
+		{0xabc0, 0xabea, 1},
+		{0xabec, 0xabed, 1},
+		{0xabf0, 0xabf9, 1},
+		{0xac00, 0xd7a3, 1},
+		{0xfa0e, 0xfa0f, 1},
+		{0xfa11, 0xfa11, 1},
+		{0xfa13, 0xfa14, 1},
+		{0xfa1f, 0xfa1f, 1},
+		{0xfa21, 0xfa21, 1},
+		{0xfa23, 0xfa24, 1},
+		{0xfa27, 0xfa29, 1},
+		{0xfb1e, 0xfb1e, 1},
+		{0xfe20, 0xfe2f, 1},
+		{0xfe73, 0xfe73, 1},
+	},
+	R32: []unicode.Range32{
+		{0x10000, 0x1000b, 1},
+		{0x1000d, 0x10026, 1},
+		{0x10028, 0x1003a, 1},
+		{0x1003c, 0x1003d, 1},
+		{0x1003f, 0x1004d, 1},
+		{0x10050, 0x1005d, 1},
+		{0x10080, 0x100fa, 1},
+		{0x101fd, 0x101fd, 1},
+		{0x10280, 0x1029c, 1},
+		{0x102a0, 0x102d0, 1},
+		{0x102e0, 0x102e0, 1},
+		{0x10300, 0x1031f, 1},
+		{0x1032d, 0x10340, 1},
+		{0x10342, 0x10349, 1},
+		{0x10350, 0x1037a, 1},
+		{0x10380, 0x1039d, 1},
+		{0x103a0, 0x103c3, 1},
+		{0x103c8, 0x103cf, 1},
+		{0x10428, 0x1049d, 1},
+		{0x104a0, 0x104a9, 1},
+		{0x104d8, 0x104fb, 1},
+		{0x10500, 0x10527, 1},
+		{0x10530, 0x10563, 1},
+		{0x10597, 0x105a1, 1},
+		{0x105a3, 0x105b1, 1},
+		{0x105b3, 0x105b9, 1},
+		{0x105bb, 0x105bc, 1},
+		{0x10600, 0x10736, 1},
+		{0x10740, 0x10755, 1},
+		{0x10760, 0x10767, 1},
+		{0x10780, 0x10780, 1},
+		{0x10800, 0x10805, 1},
+		{0x10808, 0x10808, 1},
+		{0x1080a, 0x10835, 1},
+		{0x10837, 0x10838, 1},
+		{0x1083c, 0x1083c, 1},
+		{0x1083f, 0x10855, 1},
+		{0x10860, 0x10876, 1},
+		{0x10880, 0x1089e, 1},
+		{0x108e0, 0x108f2, 1},
+		{0x108f4, 0x108f5, 1},
+		{0x10900, 0x10915, 1},
+		{0x10920, 0x10939, 1},
+		{0x10980, 0x109b7, 1},
+		{0x109be, 0x109bf, 1},
+		{0x10a00, 0x10a03, 1},
+		{0x10a05, 0x10a06, 1},
+		{0x10a0c, 0x10a13, 1},
+		{0x10a15, 0x10a17, 1},
+		{0x10a19, 0x10a35, 1},
+		{0x10a38, 0x10a3a, 1},
+		{0x10a3f, 0x10a3f, 1},
+		{0x10a60, 0x10a7c, 1},
+		{0x10a80, 0x10a9c, 1},
+		{0x10ac0, 0x10ac7, 1},
+		{0x10ac9, 0x10ae6, 1},
+		{0x10b00, 0x10b35, 1},
+		{0x10b40, 0x10b55, 1},
+		{0x10b60, 0x10b72, 1},
+		{0x10b80, 0x10b91, 1},
+		{0x10c00, 0x10c48, 1},
+		{0x10cc0, 0x10cf2, 1},
+		{0x10d00, 0x10d27, 1},
+		{0x10d30, 0x10d39, 1},
+		{0x10e80, 0x10ea9, 1},
+		{0x10eab, 0x10eac, 1},
+		{0x10eb0, 0x10eb1, 1},
+		{0x10efd, 0x10f1c, 1},
+		{0x10f27, 0x10f27, 1},
+		{0x10f30, 0x10f50, 1},
+		{0x10f70, 0x10f85, 1},
+		{0x10fb0, 0x10fc4, 1},
+		{0x10fe0, 0x10ff6, 1},
+		{0x11000, 0x11046, 1},
+		{0x11066, 0x11075, 1},
+		{0x1107f, 0x110ba, 1},
+		{0x110c2, 0x110c2, 1},
+		{0x110d0, 0x110e8, 1},
+		{0x110f0, 0x110f9, 1},
+		{0x11100, 0x11134, 1},
+		{0x11136, 0x1113f, 1},
+		{0x11144, 0x11147, 1},
+		{0x11150, 0x11173, 1},
+		{0x11176, 0x11176, 1},
+		{0x11180, 0x111c4, 1},
+		{0x111c9, 0x111cc, 1},
+		{0x111ce, 0x111da, 1},
+		{0x111dc, 0x111dc, 1},
+		{0x11200, 0x11211, 1},
+		{0x11213, 0x11237, 1},
+		{0x1123e, 0x11241, 1},
+		{0x11280, 0x11286, 1},
+		{0x11288, 0x11288, 1},
+		{0x1128a, 0x1128d, 1},
+		{0x1128f, 0x1129d, 1},
+		{0x1129f, 0x112a8, 1},
+		{0x112b0, 0x112ea, 1},
+		{0x112f0, 0x112f9, 1},
+		{0x11300, 0x11303, 1},
+		{0x11305, 0x1130c, 1},
+		{0x1130f, 0x11310, 1},
+		{0x11313, 0x11328, 1},
+		{0x1132a, 0x11330, 1},
+		{0x11332, 0x11333, 1},
+		{0x11335, 0x11339, 1},
+		{0x1133b, 0x11344, 1},
+		{0x11347, 0x11348, 1},
+		{0x1134b, 0x1134d, 1},
+		{0x11350, 0x11350, 1},
+		{0x11357, 0x11357, 1},
+		{0x1135d, 0x11363, 1},
+		{0x11366, 0x1136c, 1},
+		{0x11370, 0x11374, 1},
+		{0x11400, 0x1144a, 1},
+		{0x11450, 0x11459, 1},
+		{0x1145e, 0x11461, 1},
+		{0x11480, 0x114c5, 1},
+		{0x114c7, 0x114c7, 1},
+		{0x114d0, 0x114d9, 1},
+		{0x11580, 0x115b5, 1},
+		{0x115b8, 0x115c0, 1},
+		{0x115d8, 0x115dd, 1},
+		{0x11600, 0x11640, 1},
+		{0x11644, 0x11644, 1},
+		{0x11650, 0x11659, 1},
+		{0x11680, 0x116b8, 1},
+		{0x116c0, 0x116c9, 1},
+		{0x11700, 0x1171a, 1},
+		{0x1171d, 0x1172b, 1},
+		{0x11730, 0x11739, 1},
+		{0x11740, 0x11746, 1},
+		{0x11800, 0x1183a, 1},
+		{0x118c0, 0x118e9, 1},
+		{0x118ff, 0x11906, 1},
+		{0x11909, 0x11909, 1},
+		{0x1190c, 0x11913, 1},
+		{0x11915, 0x11916, 1},
+		{0x11918, 0x11935, 1},
+		{0x11937, 0x11938, 1},
+		{0x1193b, 0x11943, 1},
+		{0x11950, 0x11959, 1},
+		{0x119a0, 0x119a7, 1},
+		{0x119aa, 0x119d7, 1},
+		{0x119da, 0x119e1, 1},
+		{0x119e3, 0x119e4, 1},
+		{0x11a00, 0x11a3e, 1},
+		{0x11a47, 0x11a47, 1},
+		{0x11a50, 0x11a99, 1},
+		{0x11a9d, 0x11a9d, 1},
+		{0x11ab0, 0x11af8, 1},
+		{0x11c00, 0x11c08, 1},
+		{0x11c0a, 0x11c36, 1},
+		{0x11c38, 0x11c40, 1},
+		{0x11c50, 0x11c59, 1},
+		{0x11c72, 0x11c8f, 1},
+		{0x11c92, 0x11ca7, 1},
+		{0x11ca9, 0x11cb6, 1},
+		{0x11d00, 0x11d06, 1},
+		{0x11d08, 0x11d09, 1},
+		{0x11d0b, 0x11d36, 1},
+		{0x11d3a, 0x11d3a, 1},
+		{0x11d3c, 0x11d3d, 1},
+		{0x11d3f, 0x11d47, 1},
+		{0x11d50, 0x11d59, 1},
+		{0x11d60, 0x11d65, 1},
+		{0x11d67, 0x11d68, 1},
+		{0x11d6a, 0x11d8e, 1},
+		{0x11d90, 0x11d91, 1},
+		{0x11d93, 0x11d98, 1},
+		{0x11da0, 0x11da9, 1},
+		{0x11ee0, 0x11ef6, 1},
+		{0x11f00, 0x11f10, 1},
+		{0x11f12, 0x11f3a, 1},
+		{0x11f3e, 0x11f42, 1},
+		{0x11f50, 0x11f59, 1},
+		{0x11fb0, 0x11fb0, 1},
+		{0x12000, 0x12399, 1},
+		{0x12480, 0x12543, 1},
+		{0x12f90, 0x12ff0, 1},
+		{0x13000, 0x1342f, 1},
+		{0x13440, 0x13455, 1},
+		{0x14400, 0x14646, 1},
+		{0x16800, 0x16a38, 1},
+		{0x16a40, 0x16a5e, 1},
+		{0x16a60, 0x16a69, 1},
+		{0x16a70, 0x16abe, 1},
+		{0x16ac0, 0x16ac9, 1},
+		{0x16ad0, 0x16aed, 1},
+		{0x16af0, 0x16af4, 1},
+		{0x16b00, 0x16b36, 1},
+		{0x16b40, 0x16b43, 1},
+		{0x16b50, 0x16b59, 1},
+		{0x16b63, 0x16b77, 1},
+		{0x16b7d, 0x16b8f, 1},
+		{0x16e60, 0x16e7f, 1},
+		{0x16f00, 0x16f4a, 1},
+		{0x16f4f, 0x16f87, 1},
+		{0x16f8f, 0x16f9f, 1},
+		{0x16fe0, 0x16fe1, 1},
+		{0x16fe3, 0x16fe4, 1},
+		{0x16ff0, 0x16ff1, 1},
+		{0x17000, 0x187f7, 1},
+		{0x18800, 0x18cd5, 1},
+		{0x18d00, 0x18d08, 1},
+		{0x1aff0, 0x1aff3, 1},
+		{0x1aff5, 0x1affb, 1},
+		{0x1affd, 0x1affe, 1},
+		{0x1b000, 0x1b122, 1},
+		{0x1b132, 0x1b132, 1},
+		{0x1b150, 0x1b152, 1},
+		{0x1b155, 0x1b155, 1},
+		{0x1b164, 0x1b167, 1},
+		{0x1b170, 0x1b2fb, 1},
+		{0x1bc00, 0x1bc6a, 1},
+		{0x1bc70, 0x1bc7c, 1},
+		{0x1bc80, 0x1bc88, 1},
+		{0x1bc90, 0x1bc99, 1},
+		{0x1bc9d, 0x1bc9e, 1},
+		{0x1cf00, 0x1cf2d, 1},
+		{0x1cf30, 0x1cf46, 1},
+		{0x1da00, 0x1da36, 1},
+		{0x1da3b, 0x1da6c, 1},
+		{0x1da75, 0x1da75, 1},
+		{0x1da84, 0x1da84, 1},
+		{0x1da9b, 0x1da9f, 1},
+		{0x1daa1, 0x1daaf, 1},
+		{0x1df00, 0x1df1e, 1},
+		{0x1df25, 0x1df2a, 1},
+		{0x1e000, 0x1e006, 1},
+		{0x1e008, 0x1e018, 1},
+		{0x1e01b, 0x1e021, 1},
+		{0x1e023, 0x1e024, 1},
+		{0x1e026, 0x1e02a, 1},
+		{0x1e08f, 0x1e08f, 1},
+		{0x1e100, 0x1e12c, 1},
+		{0x1e130, 0x1e13d, 1},
+		{0x1e140, 0x1e149, 1},
+		{0x1e14e, 0x1e14e, 1},
+		{0x1e290, 0x1e2ae, 1},
+		{0x1e2c0, 0x1e2f9, 1},
+		{0x1e4d0, 0x1e4f9, 1},
+		{0x1e7e0, 0x1e7e6, 1},
+		{0x1e7e8, 0x1e7eb, 1},
+		{0x1e7ed, 0x1e7ee, 1},
+		{0x1e7f0, 0x1e7fe, 1},
+		{0x1e800, 0x1e8c4, 1},
+		{0x1e8d0, 0x1e8d6, 1},
+		{0x1e922, 0x1e94b, 1},
+		{0x1e950, 0x1e959, 1},
+		{0x20000, 0x2a6df, 1},
+		{0x2a700, 0x2b739, 1},
+		{0x2b740, 0x2b81d, 1},
+		{0x2b820, 0x2cea1, 1},
+		{0x2ceb0, 0x2ebe0, 1},
+		{0x2ebf0, 0x2ee5d, 1},
+		{0x30000, 0x3134a, 1},
+		{0x31350, 0x323af, 1},
+	},
+	LatinOffset: 6,
+}
+
+// textIgnored are the characters ignored by UTS-46
+var textIgnored = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00ad, 0x00ad, 1},
+		{0x034f, 0x034f, 1},
+		{0x180b, 0x180d, 1},
+		{0x180f, 0x180f, 1},
+		{0x200b, 0x200b, 1},
+		{0x2060, 0x2060, 1},
+		{0x2064, 0x2064, 1},
+		{0xfe00, 0xfe0f, 1},
+		{0xfeff, 0xfeff, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1bca0, 0x1bca3, 1},
+		{0xe0100, 0xe01ef, 1},
+	},
+	LatinOffset: 1,
+}
+
+// textMapped are the UTS-46 mappings sorted by range
+var textMapped = []textMapping{
+	{0x0041, 0x0041, "a"},
+	{0x0042, 0x0042, "b"},
+	{0x0043, 0x0043, "c"},
+	{0x0044, 0x0044, "d"},
+	{0x0045, 0x0045, "e"},
+	{0x0046, 0x0046, "f"},
+	{0x0047, 0x0047, "g"},
+	{0x0048, 0x0048, "h"},
+	{0x0049, 0x0049, "i"},
+	{0x004a, 0x004a, "j"},
+	{0x004b, 0x004b, "k"},
+	{0x004c, 0x004c, "l"},
+	{0x004d, 0x004d, "m"},
+	{0x004e, 0x004e, "n"},
+	{0x004f, 0x004f, "o"},
+	{0x0050, 0x0050, "p"},
+	{0x0051, 0x0051, "q"},
+	{0x0052, 0x0052, "r"},
+	{0x0053, 0x0053, "s"},
+	{0x0054, 0x0054, "t"},
+	{0x0055, 0x0055, "u"},
+	{0x0056, 0x0056, "v"},
+	{0x0057, 0x0057, "w"},
+	{0x0058, 0x0058, "x"},
+	{0x0059, 0x0059, "y"},
+	{0x005a, 0x005a, "z"},
+	{0x00aa, 0x00aa, "a"},
+	{0x00b2, 0x00b2, "2"},
+	{0x00b3, 0x00b3, "3"},
+	{0x00b5, 0x00b5, "\u03bc"},
+	{0x00b9, 0x00b9, "1"},
+	{0x00ba, 0x00ba, "o"},
+	{0x00bc, 0x00bc, "1\u20444"},
+	{0x00bd, 0x00bd, "1\u20442"},
+	{0x00be, 0x00be, "3\u20444"},
+	{0x00c0, 0x00c0, "\u00e0"},
+	{0x00c1, 0x00c1, "\u00e1"},
+	{0x00c2, 0x00c2, "\u00e2"},
+	{0x00c3, 0x00c3, "\u00e3"},
+	{0x00c4, 0x00c4, "\u00e4"},
+	{0x00c5, 0x00c5, "\u00e5"},
+	{0x00c6, 0x00c6, "\u00e6"},
+	{0x00c7, 0x00c7, "\u00e7"},
+	{0x00c8, 0x00c8, "\u00e8"},
+	{0x00c9, 0x00c9, "\u00e9"},
+	{0x00ca, 0x00ca, "\u00ea"},
+	{0x00cb, 0x00cb, "\u00eb"},
+	{0x00cc, 0x00cc, "\u00ec"},
+	{0x00cd, 0x00cd, "\u00ed"},
+	{0x00ce, 0x00ce, "\u00ee"},
+	{0x00cf, 0x00cf, "\u00ef"},
+	{0x00d0, 0x00d0, "\u00f0"},
+	{0x00d1, 0x00d1, "\u00f1"},
+	{0x00d2, 0x00d2, "\u00f2"},
+	{0x00d3, 0x00d3, "\u00f3"},
+	{0x00d4, 0x00d4, "\u00f4"},
+	{0x00d5, 0x00d5, "\u00f5"},
+	{0x00d6, 0x00d6, "\u00f6"},
+	{0x00d8, 0x00d8, "\u00f8"},
+	{0x00d9, 0x00d9, "\u00f9"},
+	{0x00da, 0x00da, "\u00fa"},
+	{0x00db, 0x00db, "\u00fb"},
+	{0x00dc, 0x00dc, "\u00fc"},
+	{0x00dd, 0x00dd, "\u00fd"},
+	{0x00de, 0x00de, "\u00fe"},
+	{0x0100, 0x0100, "\u0101"},
+	{0x0102, 0x0102, "\u0103"},
+	{0x0104, 0x0104, "\u0105"},
+	{0x0106, 0x0106, "\u0107"},
+	{0x0108, 0x0108, "\u0109"},
+	{0x010a, 0x010a, "\u010b"},
+	{0x010c, 0x010c, "\u010d"},
+	{0x010e, 0x010e, "\u010f"},
+	{0x0110, 0x0110, "\u0111"},
+	{0x0112, 0x0112, "\u0113"},
+	{0x0114, 0x0114, "\u0115"},
+	{0x0116, 0x0116, "\u0117"},
+	{0x0118, 0x0118, "\u0119"},
+	{0x011a, 0x011a, "\u011b"},
+	{0x011c, 0x011c, "\u011d"},
+	{0x011e, 0x011e, "\u011f"},
+	{0x0120, 0x0120, "\u0121"},
+	{0x0122, 0x0122, "\u0123"},
+	{0x0124, 0x0124, "\u0125"},
+	{0x0126, 0x0126, "\u0127"},
+	{0x0128, 0x0128, "\u0129"},
+	{0x012a, 0x012a, "\u012b"},
+	{0x012c, 0x012c, "\u012d"},
+	{0x012e, 0x012e, "\u012f"},
+	{0x0130, 0x0130, "i\u0307"},
+	{0x0132, 0x0133, "ij"},
+	{0x0134, 0x0134, "\u0135"},
+	{0x0136, 0x0136, "\u0137"},
+	{0x0139, 0x0139, "\u013a"},
+	{0x013b, 0x013b, "\u013c"},
+	{0x013d, 0x013d, "\u013e"},
+	{0x013f, 0x0140, "l\u00b7"},
+	{0x0141, 0x0141, "\u0142"},
+	{0x0143, 0x0143, "\u0144"},
+	{0x0145, 0x0145, "\u0146"},
+	{0x0147, 0x0147, "\u0148"},
+	{0x0149, 0x0149, "\u02bcn"},
+	{0x014a, 0x014a, "\u014b"},
+	{0x014c, 0x014c, "\u014d"},
+	{0x014e, 0x014e, "\u014f"},
+	{0x0150, 0x0150, "\u0151"},
+	{0x0152, 0x0152, "\u0153"},
+	{0x0154, 0x0154, "\u0155"},
+	{0x0156, 0x0156, "\u0157"},
+	{0x0158, 0x0158, "\u0159"},
+	{0x015a, 0x015a, "\u015b"},
+	{0x015c, 0x015c, "\u015d"},
+	{0x015e, 0x015e, "\u015f"},
+	{0x0160, 0x0160, "\u0161"},
+	{0x0162, 0x0162, "\u0163"},
+	{0x0164, 0x0164, "\u0165"},
+	{0x0166, 0x0166, "\u0167"},
+	{0x0168, 0x0168, "\u0169"},
+	{0x016a, 0x016a, "\u016b"},
+	{0x016c, 0x016c, "\u016d"},
+	{0x016e, 0x016e, "\u016f"},
+	{0x0170, 0x0170, "\u0171"},
+	{0x0172, 0x0172, "\u0173"},
+	{0x0174, 0x0174, "\u0175"},
+	{0x0176, 0x0176, "\u0177"},
+	{0x0178, 0x0178, "\u00ff"},
+	{0x0179, 0x0179, "\u017a"},
+	{0x017b, 0x017b, "\u017c"},
+	{0x017d, 0x017d, "\u017e"},
+	{0x017f, 0x017f, "s"},
+	{0x0181, 0x0181, "\u0253"},
+	{0x0182, 0x0182, "\u0183"},
+	{0x0184, 0x0184, "\u0185"},
+	{0x0186, 0x0186, "\u0254"},
+	{0x0187, 0x0187, "\u0188"},
+	{0x0189, 0x0189, "\u0256"},
+	{0x018a, 0x018a, "\u0257"},
+	{0x018b, 0x018b, "\u018c"},
+	{0x018e, 0x018e, "\u01dd"},
+	{0x018f, 0x018f, "\u0259"},
+	{0x0190, 0x0190, "\u025b"},
+	{0x0191, 0x0191, "\u0192"},
+	{0x0193, 0x0193, "\u0260"},
+	{0x0194, 0x0194, "\u0263"},
+	{0x0196, 0x0196, "\u0269"},
+	{0x0197, 0x0197, "\u0268"},
+	{0x0198, 0x0198, "\u0199"},
+	{0x019c, 0x019c, "\u026f"},
+	{0x019d, 0x019d, "\u0272"},
+	{0x019f, 0x019f, "\u0275"},
+	{0x01a0, 0x01a0, "\u01a1"},
+	{0x01a2, 0x01a2, "\u01a3"},
+	{0x01a4, 0x01a4, "\u01a5"},
+	{0x01a6, 0x01a6, "\u0280"},
+	{0x01a7, 0x01a7, "\u01a8"},
+	{0x01a9, 0x01a9, "\u0283"},
+	{0x01ac, 0x01ac, "\u01ad"},
+	{0x01ae, 0x01ae, "\u0288"},
+	{0x01af, 0x01af, "\u01b0"},
+	{0x01b1, 0x01b1, "\u028a"},
+	{0x01b2, 0x01b2, "\u028b"},
+	{0x01b3, 0x01b3, "\u01b4"},
+	{0x01b5, 0x01b5, "\u01b6"},
+	{0x01b7, 0x01b7, "\u0292"},
+	{0x01b8, 0x01b8, "\u01b9"},
+	{0x01bc, 0x01bc, "\u01bd"},
+	{0x01c4, 0x01c6, "d\u017e"},
+	{0x01c7, 0x01c9, "lj"},
+	{0x01ca, 0x01cc, "nj"},
+	{0x01cd, 0x01cd, "\u01ce"},
+	{0x01cf, 0x01cf, "\u01d0"},
+	{0x01d1, 0x01d1, "\u01d2"},
+	{0x01d3, 0x01d3, "\u01d4"},
+	{0x01d5, 0x01d5, "\u01d6"},
+	{0x01d7, 0x01d7, "\u01d8"},
+	{0x01d9, 0x01d9, "\u01da"},
+	{0x01db, 0x01db, "\u01dc"},
+	{0x01de, 0x01de, "\u01df"},
+	{0x01e0, 0x01e0, "\u01e1"},
+	{0x01e2, 0x01e2, "\u01e3"},
+	{0x01e4, 0x01e4, "\u01e5"},
+	{0x01e6, 0x01e6, "\u01e7"},
+	{0x01e8, 0x01e8, "\u01e9"},
+	{0x01ea, 0x01ea, "\u01eb"},
+	{0x01ec, 0x01ec, "\u01ed"},
+	{0x01ee, 0x01ee, "\u01ef"},
+	{0x01f1, 0x01f3, "dz"},
+	{0x01f4, 0x01f4, "\u01f5"},
+	{0x01f6, 0x01f6, "\u0195"},
+	{0x01f7, 0x01f7, "\u01bf"},
+	{0x01f8, 0x01f8, "\u01f9"},
+	{0x01fa, 0x01fa, "\u01fb"},
+	{0x01fc, 0x01fc, "\u01fd"},
+	{0x01fe, 0x01fe, "\u01ff"},
+	{0x0200, 0x0200, "\u0201"},
+	{0x0202, 0x0202, "\u0203"},
+	{0x0204, 0x0204, "\u0205"},
+	{0x0206, 0x0206, "\u0207"},
+	{0x0208, 0x0208, "\u0209"},
+	{0x020a, 0x020a, "\u020b"},
+	{0x020c, 0x020c, "\u020d"},
+	{0x020e, 0x020e, "\u020f"},
+	{0x0210, 0x0210, "\u0211"},
+	{0x0212, 0x0212, "\u0213"},
+	{0x0214, 0x0214, "\u0215"},
+	{0x0216, 0x0216, "\u0217"},
+	{0x0218, 0x0218, "\u0219"},
+	{0x021a, 0x021a, "\u021b"},
+	{0x021c, 0x021c, "\u021d"},
+	{0x021e, 0x021e, "\u021f"},
+	{0x0220, 0x0220, "\u019e"},
+	{0x0222, 0x0222, "\u0223"},
+	{0x0224, 0x0224, "\u0225"},
+	{0x0226, 0x0226, "\u0227"},
+	{0x0228, 0x0228, "\u0229"},
+	{0x022a, 0x022a, "\u022b"},
+	{0x022c, 0x022c, "\u022d"},
+	{0x022e, 0x022e, "\u022f"},
+	{0x0230, 0x0230, "\u0231"},
+	{0x0232, 0x0232, "\u0233"},
+	{0x023a, 0x023a, "\u2c65"},
+	{0x023b, 0x023b, "\u023c"},
+	{0x023d, 0x023d, "\u019a"},
+	{0x023e, 0x023e, "\u2c66"},
+	{0x0241, 0x0241, "\u0242"},
+	{0x0243, 0x0243, "\u0180"},
+	{0x0244, 0x0244, "\u0289"},
+	{0x0245, 0x0245, "\u028c"},
+	{0x0246, 0x0246, "\u0247"},
+	{0x0248, 0x0248, "\u0249"},
+	{0x024a, 0x024a, "\u024b"},
+	{0x024c, 0x024c, "\u024d"},
+	{0x024e, 0x024e, "\u024f"},
+	{0x02b0, 0x02b0, "h"},
+	{0x02b1, 0x02b1, "\u0266"},
+	{0x02b2, 0x02b2, "j"},
+	{0x02b3, 0x02b3, "r"},
+	{0x02b4, 0x02b4, "\u0279"},
+	{0x02b5, 0x02b5, "\u027b"},
+	{0x02b6, 0x02b6, "\u0281"},
+	{0x02b7, 0x02b7, "w"},
+	{0x02b8, 0x02b8, "y"},
+	{0x02e0, 0x02e0, "\u0263"},
+	{0x02e1, 0x02e1, "l"},
+	{0x02e2, 0x02e2, "s"},
+	{0x02e3, 0x02e3, "x"},
+	{0x02e4, 0x02e4, "\u0295"},
+	{0x0340, 0x0340, "\u0300"},
+	{0x0341, 0x0341, "\u0301"},
+	{0x0343, 0x0343, "\u0313"},
+	{0x0344, 0x0344, "\u0308\u0301"},
+	{0x0345, 0x0345, "\u03b9"},
+	{0x0370, 0x0370, "\u0371"},
+	{0x0372, 0x0372, "\u0373"},
+	{0x0374, 0x0374, "\u02b9"},
+	{0x0376, 0x0376, "\u0377"},
+	{0x037f, 0x037f, "\u03f3"},
+	{0x0386, 0x0386, "\u03ac"},
+	{0x0387, 0x0387, "\u00b7"},
+	{0x0388, 0x0388, "\u03ad"},
+	{0x0389, 0x0389, "\u03ae"},
+	{0x038a, 0x038a, "\u03af"},
+	{0x038c, 0x038c, "\u03cc"},
+	{0x038e, 0x038e, "\u03cd"},
+	{0x038f, 0x038f, "\u03ce"},
+	{0x0391, 0x0391, "\u03b1"},
+	{0x0392, 0x0392, "\u03b2"},
+	{0x0393, 0x0393, "\u03b3"},
+	{0x0394, 0x0394, "\u03b4"},
+	{0x0395, 0x0395, "\u03b5"},
+	{0x0396, 0x0396, "\u03b6"},
+	{0x0397, 0x0397, "\u03b7"},
+	{0x0398, 0x0398, "\u03b8"},
+	{0x0399, 0x0399, "\u03b9"},
+	{0x039a, 0x039a, "\u03ba"},
+	{0x039b, 0x039b, "\u03bb"},
+	{0x039c, 0x039c, "\u03bc"},
+	{0x039d, 0x039d, "\u03bd"},
+	{0x039e, 0x039e, "\u03be"},
+	{0x039f, 0x039f, "\u03bf"},
+	{0x03a0, 0x03a0, "\u03c0"},
+	{0x03a1, 0x03a1, "\u03c1"},
+	{0x03a3, 0x03a3, "\u03c3"},
+	{0x03a4, 0x03a4, "\u03c4"},
+	{0x03a5, 0x03a5, "\u03c5"},
+	{0x03a6, 0x03a6, "\u03c6"},
+	{0x03a7, 0x03a7, "\u03c7"},
+	{0x03a8, 0x03a8, "\u03c8"},
+	{0x03a9, 0x03a9, "\u03c9"},
+	{0x03aa, 0x03aa, "\u03ca"},
+	{0x03ab, 0x03ab, "\u03cb"},
+	{0x03cf, 0x03cf, "\u03d7"},
+	{0x03d0, 0x03d0, "\u03b2"},
+	{0x03d1, 0x03d1, "\u03b8"},
+	{0x03d2, 0x03d2, "\u03c5"},
+	{0x03d3, 0x03d3, "\u03cd"},
+	{0x03d4, 0x03d4, "\u03cb"},
+	{0x03d5, 0x03d5, "\u03c6"},
+	{0x03d6, 0x03d6, "\u03c0"},
+	{0x03d8, 0x03d8, "\u03d9"},
+	{0x03da, 0x03da, "\u03db"},
+	{0x03dc, 0x03dc, "\u03dd"},
+	{0x03de, 0x03de, "\u03df"},
+	{0x03e0, 0x03e0, "\u03e1"},
+	{0x03e2, 0x03e2, "\u03e3"},
+	{0x03e4, 0x03e4, "\u03e5"},
+	{0x03e6, 0x03e6, "\u03e7"},
+	{0x03e8, 0x03e8, "\u03e9"},
+	{0x03ea, 0x03ea, "\u03eb"},
+	{0x03ec, 0x03ec, "\u03ed"},
+	{0x03ee, 0x03ee, "\u03ef"},
+	{0x03f0, 0x03f0, "\u03ba"},
+	{0x03f1, 0x03f1, "\u03c1"},
+	{0x03f2, 0x03f2, "\u03c3"},
+	{0x03f4, 0x03f4, "\u03b8"},
+	{0x03f5, 0x03f5, "\u03b5"},
+	{0x03f7, 0x03f7, "\u03f8"},
+	{0x03f9, 0x03f9, "\u03c3"},
+	{0x03fa, 0x03fa, "\u03fb"},
+	{0x03fd, 0x03fd, "\u037b"},
+	{0x03fe, 0x03fe, "\u037c"},
+	{0x03ff, 0x03ff, "\u037d"},
+	{0x0400, 0x0400, "\u0450"},
+	{0x0401, 0x0401, "\u0451"},
+	{0x0402, 0x0402, "\u0452"},
+	{0x0403, 0x0403, "\u0453"},
+	{0x0404, 0x0404, "\u0454"},
+	{0x0405, 0x0405, "\u0455"},
+	{0x0406, 0x0406, "\u0456"},
+	{0x0407, 0x0407, "\u0457"},
+	{0x0408, 0x0408, "\u0458"},
+	{0x0409, 0x0409, "\u0459"},
+	{0x040a, 0x040a, "\u045a"},
+	{0x040b, 0x040b, "\u045b"},
+	{0x040c, 0x040c, "\u045c"},
+	{0x040d, 0x040d, "\u045d"},
+	{0x040e, 0x040e, "\u045e"},
+	{0x040f, 0x040f, "\u045f"},
+	{0x0410, 0x0410, "\u0430"},
+	{0x0411, 0x0411, "\u0431"},
+	{0x0412, 0x0412, "\u0432"},
+	{0x0413, 0x0413, "\u0433"},
+	{0x0414, 0x0414, "\u0434"},
+	{0x0415, 0x0415, "\u0435"},
+	{0x0416, 0x0416, "\u0436"},
+	{0x0417, 0x0417, "\u0437"},
+	{0x0418, 0x0418, "\u0438"},
+	{0x0419, 0x0419, "\u0439"},
+	{0x041a, 0x041a, "\u043a"},
+	{0x041b, 0x041b, "\u043b"},
+	{0x041c, 0x041c, "\u043c"},
+	{0x041d, 0x041d, "\u043d"},
+	{0x041e, 0x041e, "\u043e"},
+	{0x041f, 0x041f, "\u043f"},
+	{0x0420, 0x0420, "\u0440"},
+	{0x0421, 0x0421, "\u0441"},
+	{0x0422, 0x0422, "\u0442"},
+	{0x0423, 0x0423, "\u0443"},
+	{0x0424, 0x0424, "\u0444"},
+	{0x0425, 0x0425, "\u0445"},
+	{0x0426, 0x0426, "\u0446"},
+	{0x0427, 0x0427, "\u0447"},
+	{0x0428, 0x0428, "\u0448"},
+	{0x0429, 0x0429, "\u0449"},
+	{0x042a, 0x042a, "\u044a"},
+	{0x042b, 0x042b, "\u044b"},
+	{0x042c, 0x042c, "\u044c"},
+	{0x042d, 0x042d, "\u044d"},
+	{0x042e, 0x042e, "\u044e"},
+	{0x042f, 0x042f, "\u044f"},
+	{0x0460, 0x0460, "\u0461"},
+	{0x0462, 0x0462, "\u0463"},
+	{0x0464, 0x0464, "\u0465"},
+	{0x0466, 0x0466, "\u0467"},
+	{0x0468, 0x0468, "\u0469"},
+	{0x046a, 0x046a, "\u046b"},
+	{0x046c, 0x046c, "\u046d"},
+	{0x046e, 0x046e, "\u046f"},
+	{0x0470, 0x0470, "\u0471"},
+	{0x0472, 0x0472, "\u0473"},
+	{0x0474, 0x0474, "\u0475"},
+	{0x0476, 0x0476, "\u0477"},
+	{0x0478, 0x0478, "\u0479"},
+	{0x047a, 0x047a, "\u047b"},
+	{0x047c, 0x047c, "\u047d"},
+	{0x047e, 0x047e, "\u047f"},
+	{0x0480, 0x0480, "\u0481"},
+	{0x048a, 0x048a, "\u048b"},
+	{0x048c, 0x048c, "\u048d"},
+	{0x048e, 0x048e, "\u048f"},
+	{0x0490, 0x0490, "\u0491"},
+	{0x0492, 0x0492, "\u0493"},
+	{0x0494, 0x0494, "\u0495"},
+	{0x0496, 0x0496, "\u0497"},
+	{0x0498, 0x0498, "\u0499"},
+	{0x049a, 0x049a, "\u049b"},
+	{0x049c, 0x049c, "\u049d"},
+	{0x049e, 0x049e, "\u049f"},
+	{0x04a0, 0x04a0, "\u04a1"},
+	{0x04a2, 0x04a2, "\u04a3"},
+	{0x04a4, 0x04a4, "\u04a5"},
+	{0x04a6, 0x04a6, "\u04a7"},
+	{0x04a8, 0x04a8, "\u04a9"},
+	{0x04aa, 0x04aa, "\u04ab"},
+	{0x04ac, 0x04ac, "\u04ad"},
+	{0x04ae, 0x04ae, "\u04af"},
+	{0x04b0, 0x04b0, "\u04b1"},
+	{0x04b2, 0x04b2, "\u04b3"},
+	{0x04b4, 0x04b4, "\u04b5"},
+	{0x04b6, 0x04b6, "\u04b7"},
+	{0x04b8, 0x04b8, "\u04b9"},
+	{0x04ba, 0x04ba, "\u04bb"},
+	{0x04bc, 0x04bc, "\u04bd"},
+	{0x04be, 0x04be, "\u04bf"},
+	{0x04c1, 0x04c1, "\u04c2"},
+	{0x04c3, 0x04c3, "\u04c4"},
+	{0x04c5, 0x04c5, "\u04c6"},
+	{0x04c7, 0x04c7, "\u04c8"},
+	{0x04c9, 0x04c9, "\u04ca"},
+	{0x04cb, 0x04cb, "\u04cc"},
+	{0x04cd, 0x04cd, "\u04ce"},
+	{0x04d0, 0x04d0, "\u04d1"},
+	{0x04d2, 0x04d2, "\u04d3"},
+	{0x04d4, 0x04d4, "\u04d5"},
+	{0x04d6, 0x04d6, "\u04d7"},
+	{0x04d8, 0x04d8, "\u04d9"},
+	{0x04da, 0x04da, "\u04db"},
+	{0x04dc, 0x04dc, "\u04dd"},
+	{0x04de, 0x04de, "\u04df"},
+	{0x04e0, 0x04e0, "\u04e1"},
+	{0x04e2, 0x04e2, "\u04e3"},
+	{0x04e4, 0x04e4, "\u04e5"},
+	{0x04e6, 0x04e6, "\u04e7"},
+	{0x04e8, 0x04e8, "\u04e9"},
+	{0x04ea, 0x04ea, "\u04eb"},
+	{0x04ec, 0x04ec, "\u04ed"},
+	{0x04ee, 0x04ee, "\u04ef"},
+	{0x04f0, 0x04f0, "\u04f1"},
+	{0x04f2, 0x04f2, "\u04f3"},
+	{0x04f4, 0x04f4, "\u04f5"},
+	{0x04f6, 0x04f6, "\u04f7"},
+	{0x04f8, 0x04f8, "\u04f9"},
+	{0x04fa, 0x04fa, "\u04fb"},
+	{0x04fc, 0x04fc, "\u04fd"},
+	{0x04fe, 0x04fe, "\u04ff"},
+	{0x0500, 0x0500, "\u0501"},
+	{0x0502, 0x0502, "\u0503"},
+	{0x0504, 0x0504, "\u0505"},
+	{0x0506, 0x0506, "\u0507"},
+	{0x0508, 0x0508, "\u0509"},
+	{0x050a, 0x050a, "\u050b"},
+	{0x050c, 0x050c, "\u050d"},
+	{0x050e, 0x050e, "\u050f"},
+	{0x0510, 0x0510, "\u0511"},
+	{0x0512, 0x0512, "\u0513"},
+	{0x0514, 0x0514, "\u0515"},
+	{0x0516, 0x0516, "\u0517"},
+	{0x0518, 0x0518, "\u0519"},
+	{0x051a, 0x051a, "\u051b"},
+	{0x051c, 0x051c, "\u051d"},
+	{0x051e, 0x051e, "\u051f"},
+	{0x0520, 0x0520, "\u0521"},
+	{0x0522, 0x0522, "\u0523"},
+	{0x0524, 0x0524, "\u0525"},
+	{0x0526, 0x0526, "\u0527"},
+	{0x0528, 0x0528, "\u0529"},
+	{0x052a, 0x052a, "\u052b"},
+	{0x052c, 0x052c, "\u052d"},
+	{0x052e, 0x052e, "\u052f"},
+	{0x0531, 0x0531, "\u0561"},
+	{0x0532, 0x0532, "\u0562"},
+	{0x0533, 0x0533, "\u0563"},
+	{0x0534, 0x0534, "\u0564"},
+	{0x0535, 0x0535, "\u0565"},
+	{0x0536, 0x0536, "\u0566"},
+	{0x0537, 0x0537, "\u0567"},
+	{0x0538, 0x0538, "\u0568"},
+	{0x0539, 0x0539, "\u0569"},
+	{0x053a, 0x053a, "\u056a"},
+	{0x053b, 0x053b, "\u056b"},
+	{0x053c, 0x053c, "\u056c"},
+	{0x053d, 0x053d, "\u056d"},
+	{0x053e, 0x053e, "\u056e"},
+	{0x053f, 0x053f, "\u056f"},
+	{0x0540, 0x0540, "\u0570"},
+	{0x0541, 0x0541, "\u0571"},
+	{0x0542, 0x0542, "\u0572"},
+	{0x0543, 0x0543, "\u0573"},
+	{0x0544, 0x0544, "\u0574"},
+	{0x0545, 0x0545, "\u0575"},
+	{0x0546, 0x0546, "\u0576"},
+	{0x0547, 0x0547, "\u0577"},
+	{0x0548, 0x0548, "\u0578"},
+	{0x0549, 0x0549, "\u0579"},
+	{0x054a, 0x054a, "\u057a"},
+	{0x054b, 0x054b, "\u057b"},
+	{0x054c, 0x054c, "\u057c"},
+	{0x054d, 0x054d, "\u057d"},
+	{0x054e, 0x054e, "\u057e"},
+	{0x054f, 0x054f, "\u057f"},
+	{0x0550, 0x0550, "\u0580"},
+	{0x0551, 0x0551, "\u0581"},
+	{0x0552, 0x0552, "\u0582"},
+	{0x0553, 0x0553, "\u0583"},
+	{0x0554, 0x0554, "\u0584"},
+	{0x0555, 0x0555, "\u0585"},
+	{0x0556, 0x0556, "\u0586"},
+	{0x0587, 0x0587, "\u0565\u0582"},
+	{0x0675, 0x0675, "\u0627\u0674"},
+	{0x0676, 0x0676, "\u0648\u0674"},
+	{0x0677, 0x0677, "\u06c7\u0674"},
+	{0x0678, 0x0678, "\u064a\u0674"},
+	{0x0958, 0x0958, "\u0915\u093c"},
+	{0x0959, 0x0959, "\u0916\u093c"},
+	{0x095a, 0x095a, "\u0917\u093c"},
+	{0x095b, 0x095b, "\u091c\u093c"},
+	{0x095c, 0x095c, "\u0921\u093c"},
+	{0x095d, 0x095d, "\u0922\u093c"},
+	{0x095e, 0x095e, "\u092b\u093c"},
+	{0x095f, 0x095f, "\u092f\u093c"},
+	{0x09dc, 0x09dc, "\u09a1\u09bc"},
+	{0x09dd, 0x09dd, "\u09a2\u09bc"},
+	{0x09df, 0x09df, "\u09af\u09bc"},
+	{0x0a33, 0x0a33, "\u0a32\u0a3c"},
+	{0x0a36, 0x0a36, "\u0a38\u0a3c"},
+	{0x0a59, 0x0a59, "\u0a16\u0a3c"},
+	{0x0a5a, 0x0a5a, "\u0a17\u0a3c"},
+	{0x0a5b, 0x0a5b, "\u0a1c\u0a3c"},
+	{0x0a5e, 0x0a5e, "\u0a2b\u0a3c"},
+	{0x0b5c, 0x0b5c, "\u0b21\u0b3c"},
+	{0x0b5d, 0x0b5d, "\u0b22\u0b3c"},
+	{0x0e33, 0x0e33, "\u0e4d\u0e32"},
+	{0x0eb3, 0x0eb3, "\u0ecd\u0eb2"},
+	{0x0edc, 0x0edc, "\u0eab\u0e99"},
+	{0x0edd, 0x0edd, "\u0eab\u0ea1"},
+	{0x0f0c, 0x0f0c, "\u0f0b"},
+	{0x0f43, 0x0f43, "\u0f42\u0fb7"},
+	{0x0f4d, 0x0f4d, "\u0f4c\u0fb7"},
+	{0x0f52, 0x0f52, "\u0f51\u0fb7"},
+	{0x0f57, 0x0f57, "\u0f56\u0fb7"},
+	{0x0f5c, 0x0f5c, "\u0f5b\u0fb7"},
+	{0x0f69, 0x0f69, "\u0f40\u0fb5"},
+	{0x0f73, 0x0f73, "\u0f71\u0f72"},
+	{0x0f75, 0x0f75, "\u0f71\u0f74"},
+	{0x0f76, 0x0f76, "\u0fb2\u0f80"},
+	{0x0f77, 0x0f77, "\u0fb2\u0f71\u0f80"},
+	{0x0f78, 0x0f78, "\u0fb3\u0f80"},
+	{0x0f79, 0x0f79, "\u0fb3\u0f71\u0f80"},
+	{0x0f81, 0x0f81, "\u0f71\u0f80"},
+	{0x0f93, 0x0f93, "\u0f92\u0fb7"},
+	{0x0f9d, 0x0f9d, "\u0f9c\u0fb7"},
+	{0x0fa2, 0x0fa2, "\u0fa1\u0fb7"},
+	{0x0fa7, 0x0fa7, "\u0fa6\u0fb7"},
+	{0x0fac, 0x0fac, "\u0fab\u0fb7"},
+	{0x0fb9, 0x0fb9, "\u0f90\u0fb5"},
+	{0x10c7, 0x10c7, "\u2d27"},
+	{0x10cd, 0x10cd, "\u2d2d"},
+	{0x10fc, 0x10fc, "\u10dc"},
+	{0x13f8, 0x13f8, "\u13f0"},
+	{0x13f9, 0x13f9, "\u13f1"},
+	{0x13fa, 0x13fa, "\u13f2"},
+	{0x13fb, 0x13fb, "\u13f3"},
+	{0x13fc, 0x13fc, "\u13f4"},
+	{0x13fd, 0x13fd, "\u13f5"},
+	{0x1c80, 0x1c80, "\u0432"},
+	{0x1c81, 0x1c81, "\u0434"},
+	{0x1c82, 0x1c82, "\u043e"},
+	{0x1c83, 0x1c83, "\u0441"},
+	{0x1c84, 0x1c85, "\u0442"},
+	{0x1c86, 0x1c86, "\u044a"},
+	{0x1c87, 0x1c87, "\u0463"},
+	{0x1c88, 0x1c88, "\ua64b"},
+	{0x1c90, 0x1c90, "\u10d0"},
+	{0x1c91, 0x1c91, "\u10d1"},
+	{0x1c92, 0x1c92, "\u10d2"},
+	{0x1c93, 0x1c93, "\u10d3"},
+	{0x1c94, 0x1c94, "\u10d4"},
+	{0x1c95, 0x1c95, "\u10d5"},
+	{0x1c96, 0x1c96, "\u10d6"},
+	{0x1c97, 0x1c97, "\u10d7"},
+	{0x1c98, 0x1c98, "\u10d8"},
+	{0x1c99, 0x1c99, "\u10d9"},
+	{0x1c9a, 0x1c9a, "\u10da"},
+	{0x1c9b, 0x1c9b, "\u10db"},
+	{0x1c9c, 0x1c9c, "\u10dc"},
+	{0x1c9d, 0x1c9d, "\u10dd"},
+	{0x1c9e, 0x1c9e, "\u10de"},
+	{0x1c9f, 0x1c9f, "\u10df"},
+	{0x1ca0, 0x1ca0, "\u10e0"},
+	{0x1ca1, 0x1ca1, "\u10e1"},
+	{0x1ca2, 0x1ca2, "\u10e2"},
+	{0x1ca3, 0x1ca3, "\u10e3"},
+	{0x1ca4, 0x1ca4, "\u10e4"},
+	{0x1ca5, 0x1ca5, "\u10e5"},
+	{0x1ca6, 0x1ca6, "\u10e6"},
+	{0x1ca7, 0x1ca7, "\u10e7"},
+	{0x1ca8, 0x1ca8, "\u10e8"},
+	{0x1ca9, 0x1ca9, "\u10e9"},
+	{0x1caa, 0x1caa, "\u10ea"},
+	{0x1cab, 0x1cab, "\u10eb"},
+	{0x1cac, 0x1cac, "\u10ec"},
+	{0x1cad, 0x1cad, "\u10ed"},
+	{0x1cae, 0x1cae, "\u10ee"},
+	{0x1caf, 0x1caf, "\u10ef"},
+	{0x1cb0, 0x1cb0, "\u10f0"},
+	{0x1cb1, 0x1cb1, "\u10f1"},
+	{0x1cb2, 0x1cb2, "\u10f2"},
+	{0x1cb3, 0x1cb3, "\u10f3"},
+	{0x1cb4, 0x1cb4, "\u10f4"},
+	{0x1cb5, 0x1cb5, "\u10f5"},
+	{0x1cb6, 0x1cb6, "\u10f6"},
+	{0x1cb7, 0x1cb7, "\u10f7"},
+	{0x1cb8, 0x1cb8, "\u10f8"},
+	{0x1cb9, 0x1cb9, "\u10f9"},
+	{0x1cba, 0x1cba, "\u10fa"},
+	{0x1cbd, 0x1cbd, "\u10fd"},
+	{0x1cbe, 0x1cbe, "\u10fe"},
+	{0x1cbf, 0x1cbf, "\u10ff"},
+	{0x1d2c, 0x1d2c, "a"},
+	{0x1d2d, 0x1d2d, "\u00e6"},
+	{0x1d2e, 0x1d2e, "b"},
+	{0x1d30, 0x1d30, "d"},
+	{0x1d31, 0x1d31, "e"},
+	{0x1d32, 0x1d32, "\u01dd"},
+	{0x1d33, 0x1d33, "g"},
+	{0x1d34, 0x1d34, "h"},
+	{0x1d35, 0x1d35, "i"},
+	{0x1d36, 0x1d36, "j"},
+	{0x1d37, 0x1d37, "k"},
+	{0x1d38, 0x1d38, "l"},
+	{0x1d39, 0x1d39, "m"},
+	{0x1d3a, 0x1d3a, "n"},
+	{0x1d3c, 0x1d3c, "o"},
+	{0x1d3d, 0x1d3d, "\u0223"},
+	{0x1d3e, 0x1d3e, "p"},
+	{0x1d3f, 0x1d3f, "r"},
+	{0x1d40, 0x1d40, "t"},
+	{0x1d41, 0x1d41, "u"},
+	{0x1d42, 0x1d42, "w"},
+	{0x1d43, 0x1d43, "a"},
+	{0x1d44, 0x1d44, "\u0250"},
+	{0x1d45, 0x1d45, "\u0251"},
+	{0x1d46, 0x1d46, "\u1d02"},
+	{0x1d47, 0x1d47, "b"},
+	{0x1d48, 0x1d48, "d"},
+	{0x1d49, 0x1d49, "e"},
+	{0x1d4a, 0x1d4a, "\u0259"},
+	{0x1d4b, 0x1d4b, "\u025b"},
+	{0x1d4c, 0x1d4c, "\u025c"},
+	{0x1d4d, 0x1d4d, "g"},
+	{0x1d4f, 0x1d4f, "k"},
+	{0x1d50, 0x1d50, "m"},
+	{0x1d51, 0x1d51, "\u014b"},
+	{0x1d52, 0x1d52, "o"},
+	{0x1d53, 0x1d53, "\u0254"},
+	{0x1d54, 0x1d54, "\u1d16"},
+	{0x1d55, 0x1d55, "\u1d17"},
+	{0x1d56, 0x1d56, "p"},
+	{0x1d57, 0x1d57, "t"},
+	{0x1d58, 0x1d58, "u"},
+	{0x1d59, 0x1d59, "\u1d1d"},
+	{0x1d5a, 0x1d5a, "\u026f"},
+	{0x1d5b, 0x1d5b, "v"},
+	{0x1d5c, 0x1d5c, "\u1d25"},
+	{0x1d5d, 0x1d5d, "\u03b2"},
+	{0x1d5e, 0x1d5e, "\u03b3"},
+	{0x1d5f, 0x1d5f, "\u03b4"},
+	{0x1d60, 0x1d60, "\u03c6"},
+	{0x1d61, 0x1d61, "\u03c7"},
+	{0x1d62, 0x1d62, "i"},
+	{0x1d63, 0x1d63, "r"},
+	{0x1d64, 0x1d64, "u"},
+	{0x1d65, 0x1d65, "v"},
+	{0x1d66, 0x1d66, "\u03b2"},
+	{0x1d67, 0x1d67, "\u03b3"},
+	{0x1d68, 0x1d68, "\u03c1"},
+	{0x1d69, 0x1d69, "\u03c6"},
+	{0x1d6a, 0x1d6a, "\u03c7"},
+	{0x1d78, 0x1d78, "\u043d"},
+	{0x1d9b, 0x1d9b, "\u0252"},
+	{0x1d9c, 0x1d9c, "c"},
+	{0x1d9d, 0x1d9d, "\u0255"},
+	{0x1d9e, 0x1d9e, "\u00f0"},
+	{0x1d9f, 0x1d9f, "\u025c"},
+	{0x1da0, 0x1da0, "f"},
+	{0x1da1, 0x1da1, "\u025f"},
+	{0x1da2, 0x1da2, "\u0261"},
+	{0x1da3, 0x1da3, "\u0265"},
+	{0x1da4, 0x1da4, "\u0268"},
+	{0x1da5, 0x1da5, "\u0269"},
+	{0x1da6, 0x1da6, "\u026a"},
+	{0x1da7, 0x1da7, "\u1d7b"},
+	{0x1da8, 0x1da8, "\u029d"},
+	{0x1da9, 0x1da9, "\u026d"},
+	{0x1daa, 0x1daa, "\u1d85"},
+	{0x1dab, 0x1dab, "\u029f"},
+	{0x1dac, 0x1dac, "\u0271"},
+	{0x1dad, 0x1dad, "\u0270"},
+	{0x1dae, 0x1dae, "\u0272"},
+	{0x1daf, 0x1daf, "\u0273"},
+	{0x1db0, 0x1db0, "\u0274"},
+	{0x1db1, 0x1db1, "\u0275"},
+	{0x1db2, 0x1db2, "\u0278"},
+	{0x1db3, 0x1db3, "\u0282"},
+	{0x1db4, 0x1db4, "\u0283"},
+	{0x1db5, 0x1db5, "\u01ab"},
+	{0x1db6, 0x1db6, "\u0289"},
+	{0x1db7, 0x1db7, "\u028a"},
+	{0x1db8, 0x1db8, "\u1d1c"},
+	{0x1db9, 0x1db9, "\u028b"},
+	{0x1dba, 0x1dba, "\u028c"},
+	{0x1dbb, 0x1dbb, "z"},
+	{0x1dbc, 0x1dbc, "\u0290"},
+	{0x1dbd, 0x1dbd, "\u0291"},
+	{0x1dbe, 0x1dbe, "\u0292"},
+	{0x1dbf, 0x1dbf, "\u03b8"},
+	{0x1e00, 0x1e00, "\u1e01"},
+	{0x1e02, 0x1e02, "\u1e03"},
+	{0x1e04, 0x1e04, "\u1e05"},
+	{0x1e06, 0x1e06, "\u1e07"},
+	{0x1e08, 0x1e08, "\u1e09"},
+	{0x1e0a, 0x1e0a, "\u1e0b"},
+	{0x1e0c, 0x1e0c, "\u1e0d"},
+	{0x1e0e, 0x1e0e, "\u1e0f"},
+	{0x1e10, 0x1e10, "\u1e11"},
+	{0x1e12, 0x1e12, "\u1e13"},
+	{0x1e14, 0x1e14, "\u1e15"},
+	{0x1e16, 0x1e16, "\u1e17"},
+	{0x1e18, 0x1e18, "\u1e19"},
+	{0x1e1a, 0x1e1a, "\u1e1b"},
+	{0x1e1c, 0x1e1c, "\u1e1d"},
+	{0x1e1e, 0x1e1e, "\u1e1f"},
+	{0x1e20, 0x1e20, "\u1e21"},
+	{0x1e22, 0x1e22, "\u1e23"},
+	{0x1e24, 0x1e24, "\u1e25"},
+	{0x1e26, 0x1e26, "\u1e27"},
+	{0x1e28, 0x1e28, "\u1e29"},
+	{0x1e2a, 0x1e2a, "\u1e2b"},
+	{0x1e2c, 0x1e2c, "\u1e2d"},
+	{0x1e2e, 0x1e2e, "\u1e2f"},
+	{0x1e30, 0x1e30, "\u1e31"},
+	{0x1e32, 0x1e32, "\u1e33"},
+	{0x1e34, 0x1e34, "\u1e35"},
+	{0x1e36, 0x1e36, "\u1e37"},
+	{0x1e38, 0x1e38, "\u1e39"},
+	{0x1e3a, 0x1e3a, "\u1e3b"},
+	{0x1e3c, 0x1e3c, "\u1e3d"},
+	{0x1e3e, 0x1e3e, "\u1e3f"},
+	{0x1e40, 0x1e40, "\u1e41"},
+	{0x1e42, 0x1e42, "\u1e43"},
+	{0x1e44, 0x1e44, "\u1e45"},
+	{0x1e46, 0x1e46, "\u1e47"},
+	{0x1e48, 0x1e48, "\u1e49"},
+	{0x1e4a, 0x1e4a, "\u1e4b"},
+	{0x1e4c, 0x1e4c, "\u1e4d"},
+	{0x1e4e, 0x1e4e, "\u1e4f"},
+	{0x1e50, 0x1e50, "\u1e51"},
+	{0x1e52, 0x1e52, "\u1e53"},
+	{0x1e54, 0x1e54, "\u1e55"},
+	{0x1e56, 0x1e56, "\u1e57"},
+	{0x1e58, 0x1e58, "\u1e59"},
+	{0x1e5a, 0x1e5a, "\u1e5b"},
+	{0x1e5c, 0x1e5c, "\u1e5d"},
+	{0x1e5e, 0x1e5e, "\u1e5f"},
+	{0x1e60, 0x1e60, "\u1e61"},
+	{0x1e62, 0x1e62, "\u1e63"},
+	{0x1e64, 0x1e64, "\u1e65"},
+	{0x1e66, 0x1e66, "\u1e67"},
+	{0x1e68, 0x1e68, "\u1e69"},
+	{0x1e6a, 0x1e6a, "\u1e6b"},
+	{0x1e6c, 0x1e6c, "\u1e6d"},
+	{0x1e6e, 0x1e6e, "\u1e6f"},
+	{0x1e70, 0x1e70, "\u1e71"},
+	{0x1e72, 0x1e72, "\u1e73"},
+	{0x1e74, 0x1e74, "\u1e75"},
+	{0x1e76, 0x1e76, "\u1e77"},
+	{0x1e78, 0x1e78, "\u1e79"},
+	{0x1e7a, 0x1e7a, "\u1e7b"},
+	{0x1e7c, 0x1e7c, "\u1e7d"},
+	{0x1e7e, 0x1e7e, "\u1e7f"},
+	{0x1e80, 0x1e80, "\u1e81"},
+	{0x1e82, 0x1e82, "\u1e83"},
+	{0x1e84, 0x1e84, "\u1e85"},
+	{0x1e86, 0x1e86, "\u1e87"},
+	{0x1e88, 0x1e88, "\u1e89"},
+	{0x1e8a, 0x1e8a, "\u1e8b"},
+	{0x1e8c, 0x1e8c, "\u1e8d"},
+	{0x1e8e, 0x1e8e, "\u1e8f"},
+	{0x1e90, 0x1e90, "\u1e91"},
+	{0x1e92, 0x1e92, "\u1e93"},
+	{0x1e94, 0x1e94, "\u1e95"},
+	{0x1e9a, 0x1e9a, "a\u02be"},
+	{0x1e9b, 0x1e9b, "\u1e61"},
+	{0x1e9e, 0x1e9e, "\u00df"},
+	{0x1ea0, 0x1ea0, "\u1ea1"},
+	{0x1ea2, 0x1ea2, "\u1ea3"},
+	{0x1ea4, 0x1ea4, "\u1ea5"},
+	{0x1ea6, 0x1ea6, "\u1ea7"},
+	{0x1ea8, 0x1ea8, "\u1ea9"},
+	{0x1eaa, 0x1eaa, "\u1eab"},
+	{0x1eac, 0x1eac, "\u1ead"},
+	{0x1eae, 0x1eae, "\u1eaf"},
+	{0x1eb0, 0x1eb0, "\u1eb1"},
+	{0x1eb2, 0x1eb2, "\u1eb3"},
+	{0x1eb4, 0x1eb4, "\u1eb5"},
+	{0x1eb6, 0x1eb6, "\u1eb7"},
+	{0x1eb8, 0x1eb8, "\u1eb9"},
+	{0x1eba, 0x1eba, "\u1ebb"},
+	{0x1ebc, 0x1ebc, "\u1ebd"},
+	{0x1ebe, 0x1ebe, "\u1ebf"},
+	{0x1ec0, 0x1ec0, "\u1ec1"},
+	{0x1ec2, 0x1ec2, "\u1ec3"},
+	{0x1ec4, 0x1ec4, "\u1ec5"},
+	{0x1ec6, 0x1ec6, "\u1ec7"},
+	{0x1ec8, 0x1ec8, "\u1ec9"},
+	{0x1eca, 0x1eca, "\u1ecb"},
+	{0x1ecc, 0x1ecc, "\u1ecd"},
+	{0x1ece, 0x1ece, "\u1ecf"},
+	{0x1ed0, 0x1ed0, "\u1ed1"},
+	{0x1ed2, 0x1ed2, "\u1ed3"},
+	{0x1ed4, 0x1ed4, "\u1ed5"},
+	{0x1ed6, 0x1ed6, "\u1ed7"},
+	{0x1ed8, 0x1ed8, "\u1ed9"},
+	{0x1eda, 0x1eda, "\u1edb"},
+	{0x1edc, 0x1edc, "\u1edd"},
+	{0x1ede, 0x1ede, "\u1edf"},
+	{0x1ee0, 0x1ee0, "\u1ee1"},
+	{0x1ee2, 0x1ee2, "\u1ee3"},
+	{0x1ee4, 0x1ee4, "\u1ee5"},
+	{0x1ee6, 0x1ee6, "\u1ee7"},
+	{0x1ee8, 0x1ee8, "\u1ee9"},
+	{0x1eea, 0x1eea, "\u1eeb"},
+	{0x1eec, 0x1eec, "\u1eed"},
+	{0x1eee, 0x1eee, "\u1eef"},
+	{0x1ef0, 0x1ef0, "\u1ef1"},
+	{0x1ef2, 0x1ef2, "\u1ef3"},
+	{0x1ef4, 0x1ef4, "\u1ef5"},
+	{0x1ef6, 0x1ef6, "\u1ef7"},
+	{0x1ef8, 0x1ef8, "\u1ef9"},
+	{0x1efa, 0x1efa, "\u1efb"},
+	{0x1efc, 0x1efc, "\u1efd"},
+	{0x1efe, 0x1efe, "\u1eff"},
+	{0x1f08, 0x1f08, "\u1f00"},
+	{0x1f09, 0x1f09, "\u1f01"},
+	{0x1f0a, 0x1f0a, "\u1f02"},
+	{0x1f0b, 0x1f0b, "\u1f03"},
+	{0x1f0c, 0x1f0c, "\u1f04"},
+	{0x1f0d, 0x1f0d, "\u1f05"},
+	{0x1f0e, 0x1f0e, "\u1f06"},
+	{0x1f0f, 0x1f0f, "\u1f07"},
+	{0x1f18, 0x1f18, "\u1f10"},
+	{0x1f19, 0x1f19, "\u1f11"},
+	{0x1f1a, 0x1f1a, "\u1f12"},
+	{0x1f1b, 0x1f1b, "\u1f13"},
+	{0x1f1c, 0x1f1c, "\u1f14"},
+	{0x1f1d, 0x1f1d, "\u1f15"},
+	{0x1f28, 0x1f28, "\u1f20"},
+	{0x1f29, 0x1f29, "\u1f21"},
+	{0x1f2a, 0x1f2a, "\u1f22"},
+	{0x1f2b, 0x1f2b, "\u1f23"},
+	{0x1f2c, 0x1f2c, "\u1f24"},
+	{0x1f2d, 0x1f2d, "\u1f25"},
+	{0x1f2e, 0x1f2e, "\u1f26"},
+	{0x1f2f, 0x1f2f, "\u1f27"},
+	{0x1f38, 0x1f38, "\u1f30"},
+	{0x1f39, 0x1f39, "\u1f31"},
+	{0x1f3a, 0x1f3a, "\u1f32"},
+	{0x1f3b, 0x1f3b, "\u1f33"},
+	{0x1f3c, 0x1f3c, "\u1f34"},
+	{0x1f3d, 0x1f3d, "\u1f35"},
+	{0x1f3e, 0x1f3e, "\u1f36"},
+	{0x1f3f, 0x1f3f, "\u1f37"},
+	{0x1f48, 0x1f48, "\u1f40"},
+	{0x1f49, 0x1f49, "\u1f41"},
+	{0x1f4a, 0x1f4a, "\u1f42"},
+	{0x1f4b, 0x1f4b, "\u1f43"},
+	{0x1f4c, 0x1f4c, "\u1f44"},
+	{0x1f4d, 0x1f4d, "\u1f45"},
+	{0x1f59, 0x1f59, "\u1f51"},
+	{0x1f5b, 0x1f5b, "\u1f53"},
+	{0x1f5d, 0x1f5d, "\u1f55"},
+	{0x1f5f, 0x1f5f, "\u1f57"},
+	{0x1f68, 0x1f68, "\u1f60"},
+	{0x1f69, 0x1f69, "\u1f61"},
+	{0x1f6a, 0x1f6a, "\u1f62"},
+	{0x1f6b, 0x1f6b, "\u1f63"},
+	{0x1f6c, 0x1f6c, "\u1f64"},
+	{0x1f6d, 0x1f6d, "\u1f65"},
+	{0x1f6e, 0x1f6e, "\u1f66"},
+	{0x1f6f, 0x1f6f, "\u1f67"},
+	{0x1f71, 0x1f71, "\u03ac"},
+	{0x1f73, 0x1f73, "\u03ad"},
+	{0x1f75, 0x1f75, "\u03ae"},
+	{0x1f77, 0x1f77, "\u03af"},
+	{0x1f79, 0x1f79, "\u03cc"},
+	{0x1f7b, 0x1f7b, "\u03cd"},
+	{0x1f7d, 0x1f7d, "\u03ce"},
+	{0x1f80, 0x1f80, "\u1f00\u03b9"},
+	{0x1f81, 0x1f81, "\u1f01\u03b9"},
+	{0x1f82, 0x1f82, "\u1f02\u03b9"},
+	{0x1f83, 0x1f83, "\u1f03\u03b9"},
+	{0x1f84, 0x1f84, "\u1f04\u03b9"},
+	{0x1f85, 0x1f85, "\u1f05\u03b9"},
+	{0x1f86, 0x1f86, "\u1f06\u03b9"},
+	{0x1f87, 0x1f87, "\u1f07\u03b9"},
+	{0x1f88, 0x1f88, "\u1f00\u03b9"},
+	{0x1f89, 0x1f89, "\u1f01\u03b9"},
+	{0x1f8a, 0x1f8a, "\u1f02\u03b9"},
+	{0x1f8b, 0x1f8b, "\u1f03\u03b9"},
+	{0x1f8c, 0x1f8c, "\u1f04\u03b9"},
+	{0x1f8d, 0x1f8d, "\u1f05\u03b9"},
+	{0x1f8e, 0x1f8e, "\u1f06\u03b9"},
+	{0x1f8f, 0x1f8f, "\u1f07\u03b9"},
+	{0x1f90, 0x1f90, "\u1f20\u03b9"},
+	{0x1f91, 0x1f91, "\u1f21\u03b9"},
+	{0x1f92, 0x1f92, "\u1f22\u03b9"},
+	{0x1f93, 0x1f93, "\u1f23\u03b9"},
+	{0x1f94, 0x1f94, "\u1f24\u03b9"},
+	{0x1f95, 0x1f95, "\u1f25\u03b9"},
+	{0x1f96, 0x1f96, "\u1f26\u03b9"},
+	{0x1f97, 0x1f97, "\u1f27\u03b9"},
+	{0x1f98, 0x1f98, "\u1f20\u03b9"},
+	{0x1f99, 0x1f99, "\u1f21\u03b9"},
+	{0x1f9a, 0x1f9a, "\u1f22\u03b9"},
+	{0x1f9b, 0x1f9b, "\u1f23\u03b9"},
+	{0x1f9c, 0x1f9c, "\u1f24\u03b9"},
+	{0x1f9d, 0x1f9d, "\u1f25\u03b9"},
+	{0x1f9e, 0x1f9e, "\u1f26\u03b9"},
+	{0x1f9f, 0x1f9f, "\u1f27\u03b9"},
+	{0x1fa0, 0x1fa0, "\u1f60\u03b9"},
+	{0x1fa1, 0x1fa1, "\u1f61\u03b9"},
+	{0x1fa2, 0x1fa2, "\u1f62\u03b9"},
+	{0x1fa3, 0x1fa3, "\u1f63\u03b9"},
+	{0x1fa4, 0x1fa4, "\u1f64\u03b9"},
+	{0x1fa5, 0x1fa5, "\u1f65\u03b9"},
+	{0x1fa6, 0x1fa6, "\u1f66\u03b9"},
+	{0x1fa7, 0x1fa7, "\u1f67\u03b9"},
+	{0x1fa8, 0x1fa8, "\u1f60\u03b9"},
+	{0x1fa9, 0x1fa9, "\u1f61\u03b9"},
+	{0x1faa, 0x1faa, "\u1f62\u03b9"},
+	{0x1fab, 0x1fab, "\u1f63\u03b9"},
+	{0x1fac, 0x1fac, "\u1f64\u03b9"},
+	{0x1fad, 0x1fad, "\u1f65\u03b9"},
+	{0x1fae, 0x1fae, "\u1f66\u03b9"},
+	{0x1faf, 0x1faf, "\u1f67\u03b9"},
+	{0x1fb2, 0x1fb2, "\u1f70\u03b9"},
+	{0x1fb3, 0x1fb3, "\u03b1\u03b9"},
+	{0x1fb4, 0x1fb4, "\u03ac\u03b9"},
+	{0x1fb7, 0x1fb7, "\u1fb6\u03b9"},
+	{0x1fb8, 0x1fb8, "\u1fb0"},
+	{0x1fb9, 0x1fb9, "\u1fb1"},
+	{0x1fba, 0x1fba, "\u1f70"},
+	{0x1fbb, 0x1fbb, "\u03ac"},
+	{0x1fbc, 0x1fbc, "\u03b1\u03b9"},
+	{0x1fbe, 0x1fbe, "\u03b9"},
+	{0x1fc2, 0x1fc2, "\u1f74\u03b9"},
+	{0x1fc3, 0x1fc3, "\u03b7\u03b9"},
+	{0x1fc4, 0x1fc4, "\u03ae\u03b9"},
+	{0x1fc7, 0x1fc7, "\u1fc6\u03b9"},
+	{0x1fc8, 0x1fc8, "\u1f72"},
+	{0x1fc9, 0x1fc9, "\u03ad"},
+	{0x1fca, 0x1fca, "\u1f74"},
+	{0x1fcb, 0x1fcb, "\u03ae"},
+	{0x1fcc, 0x1fcc, "\u03b7\u03b9"},
+	{0x1fd3, 0x1fd3, "\u0390"},
+	{0x1fd8, 0x1fd8, "\u1fd0"},
+	{0x1fd9, 0x1fd9, "\u1fd1"},
+	{0x1fda, 0x1fda, "\u1f76"},
+	{0x1fdb, 0x1fdb, "\u03af"},
+	{0x1fe3, 0x1fe3, "\u03b0"},
+	{0x1fe8, 0x1fe8, "\u1fe0"},
+	{0x1fe9, 0x1fe9, "\u1fe1"},
+	{0x1fea, 0x1fea, "\u1f7a"},
+	{0x1feb, 0x1feb, "\u03cd"},
+	{0x1fec, 0x1fec, "\u1fe5"},
+	{0x1ff2, 0x1ff2, "\u1f7c\u03b9"},
+	{0x1ff3, 0x1ff3, "\u03c9\u03b9"},
+	{0x1ff4, 0x1ff4, "\u03ce\u03b9"},
+	{0x1ff7, 0x1ff7, "\u1ff6\u03b9"},
+	{0x1ff8, 0x1ff8, "\u1f78"},
+	{0x1ff9, 0x1ff9, "\u03cc"},
+	{0x1ffa, 0x1ffa, "\u1f7c"},
+	{0x1ffb, 0x1ffb, "\u03ce"},
+	{0x1ffc, 0x1ffc, "\u03c9\u03b9"},
+	{0x2011, 0x2011, "\u2010"},
+	{0x2033, 0x2033, "\u2032\u2032"},
+	{0x2034, 0x2034, "\u2032\u2032\u2032"},
+	{0x2036, 0x2036, "\u2035\u2035"},
+	{0x2037, 0x2037, "\u2035\u2035\u2035"},
+	{0x2057, 0x2057, "\u2032\u2032\u2032\u2032"},
+	{0x2070, 0x2070, "0"},
+	{0x2071, 0x2071, "i"},
+	{0x2074, 0x2074, "4"},
+	{0x2075, 0x2075, "5"},
+	{0x2076, 0x2076, "6"},
+	{0x2077, 0x2077, "7"},
+	{0x2078, 0x2078, "8"},
+	{0x2079, 0x2079, "9"},
+	{0x207b, 0x207b, "\u2212"},
+	{0x207f, 0x207f, "n"},
+	{0x2080, 0x2080, "0"},
+	{0x2081, 0x2081, "1"},
+	{0x2082, 0x2082, "2"},
+	{0x2083, 0x2083, "3"},
+	{0x2084, 0x2084, "4"},
+	{0x2085, 0x2085, "5"},
+	{0x2086, 0x2086, "6"},
+	{0x2087, 0x2087, "7"},
+	{0x2088, 0x2088, "8"},
+	{0x2089, 0x2089, "9"},
+	{0x208b, 0x208b, "\u2212"},
+	{0x2090, 0x2090, "a"},
+	{0x2091, 0x2091, "e"},
+	{0x2092, 0x2092, "o"},
+	{0x2093, 0x2093, "x"},
+	{0x2094, 0x2094, "\u0259"},
+	{0x2095, 0x2095, "h"},
+	{0x2096, 0x2096, "k"},
+	{0x2097, 0x2097, "l"},
+	{0x2098, 0x2098, "m"},
+	{0x2099, 0x2099, "n"},
+	{0x209a, 0x209a, "p"},
+	{0x209b, 0x209b, "s"},
+	{0x209c, 0x209c, "t"},
+	{0x20a8, 0x20a8, "rs"},
+	{0x2102, 0x2102, "c"},
+	{0x2103, 0x2103, "\u00b0c"},
+	{0x2107, 0x2107, "\u025b"},
+	{0x2109, 0x2109, "\u00b0f"},
+	{0x210a, 0x210a, "g"},
+	{0x210b, 0x210e, "h"},
+	{0x210f, 0x210f, "\u0127"},
+	{0x2110, 0x2111, "i"},
+	{0x2112, 0x2113, "l"},
+	{0x2115, 0x2115, "n"},
+	{0x2116, 0x2116, "no"},
+	{0x2119, 0x2119, "p"},
+	{0x211a, 0x211a, "q"},
+	{0x211b, 0x211d, "r"},
+	{0x2120, 0x2120, "sm"},
+	{0x2121, 0x2121, "tel"},
+	{0x2122, 0x2122, "tm"},
+	{0x2124, 0x2124, "z"},
+	{0x2126, 0x2126, "\u03c9"},
+	{0x2128, 0x2128, "z"},
+	{0x212a, 0x212a, "k"},
+	{0x212b, 0x212b, "\u00e5"},
+	{0x212c, 0x212c, "b"},
+	{0x212d, 0x212d, "c"},
+	{0x212f, 0x2130, "e"},
+	{0x2131, 0x2131, "f"},
+	{0x2133, 0x2133, "m"},
+	{0x2134, 0x2134, "o"},
+	{0x2135, 0x2135, "\u05d0"},
+	{0x2136, 0x2136, "\u05d1"},
+	{0x2137, 0x2137, "\u05d2"},
+	{0x2138, 0x2138, "\u05d3"},
+	{0x2139, 0x2139, "i"},
+	{0x213b, 0x213b, "fax"},
+	{0x213c, 0x213c, "\u03c0"},
+	{0x213d, 0x213e, "\u03b3"},
+	{0x213f, 0x213f, "\u03c0"},
+	{0x2140, 0x2140, "\u2211"},
+	{0x2145, 0x2146, "d"},
+	{0x2147, 0x2147, "e"},
+	{0x2148, 0x2148, "i"},
+	{0x2149, 0x2149, "j"},
+	{0x2150, 0x2150, "1\u20447"},
+	{0x2151, 0x2151, "1\u20449"},
+	{0x2152, 0x2152, "1\u204410"},
+	{0x2153, 0x2153, "1\u20443"},
+	{0x2154, 0x2154, "2\u20443"},
+	{0x2155, 0x2155, "1\u20445"},
+	{0x2156, 0x2156, "2\u20445"},
+	{0x2157, 0x2157, "3\u20445"},
+	{0x2158, 0x2158, "4\u20445"},
+	{0x2159, 0x2159, "1\u20446"},
+	{0x215a, 0x215a, "5\u20446"},
+	{0x215b, 0x215b, "1\u20448"},
+	{0x215c, 0x215c, "3\u20448"},
+	{0x215d, 0x215d, "5\u20448"},
+	{0x215e, 0x215e, "7\u20448"},
+	{0x215f, 0x215f, "1\u2044"},
+	{0x2160, 0x2160, "i"},
+	{0x2161, 0x2161, "ii"},
+	{0x2162, 0x2162, "iii"},
+	{0x2163, 0x2163, "iv"},
+	{0x2164, 0x2164, "v"},
+	{0x2165, 0x2165, "vi"},
+	{0x2166, 0x2166, "vii"},
+	{0x2167, 0x2167, "viii"},
+	{0x2168, 0x2168, "ix"},
+	{0x2169, 0x2169, "x"},
+	{0x216a, 0x216a, "xi"},
+	{0x216b, 0x216b, "xii"},
+	{0x216c, 0x216c, "l"},
+	{0x216d, 0x216d, "c"},
+	{0x216e, 0x216e, "d"},
+	{0x216f, 0x216f, "m"},
+	{0x2170, 0x2170, "i"},
+	{0x2171, 0x2171, "ii"},
+	{0x2172, 0x2172, "iii"},
+	{0x2173, 0x2173, "iv"},
+	{0x2174, 0x2174, "v"},
+	{0x2175, 0x2175, "vi"},
+	{0x2176, 0x2176, "vii"},
+	{0x2177, 0x2177, "viii"},
+	{0x2178, 0x2178, "ix"},
+	{0x2179, 0x2179, "x"},
+	{0x217a, 0x217a, "xi"},
+	{0x217b, 0x217b, "xii"},
+	{0x217c, 0x217c, "l"},
+	{0x217d, 0x217d, "c"},
+	{0x217e, 0x217e, "d"},
+	{0x217f, 0x217f, "m"},
+	{0x2189, 0x2189, "0\u20443"},
+	{0x222c, 0x222c, "\u222b\u222b"},
+	{0x222d, 0x222d, "\u222b\u222b\u222b"},
+	{0x222f, 0x222f, "\u222e\u222e"},
+	{0x2230, 0x2230, "\u222e\u222e\u222e"},
+	{0x2329, 0x2329, "\u3008"},
+	{0x232a, 0x232a, "\u3009"},
+	{0x2460, 0x2460, "1"},
+	{0x2461, 0x2461, "2"},
+	{0x2462, 0x2462, "3"},
+	{0x2463, 0x2463, "4"},
+	{0x2464, 0x2464, "5"},
+	{0x2465, 0x2465, "6"},
+	{0x2466, 0x2466, "7"},
+	{0x2467, 0x2467, "8"},
+	{0x2468, 0x2468, "9"},
+	{0x2469, 0x2469, "10"},
+	{0x246a, 0x246a, "11"},
+	{0x246b, 0x246b, "12"},
+	{0x246c, 0x246c, "13"},
+	{0x246d, 0x246d, "14"},
+	{0x246e, 0x246e, "15"},
+	{0x246f, 0x246f, "16"},
+	{0x2470, 0x2470, "17"},
+	{0x2471, 0x2471, "18"},
+	{0x2472, 0x2472, "19"},
+	{0x2473, 0x2473, "20"},
+	{0x24b6, 0x24b6, "a"},
+	{0x24b7, 0x24b7, "b"},
+	{0x24b8, 0x24b8, "c"},
+	{0x24b9, 0x24b9, "d"},
+	{0x24ba, 0x24ba, "e"},
+	{0x24bb, 0x24bb, "f"},
+	{0x24bc, 0x24bc, "g"},
+	{0x24bd, 0x24bd, "h"},
+	{0x24be, 0x24be, "i"},
+	{0x24bf, 0x24bf, "j"},
+	{0x24c0, 0x24c0, "k"},
+	{0x24c1, 0x24c1, "l"},
+	{0x24c2, 0x24c2, "m"},
+	{0x24c3, 0x24c3, "n"},
+	{0x24c4, 0x24c4, "o"},
+	{0x24c5, 0x24c5, "p"},
+	{0x24c6, 0x24c6, "q"},
+	{0x24c7, 0x24c7, "r"},
+	{0x24c8, 0x24c8, "s"},
+	{0x24c9, 0x24c9, "t"},
+	{0x24ca, 0x24ca, "u"},
+	{0x24cb, 0x24cb, "v"},
+	{0x24cc, 0x24cc, "w"},
+	{0x24cd, 0x24cd, "x"},
+	{0x24ce, 0x24ce, "y"},
+	{0x24cf, 0x24cf, "z"},
+	{0x24d0, 0x24d0, "a"},
+	{0x24d1, 0x24d1, "b"},
+	{0x24d2, 0x24d2, "c"},
+	{0x24d3, 0x24d3, "d"},
+	{0x24d4, 0x24d4, "e"},
+	{0x24d5, 0x24d5, "f"},
+	{0x24d6, 0x24d6, "g"},
+	{0x24d7, 0x24d7, "h"},
+	{0x24d8, 0x24d8, "i"},
+	{0x24d9, 0x24d9, "j"},
+	{0x24da, 0x24da, "k"},
+	{0x24db, 0x24db, "l"},
+	{0x24dc, 0x24dc, "m"},
+	{0x24dd, 0x24dd, "n"},
+	{0x24de, 0x24de, "o"},
+	{0x24df, 0x24df, "p"},
+	{0x24e0, 0x24e0, "q"},
+	{0x24e1, 0x24e1, "r"},
+	{0x24e2, 0x24e2, "s"},
+	{0x24e3, 0x24e3, "t"},
+	{0x24e4, 0x24e4, "u"},
+	{0x24e5, 0x24e5, "v"},
+	{0x24e6, 0x24e6, "w"},
+	{0x24e7, 0x24e7, "x"},
+	{0x24e8, 0x24e8, "y"},
+	{0x24e9, 0x24e9, "z"},
+	{0x24ea, 0x24ea, "0"},
+	{0x2a0c, 0x2a0c, "\u222b\u222b\u222b\u222b"},
+	{0x2adc, 0x2adc, "\u2add\u0338"},
+	{0x2c00, 0x2c00, "\u2c30"},
+	{0x2c01, 0x2c01, "\u2c31"},
+	{0x2c02, 0x2c02, "\u2c32"},
+	{0x2c03, 0x2c03, "\u2c33"},
+	{0x2c04, 0x2c04, "\u2c34"},
+	{0x2c05, 0x2c05, "\u2c35"},
+	{0x2c06, 0x2c06, "\u2c36"},
+	{0x2c07, 0x2c07, "\u2c37"},
+	{0x2c08, 0x2c08, "\u2c38"},
+	{0x2c09, 0x2c09, "\u2c39"},
+	{0x2c0a, 0x2c0a, "\u2c3a"},
+	{0x2c0b, 0x2c0b, "\u2c3b"},
+	{0x2c0c, 0x2c0c, "\u2c3c"},
+	{0x2c0d, 0x2c0d, "\u2c3d"},
+	{0x2c0e, 0x2c0e, "\u2c3e"},
+	{0x2c0f, 0x2c0f, "\u2c3f"},
+	{0x2c10, 0x2c10, "\u2c40"},
+	{0x2c11, 0x2c11, "\u2c41"},
+	{0x2c12, 0x2c12, "\u2c42"},
+	{0x2c13, 0x2c13, "\u2c43"},
+	{0x2c14, 0x2c14, "\u2c44"},
+	{0x2c15, 0x2c15, "\u2c45"},
+	{0x2c16, 0x2c16, "\u2c46"},
+	{0x2c17, 0x2c17, "\u2c47"},
+	{0x2c18, 0x2c18, "\u2c48"},
+	{0x2c19, 0x2c19, "\u2c49"},
+	{0x2c1a, 0x2c1a, "\u2c4a"},
+	{0x2c1b, 0x2c1b, "\u2c4b"},
+	{0x2c1c, 0x2c1c, "\u2c4c"},
+	{0x2c1d, 0x2c1d, "\u2c4d"},
+	{0x2c1e, 0x2c1e, "\u2c4e"},
+	{0x2c1f, 0x2c1f, "\u2c4f"},
+	{0x2c20, 0x2c20, "\u2c50"},
+	{0x2c21, 0x2c21, "\u2c51"},
+	{0x2c22, 0x2c22, "\u2c52"},
+	{0x2c23, 0x2c23, "\u2c53"},
+	{0x2c24, 0x2c24, "\u2c54"},
+	{0x2c25, 0x2c25, "\u2c55"},
+	{0x2c26, 0x2c26, "\u2c56"},
+	{0x2c27, 0x2c27, "\u2c57"},
+	{0x2c28, 0x2c28, "\u2c58"},
+	{0x2c29, 0x2c29, "\u2c59"},
+	{0x2c2a, 0x2c2a, "\u2c5a"},
+	{0x2c2b, 0x2c2b, "\u2c5b"},
+	{0x2c2c, 0x2c2c, "\u2c5c"},
+	{0x2c2d, 0x2c2d, "\u2c5d"},
+	{0x2c2e, 0x2c2e, "\u2c5e"},
+	{0x2c2f, 0x2c2f, "\u2c5f"},
+	{0x2c60, 0x2c60, "\u2c61"},
+	{0x2c62, 0x2c62, "\u026b"},
+	{0x2c63, 0x2c63, "\u1d7d"},
+	{0x2c64, 0x2c64, "\u027d"},
+	{0x2c67, 0x2c67, "\u2c68"},
+	{0x2c69, 0x2c69, "\u2c6a"},
+	{0x2c6b, 0x2c6b, "\u2c6c"},
+	{0x2c6d, 0x2c6d, "\u0251"},
+	{0x2c6e, 0x2c6e, "\u0271"},
+	{0x2c6f, 0x2c6f, "\u0250"},
+	{0x2c70, 0x2c70, "\u0252"},
+	{0x2c72, 0x2c72, "\u2c73"},
+	{0x2c75, 0x2c75, "\u2c76"},
+	{0x2c7c, 0x2c7c, "j"},
+	{0x2c7d, 0x2c7d, "v"},
+	{0x2c7e, 0x2c7e, "\u023f"},
+	{0x2c7f, 0x2c7f, "\u0240"},
+	{0x2c80, 0x2c80, "\u2c81"},
+	{0x2c82, 0x2c82, "\u2c83"},
+	{0x2c84, 0x2c84, "\u2c85"},
+	{0x2c86, 0x2c86, "\u2c87"},
+	{0x2c88, 0x2c88, "\u2c89"},
+	{0x2c8a, 0x2c8a, "\u2c8b"},
+	{0x2c8c, 0x2c8c, "\u2c8d"},
+	{0x2c8e, 0x2c8e, "\u2c8f"},
+	{0x2c90, 0x2c90, "\u2c91"},
+	{0x2c92, 0x2c92, "\u2c93"},
+	{0x2c94, 0x2c94, "\u2c95"},
+	{0x2c96, 0x2c96, "\u2c97"},
+	{0x2c98, 0x2c98, "\u2c99"},
+	{0x2c9a, 0x2c9a, "\u2c9b"},
+	{0x2c9c, 0x2c9c, "\u2c9d"},
+	{0x2c9e, 0x2c9e, "\u2c9f"},
+	{0x2ca0, 0x2ca0, "\u2ca1"},
+	{0x2ca2, 0x2ca2, "\u2ca3"},
+	{0x2ca4, 0x2ca4, "\u2ca5"},
+	{0x2ca6, 0x2ca6, "\u2ca7"},
+	{0x2ca8, 0x2ca8, "\u2ca9"},
+	{0x2caa, 0x2caa, "\u2cab"},
+	{0x2cac, 0x2cac, "\u2cad"},
+	{0x2cae, 0x2cae, "\u2caf"},
+	{0x2cb0, 0x2cb0, "\u2cb1"},
+	{0x2cb2, 0x2cb2, "\u2cb3"},
+	{0x2cb4, 0x2cb4, "\u2cb5"},
+	{0x2cb6, 0x2cb6, "\u2cb7"},
+	{0x2cb8, 0x2cb8, "\u2cb9"},
+	{0x2cba, 0x2cba, "\u2cbb"},
+	{0x2cbc, 0x2cbc, "\u2cbd"},
+	{0x2cbe, 0x2cbe, "\u2cbf"},
+	{0x2cc0, 0x2cc0, "\u2cc1"},
+	{0x2cc2, 0x2cc2, "\u2cc3"},
+	{0x2cc4, 0x2cc4, "\u2cc5"},
+	{0x2cc6, 0x2cc6, "\u2cc7"},
+	{0x2cc8, 0x2cc8, "\u2cc9"},
+	{0x2cca, 0x2cca, "\u2ccb"},
+	{0x2ccc, 0x2ccc, "\u2ccd"},
+	{0x2cce, 0x2cce, "\u2ccf"},
+	{0x2cd0, 0x2cd0, "\u2cd1"},
+	{0x2cd2, 0x2cd2, "\u2cd3"},
+	{0x2cd4, 0x2cd4, "\u2cd5"},
+	{0x2cd6, 0x2cd6, "\u2cd7"},
+	{0x2cd8, 0x2cd8, "\u2cd9"},
+	{0x2cda, 0x2cda, "\u2cdb"},
+	{0x2cdc, 0x2cdc, "\u2cdd"},
+	{0x2cde, 0x2cde, "\u2cdf"},
+	{0x2ce0, 0x2ce0, "\u2ce1"},
+	{0x2ce2, 0x2ce2, "\u2ce3"},
+	{0x2ceb, 0x2ceb, "\u2cec"},
+	{0x2ced, 0x2ced, "\u2cee"},
+	{0x2cf2, 0x2cf2, "\u2cf3"},
+	{0x2d6f, 0x2d6f, "\u2d61"},
+	{0x2e9f, 0x2e9f, "\u6bcd"},
+	{0x2ef3, 0x2ef3, "\u9f9f"},
+	{0x2f00, 0x2f00, "\u4e00"},
+	{0x2f01, 0x2f01, "\u4e28"},
+	{0x2f02, 0x2f02, "\u4e36"},
+	{0x2f03, 0x2f03, "\u4e3f"},
+	{0x2f04, 0x2f04, "\u4e59"},
+	{0x2f05, 0x2f05, "\u4e85"},
+	{0x2f06, 0x2f06, "\u4e8c"},
+	{0x2f07, 0x2f07, "\u4ea0"},
+	{0x2f08, 0x2f08, "\u4eba"},
+	{0x2f09, 0x2f09, "\u513f"},
+	{0x2f0a, 0x2f0a, "\u5165"},
+	{0x2f0b, 0x2f0b, "\u516b"},
+	{0x2f0c, 0x2f0c, "\u5182"},
+	{0x2f0d, 0x2f0d, "\u5196"},
+	{0x2f0e, 0x2f0e, "\u51ab"},
+	{0x2f0f, 0x2f0f, "\u51e0"},
+	{0x2f10, 0x2f10, "\u51f5"},
+	{0x2f11, 0x2f11, "\u5200"},
+	{0x2f12, 0x2f12, "\u529b"},
+	{0x2f13, 0x2f13, "\u52f9"},
+	{0x2f14, 0x2f14, "\u5315"},
+	{0x2f15, 0x2f15, "\u531a"},
+	{0x2f16, 0x2f16, "\u5338"},
+	{0x2f17, 0x2f17, "\u5341"},
+	{0x2f18, 0x2f18, "\u535c"},
+	{0x2f19, 0x2f19, "\u5369"},
+	{0x2f1a, 0x2f1a, "\u5382"},
+	{0x2f1b, 0x2f1b, "\u53b6"},
+	{0x2f1c, 0x2f1c, "\u53c8"},
+	{0x2f1d, 0x2f1d, "\u53e3"},
+	{0x2f1e, 0x2f1e, "\u56d7"},
+	{0x2f1f, 0x2f1f, "\u571f"},
+	{0x2f20, 0x2f20, "\u58eb"},
+	{0x2f21, 0x2f21, "\u5902"},
+	{0x2f22, 0x2f22, "\u590a"},
+	{0x2f23, 0x2f23, "\u5915"},
+	{0x2f24, 0x2f24, "\u5927"},
+	{0x2f25, 0x2f25, "\u5973"},
+	{0x2f26, 0x2f26, "\u5b50"},
+	{0x2f27, 0x2f27, "\u5b80"},
+	{0x2f28, 0x2f28, "\u5bf8"},
+	{0x2f29, 0x2f29, "\u5c0f"},
+	{0x2f2a, 0x2f2a, "\u5c22"},
+	{0x2f2b, 0x2f2b, "\u5c38"},
+	{0x2f2c, 0x2f2c, "\u5c6e"},
+	{0x2f2d, 0x2f2d, "\u5c71"},
+	{0x2f2e, 0x2f2e, "\u5ddb"},
+	{0x2f2f, 0x2f2f, "\u5de5"},
+	{0x2f30, 0x2f30, "\u5df1"},
+	{0x2f31, 0x2f31, "\u5dfe"},
+	{0x2f32, 0x2f32, "\u5e72"},
+	{0x2f33, 0x2f33, "\u5e7a"},
+	{0x2f34, 0x2f34, "\u5e7f"},
+	{0x2f35, 0x2f35, "\u5ef4"},
+	{0x2f36, 0x2f36, "\u5efe"},
+	{0x2f37, 0x2f37, "\u5f0b"},
+	{0x2f38, 0x2f38, "\u5f13"},
+	{0x2f39, 0x2f39, "\u5f50"},
+	{0x2f3a, 0x2f3a, "\u5f61"},
+	{0x2f3b, 0x2f3b, "\u5f73"},
+	{0x2f3c, 0x2f3c, "\u5fc3"},
+	{0x2f3d, 0x2f3d, "\u6208"},
+	{0x2f3e, 0x2f3e, "\u6236"},
+	{0x2f3f, 0x2f3f, "\u624b"},
+	{0x2f40, 0x2f40, "\u652f"},
+	{0x2f41, 0x2f41, "\u6534"},
+	{0x2f42, 0x2f42, "\u6587"},
+	{0x2f43, 0x2f43, "\u6597"},
+	{0x2f44, 0x2f44, "\u65a4"},
+	{0x2f45, 0x2f45, "\u65b9"},
+	{0x2f46, 0x2f46, "\u65e0"},
+	{0x2f47, 0x2f47, "\u65e5"},
+	{0x2f48, 0x2f48, "\u66f0"},
+	{0x2f49, 0x2f49, "\u6708"},
+	{0x2f4a, 0x2f4a, "\u6728"},
+	{0x2f4b, 0x2f4b, "\u6b20"},
+	{0x2f4c, 0x2f4c, "\u6b62"},
+	{0x2f4d, 0x2f4d, "\u6b79"},
+	{0x2f4e, 0x2f4e, "\u6bb3"},
+	{0x2f4f, 0x2f4f, "\u6bcb"},
+	{0x2f50, 0x2f50, "\u6bd4"},
+	{0x2f51, 0x2f51, "\u6bdb"},
+	{0x2f52, 0x2f52, "\u6c0f"},
+	{0x2f53, 0x2f53, "\u6c14"},
+	{0x2f54, 0x2f54, "\u6c34"},
+	{0x2f55, 0x2f55, "\u706b"},
+	{0x2f56, 0x2f56, "\u722a"},
+	{0x2f57, 0x2f57, "\u7236"},
+	{0x2f58, 0x2f58, "\u723b"},
+	{0x2f59, 0x2f59, "\u723f"},
+	{0x2f5a, 0x2f5a, "\u7247"},
+	{0x2f5b, 0x2f5b, "\u7259"},
+	{0x2f5c, 0x2f5c, "\u725b"},
+	{0x2f5d, 0x2f5d, "\u72ac"},
+	{0x2f5e, 0x2f5e, "\u7384"},
+	{0x2f5f, 0x2f5f, "\u7389"},
+	{0x2f60, 0x2f60, "\u74dc"},
+	{0x2f61, 0x2f61, "\u74e6"},
+	{0x2f62, 0x2f62, "\u7518"},
+	{0x2f63, 0x2f63, "\u751f"},
+	{0x2f64, 0x2f64, "\u7528"},
+	{0x2f65, 0x2f65, "\u7530"},
+	{0x2f66, 0x2f66, "\u758b"},
+	{0x2f67, 0x2f67, "\u7592"},
+	{0x2f68, 0x2f68, "\u7676"},
+	{0x2f69, 0x2f69, "\u767d"},
+	{0x2f6a, 0x2f6a, "\u76ae"},
+	{0x2f6b, 0x2f6b, "\u76bf"},
+	{0x2f6c, 0x2f6c, "\u76ee"},
+	{0x2f6d, 0x2f6d, "\u77db"},
+	{0x2f6e, 0x2f6e, "\u77e2"},
+	{0x2f6f, 0x2f6f, "\u77f3"},
+	{0x2f70, 0x2f70, "\u793a"},
+	{0x2f71, 0x2f71, "\u79b8"},
+	{0x2f72, 0x2f72, "\u79be"},
+	{0x2f73, 0x2f73, "\u7a74"},
+	{0x2f74, 0x2f74, "\u7acb"},
+	{0x2f75, 0x2f75, "\u7af9"},
+	{0x2f76, 0x2f76, "\u7c73"},
+	{0x2f77, 0x2f77, "\u7cf8"},
+	{0x2f78, 0x2f78, "\u7f36"},
+	{0x2f79, 0x2f79, "\u7f51"},
+	{0x2f7a, 0x2f7a, "\u7f8a"},
+	{0x2f7b, 0x2f7b, "\u7fbd"},
+	{0x2f7c, 0x2f7c, "\u8001"},
+	{0x2f7d, 0x2f7d, "\u800c"},
+	{0x2f7e, 0x2f7e, "\u8012"},
+	{0x2f7f, 0x2f7f, "\u8033"},
+	{0x2f80, 0x2f80, "\u807f"},
+	{0x2f81, 0x2f81, "\u8089"},
+	{0x2f82, 0x2f82, "\u81e3"},
+	{0x2f83, 0x2f83, "\u81ea"},
+	{0x2f84, 0x2f84, "\u81f3"},
+	{0x2f85, 0x2f85, "\u81fc"},
+	{0x2f86, 0x2f86, "\u820c"},
+	{0x2f87, 0x2f87, "\u821b"},
+	{0x2f88, 0x2f88, "\u821f"},
+	{0x2f89, 0x2f89, "\u826e"},
+	{0x2f8a, 0x2f8a, "\u8272"},
+	{0x2f8b, 0x2f8b, "\u8278"},
+	{0x2f8c, 0x2f8c, "\u864d"},
+	{0x2f8d, 0x2f8d, "\u866b"},
+	{0x2f8e, 0x2f8e, "\u8840"},
+	{0x2f8f, 0x2f8f, "\u884c"},
+	{0x2f90, 0x2f90, "\u8863"},
+	{0x2f91, 0x2f91, "\u897e"},
+	{0x2f92, 0x2f92, "\u898b"},
+	{0x2f93, 0x2f93, "\u89d2"},
+	{0x2f94, 0x2f94, "\u8a00"},
+	{0x2f95, 0x2f95, "\u8c37"},
+	{0x2f96, 0x2f96, "\u8c46"},
+	{0x2f97, 0x2f97, "\u8c55"},
+	{0x2f98, 0x2f98, "\u8c78"},
+	{0x2f99, 0x2f99, "\u8c9d"},
+	{0x2f9a, 0x2f9a, "\u8d64"},
+	{0x2f9b, 0x2f9b, "\u8d70"},
+	{0x2f9c, 0x2f9c, "\u8db3"},
+	{0x2f9d, 0x2f9d, "\u8eab"},
+	{0x2f9e, 0x2f9e, "\u8eca"},
+	{0x2f9f, 0x2f9f, "\u8f9b"},
+	{0x2fa0, 0x2fa0, "\u8fb0"},
+	{0x2fa1, 0x2fa1, "\u8fb5"},
+	{0x2fa2, 0x2fa2, "\u9091"},
+	{0x2fa3, 0x2fa3, "\u9149"},
+	{0x2fa4, 0x2fa4, "\u91c6"},
+	{0x2fa5, 0x2fa5, "\u91cc"},
+	{0x2fa6, 0x2fa6, "\u91d1"},
+	{0x2fa7, 0x2fa7, "\u9577"},
+	{0x2fa8, 0x2fa8, "\u9580"},
+	{0x2fa9, 0x2fa9, "\u961c"},
+	{0x2faa, 0x2faa, "\u96b6"},
+	{0x2fab, 0x2fab, "\u96b9"},
+	{0x2fac, 0x2fac, "\u96e8"},
+	{0x2fad, 0x2fad, "\u9751"},
+	{0x2fae, 0x2fae, "\u975e"},
+	{0x2faf, 0x2faf, "\u9762"},
+	{0x2fb0, 0x2fb0, "\u9769"},
+	{0x2fb1, 0x2fb1, "\u97cb"},
+	{0x2fb2, 0x2fb2, "\u97ed"},
+	{0x2fb3, 0x2fb3, "\u97f3"},
+	{0x2fb4, 0x2fb4, "\u9801"},
+	{0x2fb5, 0x2fb5, "\u98a8"},
+	{0x2fb6, 0x2fb6, "\u98db"},
+	{0x2fb7, 0x2fb7, "\u98df"},
+	{0x2fb8, 0x2fb8, "\u9996"},
+	{0x2fb9, 0x2fb9, "\u9999"},
+	{0x2fba, 0x2fba, "\u99ac"},
+	{0x2fbb, 0x2fbb, "\u9aa8"},
+	{0x2fbc, 0x2fbc, "\u9ad8"},
+	{0x2fbd, 0x2fbd, "\u9adf"},
+	{0x2fbe, 0x2fbe, "\u9b25"},
+	{0x2fbf, 0x2fbf, "\u9b2f"},
+	{0x2fc0, 0x2fc0, "\u9b32"},
+	{0x2fc1, 0x2fc1, "\u9b3c"},
+	{0x2fc2, 0x2fc2, "\u9b5a"},
+	{0x2fc3, 0x2fc3, "\u9ce5"},
+	{0x2fc4, 0x2fc4, "\u9e75"},
+	{0x2fc5, 0x2fc5, "\u9e7f"},
+	{0x2fc6, 0x2fc6, "\u9ea5"},
+	{0x2fc7, 0x2fc7, "\u9ebb"},
+	{0x2fc8, 0x2fc8, "\u9ec3"},
+	{0x2fc9, 0x2fc9, "\u9ecd"},
+	{0x2fca, 0x2fca, "\u9ed1"},
+	{0x2fcb, 0x2fcb, "\u9ef9"},
+	{0x2fcc, 0x2fcc, "\u9efd"},
+	{0x2fcd, 0x2fcd, "\u9f0e"},
+	{0x2fce, 0x2fce, "\u9f13"},
+	{0x2fcf, 0x2fcf, "\u9f20"},
+	{0x2fd0, 0x2fd0, "\u9f3b"},
+	{0x2fd1, 0x2fd1, "\u9f4a"},
+	{0x2fd2, 0x2fd2, "\u9f52"},
+	{0x2fd3, 0x2fd3, "\u9f8d"},
+	{0x2fd4, 0x2fd4, "\u9f9c"},
+	{0x2fd5, 0x2fd5, "\u9fa0"},
+	{0x3002, 0x3002, "."},
+	{0x3036, 0x3036, "\u3012"},
+	{0x3038, 0x3038, "\u5341"},
+	{0x3039, 0x3039, "\u5344"},
+	{0x303a, 0x303a, "\u5345"},
+	{0x309f, 0x309f, "\u3088\u308a"},
+	{0x30ff, 0x30ff, "\u30b3\u30c8"},
+	{0x3131, 0x3131, "\u1100"},
+	{0x3132, 0x3132, "\u1101"},
+	{0x3133, 0x3133, "\u11aa"},
+	{0x3134, 0x3134, "\u1102"},
+	{0x3135, 0x3135, "\u11ac"},
+	{0x3136, 0x3136, "\u11ad"},
+	{0x3137, 0x3137, "\u1103"},
+	{0x3138, 0x3138, "\u1104"},
+	{0x3139, 0x3139, "\u1105"},
+	{0x313a, 0x313a, "\u11b0"},
+	{0x313b, 0x313b, "\u11b1"},
+	{0x313c, 0x313c, "\u11b2"},
+	{0x313d, 0x313d, "\u11b3"},
+	{0x313e, 0x313e, "\u11b4"},
+	{0x313f, 0x313f, "\u11b5"},
+	{0x3140, 0x3140, "\u111a"},
+	{0x3141, 0x3141, "\u1106"},
+	{0x3142, 0x3142, "\u1107"},
+	{0x3143, 0x3143, "\u1108"},
+	{0x3144, 0x3144, "\u1121"},
+	{0x3145, 0x3145, "\u1109"},
+	{0x3146, 0x3146, "\u110a"},
+	{0x3147, 0x3147, "\u110b"},
+	{0x3148, 0x3148, "\u110c"},
+	{0x3149, 0x3149, "\u110d"},
+	{0x314a, 0x314a, "\u110e"},
+	{0x314b, 0x314b, "\u110f"},
+	{0x314c, 0x314c, "\u1110"},
+	{0x314d, 0x314d, "\u1111"},
+	{0x314e, 0x314e, "\u1112"},
+	{0x314f, 0x314f, "\u1161"},
+	{0x3150, 0x3150, "\u1162"},
+	{0x3151, 0x3151, "\u1163"},
+	{0x3152, 0x3152, "\u1164"},
+	{0x3153, 0x3153, "\u1165"},
+	{0x3154, 0x3154, "\u1166"},
+	{0x3155, 0x3155, "\u1167"},
+	{0x3156, 0x3156, "\u1168"},
+	{0x3157, 0x3157, "\u1169"},
+	{0x3158, 0x3158, "\u116a"},
+	{0x3159, 0x3159, "\u116b"},
+	{0x315a, 0x315a, "\u116c"},
+	{0x315b, 0x315b, "\u116d"},
+	{0x315c, 0x315c, "\u116e"},
+	{0x315d, 0x315d, "\u116f"},
+	{0x315e, 0x315e, "\u1170"},
+	{0x315f, 0x315f, "\u1171"},
+	{0x3160, 0x3160, "\u1172"},
+	{0x3161, 0x3161, "\u1173"},
+	{0x3162, 0x3162, "\u1174"},
+	{0x3163, 0x3163, "\u1175"},
+	{0x3165, 0x3165, "\u1114"},
+	{0x3166, 0x3166, "\u1115"},
+	{0x3167, 0x3167, "\u11c7"},
+	{0x3168, 0x3168, "\u11c8"},
+	{0x3169, 0x3169, "\u11cc"},
+	{0x316a, 0x316a, "\u11ce"},
+	{0x316b, 0x316b, "\u11d3"},
+	{0x316c, 0x316c, "\u11d7"},
+	{0x316d, 0x316d, "\u11d9"},
+	{0x316e, 0x316e, "\u111c"},
+	{0x316f, 0x316f, "\u11dd"},
+	{0x3170, 0x3170, "\u11df"},
+	{0x3171, 0x3171, "\u111d"},
+	{0x3172, 0x3172, "\u111e"},
+	{0x3173, 0x3173, "\u1120"},
+	{0x3174, 0x3174, "\u1122"},
+	{0x3175, 0x3175, "\u1123"},
+	{0x3176, 0x3176, "\u1127"},
+	{0x3177, 0x3177, "\u1129"},
+	{0x3178, 0x3178, "\u112b"},
+	{0x3179, 0x3179, "\u112c"},
+	{0x317a, 0x317a, "\u112d"},
+	{0x317b, 0x317b, "\u112e"},
+	{0x317c, 0x317c, "\u112f"},
+	{0x317d, 0x317d, "\u1132"},
+	{0x317e, 0x317e, "\u1136"},
+	{0x317f, 0x317f, "\u1140"},
+	{0x3180, 0x3180, "\u1147"},
+	{0x3181, 0x3181, "\u114c"},
+	{0x3182, 0x3182, "\u11f1"},
+	{0x3183, 0x3183, "\u11f2"},
+	{0x3184, 0x3184, "\u1157"},
+	{0x3185, 0x3185, "\u1158"},
+	{0x3186, 0x3186, "\u1159"},
+	{0x3187, 0x3187, "\u1184"},
+	{0x3188, 0x3188, "\u1185"},
+	{0x3189, 0x3189, "\u1188"},
+	{0x318a, 0x318a, "\u1191"},
+	{0x318b, 0x318b, "\u1192"},
+	{0x318c, 0x318c, "\u1194"},
+	{0x318d, 0x318d, "\u119e"},
+	{0x318e, 0x318e, "\u11a1"},
+	{0x3192, 0x3192, "\u4e00"},
+	{0x3193, 0x3193, "\u4e8c"},
+	{0x3194, 0x3194, "\u4e09"},
+	{0x3195, 0x3195, "\u56db"},
+	{0x3196, 0x3196, "\u4e0a"},
+	{0x3197, 0x3197, "\u4e2d"},
+	{0x3198, 0x3198, "\u4e0b"},
+	{0x3199, 0x3199, "\u7532"},
+	{0x319a, 0x319a, "\u4e59"},
+	{0x319b, 0x319b, "\u4e19"},
+	{0x319c, 0x319c, "\u4e01"},
+	{0x319d, 0x319d, "\u5929"},
+	{0x319e, 0x319e, "\u5730"},
+	{0x319f, 0x319f, "\u4eba"},
+	{0x3244, 0x3244, "\u554f"},
+	{0x3245, 0x3245, "\u5e7c"},
+	{0x3246, 0x3246, "\u6587"},
+	{0x3247, 0x3247, "\u7b8f"},
+	{0x3250, 0x3250, "pte"},
+	{0x3251, 0x3251, "21"},
+	{0x3252, 0x3252, "22"},
+	{0x3253, 0x3253, "23"},
+	{0x3254, 0x3254, "24"},
+	{0x3255, 0x3255, "25"},
+	{0x3256, 0x3256, "26"},
+	{0x3257, 0x3257, "27"},
+	{0x3258, 0x3258, "28"},
+	{0x3259, 0x3259, "29"},
+	{0x325a, 0x325a, "30"},
+	{0x325b, 0x325b, "31"},
+	{0x325c, 0x325c, "32"},
+	{0x325d, 0x325d, "33"},
+	{0x325e, 0x325e, "34"},
+	{0x325f, 0x325f, "35"},
+	{0x3260, 0x3260, "\u1100"},
+	{0x3261, 0x3261, "\u1102"},
+	{0x3262, 0x3262, "\u1103"},
+	{0x3263, 0x3263, "\u1105"},
+	{0x3264, 0x3264, "\u1106"},
+	{0x3265, 0x3265, "\u1107"},
+	{0x3266, 0x3266, "\u1109"},
+	{0x3267, 0x3267, "\u110b"},
+	{0x3268, 0x3268, "\u110c"},
+	{0x3269, 0x3269, "\u110e"},
+	{0x326a, 0x326a, "\u110f"},
+	{0x326b, 0x326b, "\u1110"},
+	{0x326c, 0x326c, "\u1111"},
+	{0x326d, 0x326d, "\u1112"},
+	{0x326e, 0x326e, "\uac00"},
+	{0x326f, 0x326f, "\ub098"},
+	{0x3270, 0x3270, "\ub2e4"},
+	{0x3271, 0x3271, "\ub77c"},
+	{0x3272, 0x3272, "\ub9c8"},
+	{0x3273, 0x3273, "\ubc14"},
+	{0x3274, 0x3274, "\uc0ac"},
+	{0x3275, 0x3275, "\uc544"},
+	{0x3276, 0x3276, "\uc790"},
+	{0x3277, 0x3277, "\ucc28"},
+	{0x3278, 0x3278, "\uce74"},
+	{0x3279, 0x3279, "\ud0c0"},
+	{0x327a, 0x327a, "\ud30c"},
+	{0x327b, 0x327b, "\ud558"},
+	{0x327c, 0x327c, "\ucc38\uace0"},
+	{0x327d, 0x327d, "\uc8fc\uc758"},
+	{0x327e, 0x327e, "\uc6b0"},
+	{0x3280, 0x3280, "\u4e00"},
+	{0x3281, 0x3281, "\u4e8c"},
+	{0x3282, 0x3282, "\u4e09"},
+	{0x3283, 0x3283, "\u56db"},
+	{0x3284, 0x3284, "\u4e94"},
+	{0x3285, 0x3285, "\u516d"},
+	{0x3286, 0x3286, "\u4e03"},
+	{0x3287, 0x3287, "\u516b"},
+	{0x3288, 0x3288, "\u4e5d"},
+	{0x3289, 0x3289, "\u5341"},
+	{0x328a, 0x328a, "\u6708"},
+	{0x328b, 0x328b, "\u706b"},
+	{0x328c, 0x328c, "\u6c34"},
+	{0x328d, 0x328d, "\u6728"},
+	{0x328e, 0x328e, "\u91d1"},
+	{0x328f, 0x328f, "\u571f"},
+	{0x3290, 0x3290, "\u65e5"},
+	{0x3291, 0x3291, "\u682a"},
+	{0x3292, 0x3292, "\u6709"},
+	{0x3293, 0x3293, "\u793e"},
+	{0x3294, 0x3294, "\u540d"},
+	{0x3295, 0x3295, "\u7279"},
+	{0x3296, 0x3296, "\u8ca1"},
+	{0x3297, 0x3297, "\u795d"},
+	{0x3298, 0x3298, "\u52b4"},
+	{0x3299, 0x3299, "\u79d8"},
+	{0x329a, 0x329a, "\u7537"},
+	{0x329b, 0x329b, "\u5973"},
+	{0x329c, 0x329c, "\u9069"},
+	{0x329d, 0x329d, "\u512a"},
+	{0x329e, 0x329e, "\u5370"},
+	{0x329f, 0x329f, "\u6ce8"},
+	{0x32a0, 0x32a0, "\u9805"},
+	{0x32a1, 0x32a1, "\u4f11"},
+	{0x32a2, 0x32a2, "\u5199"},
+	{0x32a3, 0x32a3, "\u6b63"},
+	{0x32a4, 0x32a4, "\u4e0a"},
+	{0x32a5, 0x32a5, "\u4e2d"},
+	{0x32a6, 0x32a6, "\u4e0b"},
+	{0x32a7, 0x32a7, "\u5de6"},
+	{0x32a8, 0x32a8, "\u53f3"},
+	{0x32a9, 0x32a9, "\u533b"},
+	{0x32aa, 0x32aa, "\u5b97"},
+	{0x32ab, 0x32ab, "\u5b66"},
+	{0x32ac, 0x32ac, "\u76e3"},
+	{0x32ad, 0x32ad, "\u4f01"},
+	{0x32ae, 0x32ae, "\u8cc7"},
+	{0x32af, 0x32af, "\u5354"},
+	{0x32b0, 0x32b0, "\u591c"},
+	{0x32b1, 0x32b1, "36"},
+	{0x32b2, 0x32b2, "37"},
+	{0x32b3, 0x32b3, "38"},
+	{0x32b4, 0x32b4, "39"},
+	{0x32b5, 0x32b5, "40"},
+	{0x32b6, 0x32b6, "41"},
+	{0x32b7, 0x32b7, "42"},
+	{0x32b8, 0x32b8, "43"},
+	{0x32b9, 0x32b9, "44"},
+	{0x32ba, 0x32ba, "45"},
+	{0x32bb, 0x32bb, "46"},
+	{0x32bc, 0x32bc, "47"},
+	{0x32bd, 0x32bd, "48"},
+	{0x32be, 0x32be, "49"},
+	{0x32bf, 0x32bf, "50"},
+	{0x32c0, 0x32c0, "1\u6708"},
+	{0x32c1, 0x32c1, "2\u6708"},
+	{0x32c2, 0x32c2, "3\u6708"},
+	{0x32c3, 0x32c3, "4\u6708"},
+	{0x32c4, 0x32c4, "5\u6708"},
+	{0x32c5, 0x32c5, "6\u6708"},
+	{0x32c6, 0x32c6, "7\u6708"},
+	{0x32c7, 0x32c7, "8\u6708"},
+	{0x32c8, 0x32c8, "9\u6708"},
+	{0x32c9, 0x32c9, "10\u6708"},
+	{0x32ca, 0x32ca, "11\u6708"},
+	{0x32cb, 0x32cb, "12\u6708"},
+	{0x32cc, 0x32cc, "hg"},
+	{0x32cd, 0x32cd, "erg"},
+	{0x32ce, 0x32ce, "ev"},
+	{0x32cf, 0x32cf, "ltd"},
+	{0x32d0, 0x32d0, "\u30a2"},
+	{0x32d1, 0x32d1, "\u30a4"},
+	{0x32d2, 0x32d2, "\u30a6"},
+	{0x32d3, 0x32d3, "\u30a8"},
+	{0x32d4, 0x32d4, "\u30aa"},
+	{0x32d5, 0x32d5, "\u30ab"},
+	{0x32d6, 0x32d6, "\u30ad"},
+	{0x32d7, 0x32d7, "\u30af"},
+	{0x32d8, 0x32d8, "\u30b1"},
+	{0x32d9, 0x32d9, "\u30b3"},
+	{0x32da, 0x32da, "\u30b5"},
+	{0x32db, 0x32db, "\u30b7"},
+	{0x32dc, 0x32dc, "\u30b9"},
+	{0x32dd, 0x32dd, "\u30bb"},
+	{0x32de, 0x32de, "\u30bd"},
+	{0x32df, 0x32df, "\u30bf"},
+	{0x32e0, 0x32e0, "\u30c1"},
+	{0x32e1, 0x32e1, "\u30c4"},
+	{0x32e2, 0x32e2, "\u30c6"},
+	{0x32e3, 0x32e3, "\u30c8"},
+	{0x32e4, 0x32e4, "\u30ca"},
+	{0x32e5, 0x32e5, "\u30cb"},
+	{0x32e6, 0x32e6, "\u30cc"},
+	{0x32e7, 0x32e7, "\u30cd"},
+	{0x32e8, 0x32e8, "\u30ce"},
+	{0x32e9, 0x32e9, "\u30cf"},
+	{0x32ea, 0x32ea, "\u30d2"},
+	{0x32eb, 0x32eb, "\u30d5"},
+	{0x32ec, 0x32ec, "\u30d8"},
+	{0x32ed, 0x32ed, "\u30db"},
+	{0x32ee, 0x32ee, "\u30de"},
+	{0x32ef, 0x32ef, "\u30df"},
+	{0x32f0, 0x32f0, "\u30e0"},
+	{0x32f1, 0x32f1, "\u30e1"},
+	{0x32f2, 0x32f2, "\u30e2"},
+	{0x32f3, 0x32f3, "\u30e4"},
+	{0x32f4, 0x32f4, "\u30e6"},
+	{0x32f5, 0x32f5, "\u30e8"},
+	{0x32f6, 0x32f6, "\u30e9"},
+	{0x32f7, 0x32f7, "\u30ea"},
+	{0x32f8, 0x32f8, "\u30eb"},
+	{0x32f9, 0x32f9, "\u30ec"},
+	{0x32fa, 0x32fa, "\u30ed"},
+	{0x32fb, 0x32fb, "\u30ef"},
+	{0x32fc, 0x32fc, "\u30f0"},
+	{0x32fd, 0x32fd, "\u30f1"},
+	{0x32fe, 0x32fe, "\u30f2"},
+	{0x32ff, 0x32ff, "\u4ee4\u548c"},
+	{0x3300, 0x3300, "\u30a2\u30d1\u30fc\u30c8"},
+	{0x3301, 0x3301, "\u30a2\u30eb\u30d5\u30a1"},
+	{0x3302, 0x3302, "\u30a2\u30f3\u30da\u30a2"},
+	{0x3303, 0x3303, "\u30a2\u30fc\u30eb"},
+	{0x3304, 0x3304, "\u30a4\u30cb\u30f3\u30b0"},
+	{0x3305, 0x3305, "\u30a4\u30f3\u30c1"},
+	{0x3306, 0x3306, "\u30a6\u30a9\u30f3"},
+	{0x3307, 0x3307, "\u30a8\u30b9\u30af\u30fc\u30c9"},
+	{0x3308, 0x3308, "\u30a8\u30fc\u30ab\u30fc"},
+	{0x3309, 0x3309, "\u30aa\u30f3\u30b9"},
+	{0x330a, 0x330a, "\u30aa\u30fc\u30e0"},
+	{0x330b, 0x330b, "\u30ab\u30a4\u30ea"},
+	{0x330c, 0x330c, "\u30ab\u30e9\u30c3\u30c8"},
+	{0x330d, 0x330d, "\u30ab\u30ed\u30ea\u30fc"},
+	{0x330e, 0x330e, "\u30ac\u30ed\u30f3"},
+	{0x330f, 0x330f, "\u30ac\u30f3\u30de"},
+	{0x3310, 0x3310, "\u30ae\u30ac"},
+	{0x3311, 0x3311, "\u30ae\u30cb\u30fc"},
+	{0x3312, 0x3312, "\u30ad\u30e5\u30ea\u30fc"},
+	{0x3313, 0x3313, "\u30ae\u30eb\u30c0\u30fc"},
+	{0x3314, 0x3314, "\u30ad\u30ed"},
+	{0x3315, 0x3315, "\u30ad\u30ed\u30b0\u30e9\u30e0"},
+	{0x3316, 0x3316, "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb"},
+	{0x3317, 0x3317, "\u30ad\u30ed\u30ef\u30c3\u30c8"},
+	{0x3318, 0x3318, "\u30b0\u30e9\u30e0"},
+	{0x3319, 0x3319, "\u30b0\u30e9\u30e0\u30c8\u30f3"},
+	{0x331a, 0x331a, "\u30af\u30eb\u30bc\u30a4\u30ed"},
+	{0x331b, 0x331b, "\u30af\u30ed\u30fc\u30cd"},
+	{0x331c, 0x331c, "\u30b1\u30fc\u30b9"},
+	{0x331d, 0x331d, "\u30b3\u30eb\u30ca"},
+	{0x331e, 0x331e, "\u30b3\u30fc\u30dd"},
+	{0x331f, 0x331f, "\u30b5\u30a4\u30af\u30eb"},
+	{0x3320, 0x3320, "\u30b5\u30f3\u30c1\u30fc\u30e0"},
+	{0x3321, 0x3321, "\u30b7\u30ea\u30f3\u30b0"},
+	{0x3322, 0x3322, "\u30bb\u30f3\u30c1"},
+	{0x3323, 0x3323, "\u30bb\u30f3\u30c8"},
+	{0x3324, 0x3324, "\u30c0\u30fc\u30b9"},
+	{0x3325, 0x3325, "\u30c7\u30b7"},
+	{0x3326, 0x3326, "\u30c9\u30eb"},
+	{0x3327, 0x3327, "\u30c8\u30f3"},
+	{0x3328, 0x3328, "\u30ca\u30ce"},
+	{0x3329, 0x3329, "\u30ce\u30c3\u30c8"},
+	{0x332a, 0x332a, "\u30cf\u30a4\u30c4"},
+	{0x332b, 0x332b, "\u30d1\u30fc\u30bb\u30f3\u30c8"},
+	{0x332c, 0x332c, "\u30d1\u30fc\u30c4"},
+	{0x332d, 0x332d, "\u30d0\u30fc\u30ec\u30eb"},
+	{0x332e, 0x332e, "\u30d4\u30a2\u30b9\u30c8\u30eb"},
+	{0x332f, 0x332f, "\u30d4\u30af\u30eb"},
+	{0x3330, 0x3330, "\u30d4\u30b3"},
+	{0x3331, 0x3331, "\u30d3\u30eb"},
+	{0x3332, 0x3332, "\u30d5\u30a1\u30e9\u30c3\u30c9"},
+	{0x3333, 0x3333, "\u30d5\u30a3\u30fc\u30c8"},
+	{0x3334, 0x3334, "\u30d6\u30c3\u30b7\u30a7\u30eb"},
+	{0x3335, 0x3335, "\u30d5\u30e9\u30f3"},
+	{0x3336, 0x3336, "\u30d8\u30af\u30bf\u30fc\u30eb"},
+	{0x3337, 0x3337, "\u30da\u30bd"},
+	{0x3338, 0x3338, "\u30da\u30cb\u30d2"},
+	{0x3339, 0x3339, "\u30d8\u30eb\u30c4"},
+	{0x333a, 0x333a, "\u30da\u30f3\u30b9"},
+	{0x333b, 0x333b, "\u30da\u30fc\u30b8"},
+	{0x333c, 0x333c, "\u30d9\u30fc\u30bf"},
+	{0x333d, 0x333d, "\u30dd\u30a4\u30f3\u30c8"},
+	{0x333e, 0x333e, "\u30dc\u30eb\u30c8"},
+	{0x333f, 0x333f, "\u30db\u30f3"},
+	{0x3340, 0x3340, "\u30dd\u30f3\u30c9"},
+	{0x3341, 0x3341, "\u30db\u30fc\u30eb"},
+	{0x3342, 0x3342, "\u30db\u30fc\u30f3"},
+	{0x3343, 0x3343, "\u30de\u30a4\u30af\u30ed"},
+	{0x3344, 0x3344, "\u30de\u30a4\u30eb"},
+	{0x3345, 0x3345, "\u30de\u30c3\u30cf"},
+	{0x3346, 0x3346, "\u30de\u30eb\u30af"},
+	{0x3347, 0x3347, "\u30de\u30f3\u30b7\u30e7\u30f3"},
+	{0x3348, 0x3348, "\u30df\u30af\u30ed\u30f3"},
+	{0x3349, 0x3349, "\u30df\u30ea"},
+	{0x334a, 0x334a, "\u30df\u30ea\u30d0\u30fc\u30eb"},
+	{0x334b, 0x334b, "\u30e1\u30ac"},
+	{0x334c, 0x334c, "\u30e1\u30ac\u30c8\u30f3"},
+	{0x334d, 0x334d, "\u30e1\u30fc\u30c8\u30eb"},
+	{0x334e, 0x334e, "\u30e4\u30fc\u30c9"},
+	{0x334f, 0x334f, "\u30e4\u30fc\u30eb"},
+	{0x3350, 0x3350, "\u30e6\u30a2\u30f3"},
+	{0x3351, 0x3351, "\u30ea\u30c3\u30c8\u30eb"},
+	{0x3352, 0x3352, "\u30ea\u30e9"},
+	{0x3353, 0x3353, "\u30eb\u30d4\u30fc"},
+	{0x3354, 0x3354, "\u30eb\u30fc\u30d6\u30eb"},
+	{0x3355, 0x3355, "\u30ec\u30e0"},
+	{0x3356, 0x3356, "\u30ec\u30f3\u30c8\u30b2\u30f3"},
+	{0x3357, 0x3357, "\u30ef\u30c3\u30c8"},
+	{0x3358, 0x3358, "0\u70b9"},
+	{0x3359, 0x3359, "1\u70b9"},
+	{0x335a, 0x335a, "2\u70b9"},
+	{0x335b, 0x335b, "3\u70b9"},
+	{0x335c, 0x335c, "4\u70b9"},
+	{0x335d, 0x335d, "5\u70b9"},
+	{0x335e, 0x335e, "6\u70b9"},
+	{0x335f, 0x335f, "7\u70b9"},
+	{0x3360, 0x3360, "8\u70b9"},
+	{0x3361, 0x3361, "9\u70b9"},
+	{0x3362, 0x3362, "10\u70b9"},
+	{0x3363, 0x3363, "11\u70b9"},
+	{0x3364, 0x3364, "12\u70b9"},
+	{0x3365, 0x3365, "13\u70b9"},
+	{0x3366, 0x3366, "14\u70b9"},
+	{0x3367, 0x3367, "15\u70b9"},
+	{0x3368, 0x3368, "16\u70b9"},
+	{0x3369, 0x3369, "17\u70b9"},
+	{0x336a, 0x336a, "18\u70b9"},
+	{0x336b, 0x336b, "19\u70b9"},
+	{0x336c, 0x336c, "20\u70b9"},
+	{0x336d, 0x336d, "21\u70b9"},
+	{0x336e, 0x336e, "22\u70b9"},
+	{0x336f, 0x336f, "23\u70b9"},
+	{0x3370, 0x3370, "24\u70b9"},
+	{0x3371, 0x3371, "hpa"},
+	{0x3372, 0x3372, "da"},
+	{0x3373, 0x3373, "au"},
+	{0x3374, 0x3374, "bar"},
+	{0x3375, 0x3375, "ov"},
+	{0x3376, 0x3376, "pc"},
+	{0x3377, 0x3377, "dm"},
+	{0x3378, 0x3378, "dm2"},
+	{0x3379, 0x3379, "dm3"},
+	{0x337a, 0x337a, "iu"},
+	{0x337b, 0x337b, "\u5e73\u6210"},
+	{0x337c, 0x337c, "\u662d\u548c"},
+	{0x337d, 0x337d, "\u5927\u6b63"},
+	{0x337e, 0x337e, "\u660e\u6cbb"},
+	{0x337f, 0x337f, "\u682a\u5f0f\u4f1a\u793e"},
+	{0x3380, 0x3380, "pa"},
+	{0x3381, 0x3381, "na"},
+	{0x3382, 0x3382, "\u03bca"},
+	{0x3383, 0x3383, "ma"},
+	{0x3384, 0x3384, "ka"},
+	{0x3385, 0x3385, "kb"},
+	{0x3386, 0x3386, "mb"},
+	{0x3387, 0x3387, "gb"},
+	{0x3388, 0x3388, "cal"},
+	{0x3389, 0x3389, "kcal"},
+	{0x338a, 0x338a, "pf"},
+	{0x338b, 0x338b, "nf"},
+	{0x338c, 0x338c, "\u03bcf"},
+	{0x338d, 0x338d, "\u03bcg"},
+	{0x338e, 0x338e, "mg"},
+	{0x338f, 0x338f, "kg"},
+	{0x3390, 0x3390, "hz"},
+	{0x3391, 0x3391, "khz"},
+	{0x3392, 0x3392, "mhz"},
+	{0x3393, 0x3393, "ghz"},
+	{0x3394, 0x3394, "thz"},
+	{0x3395, 0x3395, "\u03bcl"},
+	{0x3396, 0x3396, "ml"},
+	{0x3397, 0x3397, "dl"},
+	{0x3398, 0x3398, "kl"},
+	{0x3399, 0x3399, "fm"},
+	{0x339a, 0x339a, "nm"},
+	{0x339b, 0x339b, "\u03bcm"},
+	{0x339c, 0x339c, "mm"},
+	{0x339d, 0x339d, "cm"},
+	{0x339e, 0x339e, "km"},
+	{0x339f, 0x339f, "mm2"},
+	{0x33a0, 0x33a0, "cm2"},
+	{0x33a1, 0x33a1, "m2"},
+	{0x33a2, 0x33a2, "km2"},
+	{0x33a3, 0x33a3, "mm3"},
+	{0x33a4, 0x33a4, "cm3"},
+	{0x33a5, 0x33a5, "m3"},
+	{0x33a6, 0x33a6, "km3"},
+	{0x33a7, 0x33a7, "m\u2215s"},
+	{0x33a8, 0x33a8, "m\u2215s2"},
+	{0x33a9, 0x33a9, "pa"},
+	{0x33aa, 0x33aa, "kpa"},
+	{0x33ab, 0x33ab, "mpa"},
+	{0x33ac, 0x33ac, "gpa"},
+	{0x33ad, 0x33ad, "rad"},
+	{0x33ae, 0x33ae, "rad\u2215s"},
+	{0x33af, 0x33af, "rad\u2215s2"},
+	{0x33b0, 0x33b0, "ps"},
+	{0x33b1, 0x33b1, "ns"},
+	{0x33b2, 0x33b2, "\u03bcs"},
+	{0x33b3, 0x33b3, "ms"},
+	{0x33b4, 0x33b4, "pv"},
+	{0x33b5, 0x33b5, "nv"},
+	{0x33b6, 0x33b6, "\u03bcv"},
+	{0x33b7, 0x33b7, "mv"},
+	{0x33b8, 0x33b8, "kv"},
+	{0x33b9, 0x33b9, "mv"},
+	{0x33ba, 0x33ba, "pw"},
+	{0x33bb, 0x33bb, "nw"},
+	{0x33bc, 0x33bc, "\u03bcw"},
+	{0x33bd, 0x33bd, "mw"},
+	{0x33be, 0x33be, "kw"},
+	{0x33bf, 0x33bf, "mw"},
+	{0x33c0, 0x33c0, "k\u03c9"},
+	{0x33c1, 0x33c1, "m\u03c9"},
+	{0x33c3, 0x33c3, "bq"},
+	{0x33c4, 0x33c4, "cc"},
+	{0x33c5, 0x33c5, "cd"},
+	{0x33c6, 0x33c6, "c\u2215kg"},
+	{0x33c8, 0x33c8, "db"},
+	{0x33c9, 0x33c9, "gy"},
+	{0x33ca, 0x33ca, "ha"},
+	{0x33cb, 0x33cb, "hp"},
+	{0x33cc, 0x33cc, "in"},
+	{0x33cd, 0x33cd, "kk"},
+	{0x33ce, 0x33ce, "km"},
+	{0x33cf, 0x33cf, "kt"},
+	{0x33d0, 0x33d0, "lm"},
+	{0x33d1, 0x33d1, "ln"},
+	{0x33d2, 0x33d2, "log"},
+	{0x33d3, 0x33d3, "lx"},
+	{0x33d4, 0x33d4, "mb"},
+	{0x33d5, 0x33d5, "mil"},
+	{0x33d6, 0x33d6, "mol"},
+	{0x33d7, 0x33d7, "ph"},
+	{0x33d9, 0x33d9, "ppm"},
+	{0x33da, 0x33da, "pr"},
+	{0x33db, 0x33db, "sr"},
+	{0x33dc, 0x33dc, "sv"},
+	{0x33dd, 0x33dd, "wb"},
+	{0x33de, 0x33de, "v\u2215m"},
+	{0x33df, 0x33df, "a\u2215m"},
+	{0x33e0, 0x33e0, "1\u65e5"},
+	{0x33e1, 0x33e1, "2\u65e5"},
+	{0x33e2, 0x33e2, "3\u65e5"},
+	{0x33e3, 0x33e3, "4\u65e5"},
+	{0x33e4, 0x33e4, "5\u65e5"},
+	{0x33e5, 0x33e5, "6\u65e5"},
+	{0x33e6, 0x33e6, "7\u65e5"},
+	{0x33e7, 0x33e7, "8\u65e5"},
+	{0x33e8, 0x33e8, "9\u65e5"},
+	{0x33e9, 0x33e9, "10\u65e5"},
+	{0x33ea, 0x33ea, "11\u65e5"},
+	{0x33eb, 0x33eb, "12\u65e5"},
+	{0x33ec, 0x33ec, "13\u65e5"},
+	{0x33ed, 0x33ed, "14\u65e5"},
+	{0x33ee, 0x33ee, "15\u65e5"},
+	{0x33ef, 0x33ef, "16\u65e5"},
+	{0x33f0, 0x33f0, "17\u65e5"},
+	{0x33f1, 0x33f1, "18\u65e5"},
+	{0x33f2, 0x33f2, "19\u65e5"},
+	{0x33f3, 0x33f3, "20\u65e5"},
+	{0x33f4, 0x33f4, "21\u65e5"},
+	{0x33f5, 0x33f5, "22\u65e5"},
+	{0x33f6, 0x33f6, "23\u65e5"},
+	{0x33f7, 0x33f7, "24\u65e5"},
+	{0x33f8, 0x33f8, "25\u65e5"},
+	{0x33f9, 0x33f9, "26\u65e5"},
+	{0x33fa, 0x33fa, "27\u65e5"},
+	{0x33fb, 0x33fb, "28\u65e5"},
+	{0x33fc, 0x33fc, "29\u65e5"},
+	{0x33fd, 0x33fd, "30\u65e5"},
+	{0x33fe, 0x33fe, "31\u65e5"},
+	{0x33ff, 0x33ff, "gal"},
+	{0xa640, 0xa640, "\ua641"},
+	{0xa642, 0xa642, "\ua643"},
+	{0xa644, 0xa644, "\ua645"},
+	{0xa646, 0xa646, "\ua647"},
+	{0xa648, 0xa648, "\ua649"},
+	{0xa64a, 0xa64a, "\ua64b"},
+	{0xa64c, 0xa64c, "\ua64d"},
+	{0xa64e, 0xa64e, "\ua64f"},
+	{0xa650, 0xa650, "\ua651"},
+	{0xa652, 0xa652, "\ua653"},
+	{0xa654, 0xa654, "\ua655"},
+	{0xa656, 0xa656, "\ua657"},
+	{0xa658, 0xa658, "\ua659"},
+	{0xa65a, 0xa65a, "\ua65b"},
+	{0xa65c, 0xa65c, "\ua65d"},
+	{0xa65e, 0xa65e, "\ua65f"},
+	{0xa660, 0xa660, "\ua661"},
+	{0xa662, 0xa662, "\ua663"},
+	{0xa664, 0xa664, "\ua665"},
+	{0xa666, 0xa666, "\ua667"},
+	{0xa668, 0xa668, "\ua669"},
+	{0xa66a, 0xa66a, "\ua66b"},
+	{0xa66c, 0xa66c, "\ua66d"},
+	{0xa680, 0xa680, "\ua681"},
+	{0xa682, 0xa682, "\ua683"},
+	{0xa684, 0xa684, "\ua685"},
+	{0xa686, 0xa686, "\ua687"},
+	{0xa688, 0xa688, "\ua689"},
+	{0xa68a, 0xa68a, "\ua68b"},
+	{0xa68c, 0xa68c, "\ua68d"},
+	{0xa68e, 0xa68e, "\ua68f"},
+	{0xa690, 0xa690, "\ua691"},
+	{0xa692, 0xa692, "\ua693"},
+	{0xa694, 0xa694, "\ua695"},
+	{0xa696, 0xa696, "\ua697"},
+	{0xa698, 0xa698, "\ua699"},
+	{0xa69a, 0xa69a, "\ua69b"},
+	{0xa69c, 0xa69c, "\u044a"},
+	{0xa69d, 0xa69d, "\u044c"},
+	{0xa722, 0xa722, "\ua723"},
+	{0xa724, 0xa724, "\ua725"},
+	{0xa726, 0xa726, "\ua727"},
+	{0xa728, 0xa728, "\ua729"},
+	{0xa72a, 0xa72a, "\ua72b"},
+	{0xa72c, 0xa72c, "\ua72d"},
+	{0xa72e, 0xa72e, "\ua72f"},
+	{0xa732, 0xa732, "\ua733"},
+	{0xa734, 0xa734, "\ua735"},
+	{0xa736, 0xa736, "\ua737"},
+	{0xa738, 0xa738, "\ua739"},
+	{0xa73a, 0xa73a, "\ua73b"},
+	{0xa73c, 0xa73c, "\ua73d"},
+	{0xa73e, 0xa73e, "\ua73f"},
+	{0xa740, 0xa740, "\ua741"},
+	{0xa742, 0xa742, "\ua743"},
+	{0xa744, 0xa744, "\ua745"},
+	{0xa746, 0xa746, "\ua747"},
+	{0xa748, 0xa748, "\ua749"},
+	{0xa74a, 0xa74a, "\ua74b"},
+	{0xa74c, 0xa74c, "\ua74d"},
+	{0xa74e, 0xa74e, "\ua74f"},
+	{0xa750, 0xa750, "\ua751"},
+	{0xa752, 0xa752, "\ua753"},
+	{0xa754, 0xa754, "\ua755"},
+	{0xa756, 0xa756, "\ua757"},
+	{0xa758, 0xa758, "\ua759"},
+	{0xa75a, 0xa75a, "\ua75b"},
+	{0xa75c, 0xa75c, "\ua75d"},
+	{0xa75e, 0xa75e, "\ua75f"},
+	{0xa760, 0xa760, "\ua761"},
+	{0xa762, 0xa762, "\ua763"},
+	{0xa764, 0xa764, "\ua765"},
+	{0xa766, 0xa766, "\ua767"},
+	{0xa768, 0xa768, "\ua769"},
+	{0xa76a, 0xa76a, "\ua76b"},
+	{0xa76c, 0xa76c, "\ua76d"},
+	{0xa76e, 0xa76e, "\ua76f"},
+	{0xa770, 0xa770, "\ua76f"},
+	{0xa779, 0xa779, "\ua77a"},
+	{0xa77b, 0xa77b, "\ua77c"},
+	{0xa77d, 0xa77d, "\u1d79"},
+	{0xa77e, 0xa77e, "\ua77f"},
+	{0xa780, 0xa780, "\ua781"},
+	{0xa782, 0xa782, "\ua783"},
+	{0xa784, 0xa784, "\ua785"},
+	{0xa786, 0xa786, "\ua787"},
+	{0xa78b, 0xa78b, "\ua78c"},
+	{0xa78d, 0xa78d, "\u0265"},
+	{0xa790, 0xa790, "\ua791"},
+	{0xa792, 0xa792, "\ua793"},
+	{0xa796, 0xa796, "\ua797"},
+	{0xa798, 0xa798, "\ua799"},
+	{0xa79a, 0xa79a, "\ua79b"},
+	{0xa79c, 0xa79c, "\ua79d"},
+	{0xa79e, 0xa79e, "\ua79f"},
+	{0xa7a0, 0xa7a0, "\ua7a1"},
+	{0xa7a2, 0xa7a2, "\ua7a3"},
+	{0xa7a4, 0xa7a4, "\ua7a5"},
+	{0xa7a6, 0xa7a6, "\ua7a7"},
+	{0xa7a8, 0xa7a8, "\ua7a9"},
+	{0xa7aa, 0xa7aa, "\u0266"},
+	{0xa7ab, 0xa7ab, "\u025c"},
+	{0xa7ac, 0xa7ac, "\u0261"},
+	{0xa7ad, 0xa7ad, "\u026c"},
+	{0xa7ae, 0xa7ae, "\u026a"},
+	{0xa7b0, 0xa7b0, "\u029e"},
+	{0xa7b1, 0xa7b1, "\u0287"},
+	{0xa7b2, 0xa7b2, "\u029d"},
+	{0xa7b3, 0xa7b3, "\uab53"},
+	{0xa7b4, 0xa7b4, "\ua7b5"},
+	{0xa7b6, 0xa7b6, "\ua7b7"},
+	{0xa7b8, 0xa7b8, "\ua7b9"},
+	{0xa7ba, 0xa7ba, "\ua7bb"},
+	{0xa7bc, 0xa7bc, "\ua7bd"},
+	{0xa7be, 0xa7be, "\ua7bf"},
+	{0xa7c0, 0xa7c0, "\ua7c1"},
+	{0xa7c2, 0xa7c2, "\ua7c3"},
+	{0xa7c4, 0xa7c4, "\ua794"},
+	{0xa7c5, 0xa7c5, "\u0282"},
+	{0xa7c6, 0xa7c6, "\u1d8e"},
+	{0xa7c7, 0xa7c7, "\ua7c8"},
+	{0xa7c9, 0xa7c9, "\ua7ca"},
+	{0xa7d0, 0xa7d0, "\ua7d1"},
+	{0xa7d6, 0xa7d6, "\ua7d7"},
+	{0xa7d8, 0xa7d8, "\ua7d9"},
+	{0xa7f2, 0xa7f2, "c"},
+	{0xa7f3, 0xa7f3, "f"},
+	{0xa7f4, 0xa7f4, "q"},
+	{0xa7f5, 0xa7f5, "\ua7f6"},
+	{0xa7f8, 0xa7f8, "\u0127"},
+	{0xa7f9, 0xa7f9, "\u0153"},
+	{0xab5c, 0xab5c, "\ua727"},
+	{0xab5d, 0xab5d, "\uab37"},
+	{0xab5e, 0xab5e, "\u026b"},
+	{0xab5f, 0xab5f, "\uab52"},
+	{0xab69, 0xab69, "\u028d"},
+	{0xab70, 0xab70, "\u13a0"},
+	{0xab71, 0xab71, "\u13a1"},
+	{0xab72, 0xab72, "\u13a2"},
+	{0xab73, 0xab73, "\u13a3"},
+	{0xab74, 0xab74, "\u13a4"},
+	{0xab75, 0xab75, "\u13a5"},
+	{0xab76, 0xab76, "\u13a6"},
+	{0xab77, 0xab77, "\u13a7"},
+	{0xab78, 0xab78, "\u13a8"},
+	{0xab79, 0xab79, "\u13a9"},
+	{0xab7a, 0xab7a, "\u13aa"},
+	{0xab7b, 0xab7b, "\u13ab"},
+	{0xab7c, 0xab7c, "\u13ac"},
+	{0xab7d, 0xab7d, "\u13ad"},
+	{0xab7e, 0xab7e, "\u13ae"},
+	{0xab7f, 0xab7f, "\u13af"},
+	{0xab80, 0xab80, "\u13b0"},
+	{0xab81, 0xab81, "\u13b1"},
+	{0xab82, 0xab82, "\u13b2"},
+	{0xab83, 0xab83, "\u13b3"},
+	{0xab84, 0xab84, "\u13b4"},
+	{0xab85, 0xab85, "\u13b5"},
+	{0xab86, 0xab86, "\u13b6"},
+	{0xab87, 0xab87, "\u13b7"},
+	{0xab88, 0xab88, "\u13b8"},
+	{0xab89, 0xab89, "\u13b9"},
+	{0xab8a, 0xab8a, "\u13ba"},
+	{0xab8b, 0xab8b, "\u13bb"},
+	{0xab8c, 0xab8c, "\u13bc"},
+	{0xab8d, 0xab8d, "\u13bd"},
+	{0xab8e, 0xab8e, "\u13be"},
+	{0xab8f, 0xab8f, "\u13bf"},
+	{0xab90, 0xab90, "\u13c0"},
+	{0xab91, 0xab91, "\u13c1"},
+	{0xab92, 0xab92, "\u13c2"},
+	{0xab93, 0xab93, "\u13c3"},
+	{0xab94, 0xab94, "\u13c4"},
+	{0xab95, 0xab95, "\u13c5"},
+	{0xab96, 0xab96, "\u13c6"},
+	{0xab97, 0xab97, "\u13c7"},
+	{0xab98, 0xab98, "\u13c8"},
+	{0xab99, 0xab99, "\u13c9"},
+	{0xab9a, 0xab9a, "\u13ca"},
+	{0xab9b, 0xab9b, "\u13cb"},
+	{0xab9c, 0xab9c, "\u13cc"},
+	{0xab9d, 0xab9d, "\u13cd"},
+	{0xab9e, 0xab9e, "\u13ce"},
+	{0xab9f, 0xab9f, "\u13cf"},
+	{0xaba0, 0xaba0, "\u13d0"},
+	{0xaba1, 0xaba1, "\u13d1"},
+	{0xaba2, 0xaba2, "\u13d2"},
+	{0xaba3, 0xaba3, "\u13d3"},
+	{0xaba4, 0xaba4, "\u13d4"},
+	{0xaba5, 0xaba5, "\u13d5"},
+	{0xaba6, 0xaba6, "\u13d6"},
+	{0xaba7, 0xaba7, "\u13d7"},
+	{0xaba8, 0xaba8, "\u13d8"},
+	{0xaba9, 0xaba9, "\u13d9"},
+	{0xabaa, 0xabaa, "\u13da"},
+	{0xabab, 0xabab, "\u13db"},
+	{0xabac, 0xabac, "\u13dc"},
+	{0xabad, 0xabad, "\u13dd"},
+	{0xabae, 0xabae, "\u13de"},
+	{0xabaf, 0xabaf, "\u13df"},
+	{0xabb0, 0xabb0, "\u13e0"},
+	{0xabb1, 0xabb1, "\u13e1"},
+	{0xabb2, 0xabb2, "\u13e2"},
+	{0xabb3, 0xabb3, "\u13e3"},
+	{0xabb4, 0xabb4, "\u13e4"},
+	{0xabb5, 0xabb5, "\u13e5"},
+	{0xabb6, 0xabb6, "\u13e6"},
+	{0xabb7, 0xabb7, "\u13e7"},
+	{0xabb8, 0xabb8, "\u13e8"},
+	{0xabb9, 0xabb9, "\u13e9"},
+	{0xabba, 0xabba, "\u13ea"},
+	{0xabbb, 0xabbb, "\u13eb"},
+	{0xabbc, 0xabbc, "\u13ec"},
+	{0xabbd, 0xabbd, "\u13ed"},
+	{0xabbe, 0xabbe, "\u13ee"},
+	{0xabbf, 0xabbf, "\u13ef"},
+	{0xf900, 0xf900, "\u8c48"},
+	{0xf901, 0xf901, "\u66f4"},
+	{0xf902, 0xf902, "\u8eca"},
+	{0xf903, 0xf903, "\u8cc8"},
+	{0xf904, 0xf904, "\u6ed1"},
+	{0xf905, 0xf905, "\u4e32"},
+	{0xf906, 0xf906, "\u53e5"},
+	{0xf907, 0xf908, "\u9f9c"},
+	{0xf909, 0xf909, "\u5951"},
+	{0xf90a, 0xf90a, "\u91d1"},
+	{0xf90b, 0xf90b, "\u5587"},
+	{0xf90c, 0xf90c, "\u5948"},
+	{0xf90d, 0xf90d, "\u61f6"},
+	{0xf90e, 0xf90e, "\u7669"},
+	{0xf90f, 0xf90f, "\u7f85"},
+	{0xf910, 0xf910, "\u863f"},
+	{0xf911, 0xf911, "\u87ba"},
+	{0xf912, 0xf912, "\u88f8"},
+	{0xf913, 0xf913, "\u908f"},
+	{0xf914, 0xf914, "\u6a02"},
+	{0xf915, 0xf915, "\u6d1b"},
+	{0xf916, 0xf916, "\u70d9"},
+	{0xf917, 0xf917, "\u73de"},
+	{0xf918, 0xf918, "\u843d"},
+	{0xf919, 0xf919, "\u916a"},
+	{0xf91a, 0xf91a, "\u99f1"},
+	{0xf91b, 0xf91b, "\u4e82"},
+	{0xf91c, 0xf91c, "\u5375"},
+	{0xf91d, 0xf91d, "\u6b04"},
+	{0xf91e, 0xf91e, "\u721b"},
+	{0xf91f, 0xf91f, "\u862d"},
+	{0xf920, 0xf920, "\u9e1e"},
+	{0xf921, 0xf921, "\u5d50"},
+	{0xf922, 0xf922, "\u6feb"},
+	{0xf923, 0xf923, "\u85cd"},
+	{0xf924, 0xf924, "\u8964"},
+	{0xf925, 0xf925, "\u62c9"},
+	{0xf926, 0xf926, "\u81d8"},
+	{0xf927, 0xf927, "\u881f"},
+	{0xf928, 0xf928, "\u5eca"},
+	{0xf929, 0xf929, "\u6717"},
+	{0xf92a, 0xf92a, "\u6d6a"},
+	{0xf92b, 0xf92b, "\u72fc"},
+	{0xf92c, 0xf92c, "\u90ce"},
+	{0xf92d, 0xf92d, "\u4f86"},
+	{0xf92e, 0xf92e, "\u51b7"},
+	{0xf92f, 0xf92f, "\u52de"},
+	{0xf930, 0xf930, "\u64c4"},
+	{0xf931, 0xf931, "\u6ad3"},
+	{0xf932, 0xf932, "\u7210"},
+	{0xf933, 0xf933, "\u76e7"},
+	{0xf934, 0xf934, "\u8001"},
+	{0xf935, 0xf935, "\u8606"},
+	{0xf936, 0xf936, "\u865c"},
+	{0xf937, 0xf937, "\u8def"},
+	{0xf938, 0xf938, "\u9732"},
+	{0xf939, 0xf939, "\u9b6f"},
+	{0xf93a, 0xf93a, "\u9dfa"},
+	{0xf93b, 0xf93b, "\u788c"},
+	{0xf93c, 0xf93c, "\u797f"},
+	{0xf93d, 0xf93d, "\u7da0"},
+	{0xf93e, 0xf93e, "\u83c9"},
+	{0xf93f, 0xf93f, "\u9304"},
+	{0xf940, 0xf940, "\u9e7f"},
+	{0xf941, 0xf941, "\u8ad6"},
+	{0xf942, 0xf942, "\u58df"},
+	{0xf943, 0xf943, "\u5f04"},
+	{0xf944, 0xf944, "\u7c60"},
+	{0xf945, 0xf945, "\u807e"},
+	{0xf946, 0xf946, "\u7262"},
+	{0xf947, 0xf947, "\u78ca"},
+	{0xf948, 0xf948, "\u8cc2"},
+	{0xf949, 0xf949, "\u96f7"},
+	{0xf94a, 0xf94a, "\u58d8"},
+	{0xf94b, 0xf94b, "\u5c62"},
+	{0xf94c, 0xf94c, "\u6a13"},
+	{0xf94d, 0xf94d, "\u6dda"},
+	{0xf94e, 0xf94e, "\u6f0f"},
+	{0xf94f, 0xf94f, "\u7d2f"},
+	{0xf950, 0xf950, "\u7e37"},
+	{0xf951, 0xf951, "\u964b"},
+	{0xf952, 0xf952, "\u52d2"},
+	{0xf953, 0xf953, "\u808b"},
+	{0xf954, 0xf954, "\u51dc"},
+	{0xf955, 0xf955, "\u51cc"},
+	{0xf956, 0xf956, "\u7a1c"},
+	{0xf957, 0xf957, "\u7dbe"},
+	{0xf958, 0xf958, "\u83f1"},
+	{0xf959, 0xf959, "\u9675"},
+	{0xf95a, 0xf95a, "\u8b80"},
+	{0xf95b, 0xf95b, "\u62cf"},
+	{0xf95c, 0xf95c, "\u6a02"},
+	{0xf95d, 0xf95d, "\u8afe"},
+	{0xf95e, 0xf95e, "\u4e39"},
+	{0xf95f, 0xf95f, "\u5be7"},
+	{0xf960, 0xf960, "\u6012"},
+	{0xf961, 0xf961, "\u7387"},
+	{0xf962, 0xf962, "\u7570"},
+	{0xf963, 0xf963, "\u5317"},
+	{0xf964, 0xf964, "\u78fb"},
+	{0xf965, 0xf965, "\u4fbf"},
+	{0xf966, 0xf966, "\u5fa9"},
+	{0xf967, 0xf967, "\u4e0d"},
+	{0xf968, 0xf968, "\u6ccc"},
+	{0xf969, 0xf969, "\u6578"},
+	{0xf96a, 0xf96a, "\u7d22"},
+	{0xf96b, 0xf96b, "\u53c3"},
+	{0xf96c, 0xf96c, "\u585e"},
+	{0xf96d, 0xf96d, "\u7701"},
+	{0xf96e, 0xf96e, "\u8449"},
+	{0xf96f, 0xf96f, "\u8aaa"},
+	{0xf970, 0xf970, "\u6bba"},
+	{0xf971, 0xf971, "\u8fb0"},
+	{0xf972, 0xf972, "\u6c88"},
+	{0xf973, 0xf973, "\u62fe"},
+	{0xf974, 0xf974, "\u82e5"},
+	{0xf975, 0xf975, "\u63a0"},
+	{0xf976, 0xf976, "\u7565"},
+	{0xf977, 0xf977, "\u4eae"},
+	{0xf978, 0xf978, "\u5169"},
+	{0xf979, 0xf979, "\u51c9"},
+	{0xf97a, 0xf97a, "\u6881"},
+	{0xf97b, 0xf97b, "\u7ce7"},
+	{0xf97c, 0xf97c, "\u826f"},
+	{0xf97d, 0xf97d, "\u8ad2"},
+	{0xf97e, 0xf97e, "\u91cf"},
+	{0xf97f, 0xf97f, "\u52f5"},
+	{0xf980, 0xf980, "\u5442"},
+	{0xf981, 0xf981, "\u5973"},
+	{0xf982, 0xf982, "\u5eec"},
+	{0xf983, 0xf983, "\u65c5"},
+	{0xf984, 0xf984, "\u6ffe"},
+	{0xf985, 0xf985, "\u792a"},
+	{0xf986, 0xf986, "\u95ad"},
+	{0xf987, 0xf987, "\u9a6a"},
+	{0xf988, 0xf988, "\u9e97"},
+	{0xf989, 0xf989, "\u9ece"},
+	{0xf98a, 0xf98a, "\u529b"},
+	{0xf98b, 0xf98b, "\u66c6"},
+	{0xf98c, 0xf98c, "\u6b77"},
+	{0xf98d, 0xf98d, "\u8f62"},
+	{0xf98e, 0xf98e, "\u5e74"},
+	{0xf98f, 0xf98f, "\u6190"},
+	{0xf990, 0xf990, "\u6200"},
+	{0xf991, 0xf991, "\u649a"},
+	{0xf992, 0xf992, "\u6f23"},
+	{0xf993, 0xf993, "\u7149"},
+	{0xf994, 0xf994, "\u7489"},
+	{0xf995, 0xf995, "\u79ca"},
+	{0xf996, 0xf996, "\u7df4"},
+	{0xf997, 0xf997, "\u806f"},
+	{0xf998, 0xf998, "\u8f26"},
+	{0xf999, 0xf999, "\u84ee"},
+	{0xf99a, 0xf99a, "\u9023"},
+	{0xf99b, 0xf99b, "\u934a"},
+	{0xf99c, 0xf99c, "\u5217"},
+	{0xf99d, 0xf99d, "\u52a3"},
+	{0xf99e, 0xf99e, "\u54bd"},
+	{0xf99f, 0xf99f, "\u70c8"},
+	{0xf9a0, 0xf9a0, "\u88c2"},
+	{0xf9a1, 0xf9a1, "\u8aaa"},
+	{0xf9a2, 0xf9a2, "\u5ec9"},
+	{0xf9a3, 0xf9a3, "\u5ff5"},
+	{0xf9a4, 0xf9a4, "\u637b"},
+	{0xf9a5, 0xf9a5, "\u6bae"},
+	{0xf9a6, 0xf9a6, "\u7c3e"},
+	{0xf9a7, 0xf9a7, "\u7375"},
+	{0xf9a8, 0xf9a8, "\u4ee4"},
+	{0xf9a9, 0xf9a9, "\u56f9"},
+	{0xf9aa, 0xf9aa, "\u5be7"},
+	{0xf9ab, 0xf9ab, "\u5dba"},
+	{0xf9ac, 0xf9ac, "\u601c"},
+	{0xf9ad, 0xf9ad, "\u73b2"},
+	{0xf9ae, 0xf9ae, "\u7469"},
+	{0xf9af, 0xf9af, "\u7f9a"},
+	{0xf9b0, 0xf9b0, "\u8046"},
+	{0xf9b1, 0xf9b1, "\u9234"},
+	{0xf9b2, 0xf9b2, "\u96f6"},
+	{0xf9b3, 0xf9b3, "\u9748"},
+	{0xf9b4, 0xf9b4, "\u9818"},
+	{0xf9b5, 0xf9b5, "\u4f8b"},
+	{0xf9b6, 0xf9b6, "\u79ae"},
+	{0xf9b7, 0xf9b7, "\u91b4"},
+	{0xf9b8, 0xf9b8, "\u96b8"},
+	{0xf9b9, 0xf9b9, "\u60e1"},
+	{0xf9ba, 0xf9ba, "\u4e86"},
+	{0xf9bb, 0xf9bb, "\u50da"},
+	{0xf9bc, 0xf9bc, "\u5bee"},
+	{0xf9bd, 0xf9bd, "\u5c3f"},
+	{0xf9be, 0xf9be, "\u6599"},
+	{0xf9bf, 0xf9bf, "\u6a02"},
+	{0xf9c0, 0xf9c0, "\u71ce"},
+	{0xf9c1, 0xf9c1, "\u7642"},
+	{0xf9c2, 0xf9c2, "\u84fc"},
+	{0xf9c3, 0xf9c3, "\u907c"},
+	{0xf9c4, 0xf9c4, "\u9f8d"},
+	{0xf9c5, 0xf9c5, "\u6688"},
+	{0xf9c6, 0xf9c6, "\u962e"},
+	{0xf9c7, 0xf9c7, "\u5289"},
+	{0xf9c8, 0xf9c8, "\u677b"},
+	{0xf9c9, 0xf9c9, "\u67f3"},
+	{0xf9ca, 0xf9ca, "\u6d41"},
+	{0xf9cb, 0xf9cb, "\u6e9c"},
+	{0xf9cc, 0xf9cc, "\u7409"},
+	{0xf9cd, 0xf9cd, "\u7559"},
+	{0xf9ce, 0xf9ce, "\u786b"},
+	{0xf9cf, 0xf9cf, "\u7d10"},
+	{0xf9d0, 0xf9d0, "\u985e"},
+	{0xf9d1, 0xf9d1, "\u516d"},
+	{0xf9d2, 0xf9d2, "\u622e"},
+	{0xf9d3, 0xf9d3, "\u9678"},
+	{0xf9d4, 0xf9d4, "\u502b"},
+	{0xf9d5, 0xf9d5, "\u5d19"},
+	{0xf9d6, 0xf9d6, "\u6dea"},
+	{0xf9d7, 0xf9d7, "\u8f2a"},
+	{0xf9d8, 0xf9d8, "\u5f8b"},
+	{0xf9d9, 0xf9d9, "\u6144"},
+	{0xf9da, 0xf9da, "\u6817"},
+	{0xf9db, 0xf9db, "\u7387"},
+	{0xf9dc, 0xf9dc, "\u9686"},
+	{0xf9dd, 0xf9dd, "\u5229"},
+	{0xf9de, 0xf9de, "\u540f"},
+	{0xf9df, 0xf9df, "\u5c65"},
+	{0xf9e0, 0xf9e0, "\u6613"},
+	{0xf9e1, 0xf9e1, "\u674e"},
+	{0xf9e2, 0xf9e2, "\u68a8"},
+	{0xf9e3, 0xf9e3, "\u6ce5"},
+	{0xf9e4, 0xf9e4, "\u7406"},
+	{0xf9e5, 0xf9e5, "\u75e2"},
+	{0xf9e6, 0xf9e6, "\u7f79"},
+	{0xf9e7, 0xf9e7, "\u88cf"},
+	{0xf9e8, 0xf9e8, "\u88e1"},
+	{0xf9e9, 0xf9e9, "\u91cc"},
+	{0xf9ea, 0xf9ea, "\u96e2"},
+	{0xf9eb, 0xf9eb, "\u533f"},
+	{0xf9ec, 0xf9ec, "\u6eba"},
+	{0xf9ed, 0xf9ed, "\u541d"},
+	{0xf9ee, 0xf9ee, "\u71d0"},
+	{0xf9ef, 0xf9ef, "\u7498"},
+	{0xf9f0, 0xf9f0, "\u85fa"},
+	{0xf9f1, 0xf9f1, "\u96a3"},
+	{0xf9f2, 0xf9f2, "\u9c57"},
+	{0xf9f3, 0xf9f3, "\u9e9f"},
+	{0xf9f4, 0xf9f4, "\u6797"},
+	{0xf9f5, 0xf9f5, "\u6dcb"},
+	{0xf9f6, 0xf9f6, "\u81e8"},
+	{0xf9f7, 0xf9f7, "\u7acb"},
+	{0xf9f8, 0xf9f8, "\u7b20"},
+	{0xf9f9, 0xf9f9, "\u7c92"},
+	{0xf9fa, 0xf9fa, "\u72c0"},
+	{0xf9fb, 0xf9fb, "\u7099"},
+	{0xf9fc, 0xf9fc, "\u8b58"},
+	{0xf9fd, 0xf9fd, "\u4ec0"},
+	{0xf9fe, 0xf9fe, "\u8336"},
+	{0xf9ff, 0xf9ff, "\u523a"},
+	{0xfa00, 0xfa00, "\u5207"},
+	{0xfa01, 0xfa01, "\u5ea6"},
+	{0xfa02, 0xfa02, "\u62d3"},
+	{0xfa03, 0xfa03, "\u7cd6"},
+	{0xfa04, 0xfa04, "\u5b85"},
+	{0xfa05, 0xfa05, "\u6d1e"},
+	{0xfa06, 0xfa06, "\u66b4"},
+	{0xfa07, 0xfa07, "\u8f3b"},
+	{0xfa08, 0xfa08, "\u884c"},
+	{0xfa09, 0xfa09, "\u964d"},
+	{0xfa0a, 0xfa0a, "\u898b"},
+	{0xfa0b, 0xfa0b, "\u5ed3"},
+	{0xfa0c, 0xfa0c, "\u5140"},
+	{0xfa0d, 0xfa0d, "\u55c0"},
+	{0xfa10, 0xfa10, "\u585a"},
+	{0xfa12, 0xfa12, "\u6674"},
+	{0xfa15, 0xfa15, "\u51de"},
+	{0xfa16, 0xfa16, "\u732a"},
+	{0xfa17, 0xfa17, "\u76ca"},
+	{0xfa18, 0xfa18, "\u793c"},
+	{0xfa19, 0xfa19, "\u795e"},
+	{0xfa1a, 0xfa1a, "\u7965"},
+	{0xfa1b, 0xfa1b, "\u798f"},
+	{0xfa1c, 0xfa1c, "\u9756"},
+	{0xfa1d, 0xfa1d, "\u7cbe"},
+	{0xfa1e, 0xfa1e, "\u7fbd"},
+	{0xfa20, 0xfa20, "\u8612"},
+	{0xfa22, 0xfa22, "\u8af8"},
+	{0xfa25, 0xfa25, "\u9038"},
+	{0xfa26, 0xfa26, "\u90fd"},
+	{0xfa2a, 0xfa2a, "\u98ef"},
+	{0xfa2b, 0xfa2b, "\u98fc"},
+	{0xfa2c, 0xfa2c, "\u9928"},
+	{0xfa2d, 0xfa2d, "\u9db4"},
+	{0xfa2e, 0xfa2e, "\u90de"},
+	{0xfa2f, 0xfa2f, "\u96b7"},
+	{0xfa30, 0xfa30, "\u4fae"},
+	{0xfa31, 0xfa31, "\u50e7"},
+	{0xfa32, 0xfa32, "\u514d"},
+	{0xfa33, 0xfa33, "\u52c9"},
+	{0xfa34, 0xfa34, "\u52e4"},
+	{0xfa35, 0xfa35, "\u5351"},
+	{0xfa36, 0xfa36, "\u559d"},
+	{0xfa37, 0xfa37, "\u5606"},
+	{0xfa38, 0xfa38, "\u5668"},
+	{0xfa39, 0xfa39, "\u5840"},
+	{0xfa3a, 0xfa3a, "\u58a8"},
+	{0xfa3b, 0xfa3b, "\u5c64"},
+	{0xfa3c, 0xfa3c, "\u5c6e"},
+	{0xfa3d, 0xfa3d, "\u6094"},
+	{0xfa3e, 0xfa3e, "\u6168"},
+	{0xfa3f, 0xfa3f, "\u618e"},
+	{0xfa40, 0xfa40, "\u61f2"},
+	{0xfa41, 0xfa41, "\u654f"},
+	{0xfa42, 0xfa42, "\u65e2"},
+	{0xfa43, 0xfa43, "\u6691"},
+	{0xfa44, 0xfa44, "\u6885"},
+	{0xfa45, 0xfa45, "\u6d77"},
+	{0xfa46, 0xfa46, "\u6e1a"},
+	{0xfa47, 0xfa47, "\u6f22"},
+	{0xfa48, 0xfa48, "\u716e"},
+	{0xfa49, 0xfa49, "\u722b"},
+	{0xfa4a, 0xfa4a, "\u7422"},
+	{0xfa4b, 0xfa4b, "\u7891"},
+	{0xfa4c, 0xfa4c, "\u793e"},
+	{0xfa4d, 0xfa4d, "\u7949"},
+	{0xfa4e, 0xfa4e, "\u7948"},
+	{0xfa4f, 0xfa4f, "\u7950"},
+	{0xfa50, 0xfa50, "\u7956"},
+	{0xfa51, 0xfa51, "\u795d"},
+	{0xfa52, 0xfa52, "\u798d"},
+	{0xfa53, 0xfa53, "\u798e"},
+	{0xfa54, 0xfa54, "\u7a40"},
+	{0xfa55, 0xfa55, "\u7a81"},
+	{0xfa56, 0xfa56, "\u7bc0"},
+	{0xfa57, 0xfa57, "\u7df4"},
+	{0xfa58, 0xfa58, "\u7e09"},
+	{0xfa59, 0xfa59, "\u7e41"},
+	{0xfa5a, 0xfa5a, "\u7f72"},
+	{0xfa5b, 0xfa5b, "\u8005"},
+	{0xfa5c, 0xfa5c, "\u81ed"},
+	{0xfa5d, 0xfa5e, "\u8279"},
+	{0xfa5f, 0xfa5f, "\u8457"},
+	{0xfa60, 0xfa60, "\u8910"},
+	{0xfa61, 0xfa61, "\u8996"},
+	{0xfa62, 0xfa62, "\u8b01"},
+	{0xfa63, 0xfa63, "\u8b39"},
+	{0xfa64, 0xfa64, "\u8cd3"},
+	{0xfa65, 0xfa65, "\u8d08"},
+	{0xfa66, 0xfa66, "\u8fb6"},
+	{0xfa67, 0xfa67, "\u9038"},
+	{0xfa68, 0xfa68, "\u96e3"},
+	{0xfa69, 0xfa69, "\u97ff"},
+	{0xfa6a, 0xfa6a, "\u983b"},
+	{0xfa6b, 0xfa6b, "\u6075"},
+	{0xfa6c, 0xfa6c, "\U000242ee"},
+	{0xfa6d, 0xfa6d, "\u8218"},
+	{0xfa70, 0xfa70, "\u4e26"},
+	{0xfa71, 0xfa71, "\u51b5"},
+	{0xfa72, 0xfa72, "\u5168"},
+	{0xfa73, 0xfa73, "\u4f80"},
+	{0xfa74, 0xfa74, "\u5145"},
+	{0xfa75, 0xfa75, "\u5180"},
+	{0xfa76, 0xfa76, "\u52c7"},
+	{0xfa77, 0xfa77, "\u52fa"},
+	{0xfa78, 0xfa78, "\u559d"},
+	{0xfa79, 0xfa79, "\u5555"},
+	{0xfa7a, 0xfa7a, "\u5599"},
+	{0xfa7b, 0xfa7b, "\u55e2"},
+	{0xfa7c, 0xfa7c, "\u585a"},
+	{0xfa7d, 0xfa7d, "\u58b3"},
+	{0xfa7e, 0xfa7e, "\u5944"},
+	{0xfa7f, 0xfa7f, "\u5954"},
+	{0xfa80, 0xfa80, "\u5a62"},
+	{0xfa81, 0xfa81, "\u5b28"},
+	{0xfa82, 0xfa82, "\u5ed2"},
+	{0xfa83, 0xfa83, "\u5ed9"},
+	{0xfa84, 0xfa84, "\u5f69"},
+	{0xfa85, 0xfa85, "\u5fad"},
+	{0xfa86, 0xfa86, "\u60d8"},
+	{0xfa87, 0xfa87, "\u614e"},
+	{0xfa88, 0xfa88, "\u6108"},
+	{0xfa89, 0xfa89, "\u618e"},
+	{0xfa8a, 0xfa8a, "\u6160"},
+	{0xfa8b, 0xfa8b, "\u61f2"},
+	{0xfa8c, 0xfa8c, "\u6234"},
+	{0xfa8d, 0xfa8d, "\u63c4"},
+	{0xfa8e, 0xfa8e, "\u641c"},
+	{0xfa8f, 0xfa8f, "\u6452"},
+	{0xfa90, 0xfa90, "\u6556"},
+	{0xfa91, 0xfa91, "\u6674"},
+	{0xfa92, 0xfa92, "\u6717"},
+	{0xfa93, 0xfa93, "\u671b"},
+	{0xfa94, 0xfa94, "\u6756"},
+	{0xfa95, 0xfa95, "\u6b79"},
+	{0xfa96, 0xfa96, "\u6bba"},
+	{0xfa97, 0xfa97, "\u6d41"},
+	{0xfa98, 0xfa98, "\u6edb"},
+	{0xfa99, 0xfa99, "\u6ecb"},
+	{0xfa9a, 0xfa9a, "\u6f22"},
+	{0xfa9b, 0xfa9b, "\u701e"},
+	{0xfa9c, 0xfa9c, "\u716e"},
+	{0xfa9d, 0xfa9d, "\u77a7"},
+	{0xfa9e, 0xfa9e, "\u7235"},
+	{0xfa9f, 0xfa9f, "\u72af"},
+	{0xfaa0, 0xfaa0, "\u732a"},
+	{0xfaa1, 0xfaa1, "\u7471"},
+	{0xfaa2, 0xfaa2, "\u7506"},
+	{0xfaa3, 0xfaa3, "\u753b"},
+	{0xfaa4, 0xfaa4, "\u761d"},
+	{0xfaa5, 0xfaa5, "\u761f"},
+	{0xfaa6, 0xfaa6, "\u76ca"},
+	{0xfaa7, 0xfaa7, "\u76db"},
+	{0xfaa8, 0xfaa8, "\u76f4"},
+	{0xfaa9, 0xfaa9, "\u774a"},
+	{0xfaaa, 0xfaaa, "\u7740"},
+	{0xfaab, 0xfaab, "\u78cc"},
+	{0xfaac, 0xfaac, "\u7ab1"},
+	{0xfaad, 0xfaad, "\u7bc0"},
+	{0xfaae, 0xfaae, "\u7c7b"},
+	{0xfaaf, 0xfaaf, "\u7d5b"},
+	{0xfab0, 0xfab0, "\u7df4"},
+	{0xfab1, 0xfab1, "\u7f3e"},
+	{0xfab2, 0xfab2, "\u8005"},
+	{0xfab3, 0xfab3, "\u8352"},
+	{0xfab4, 0xfab4, "\u83ef"},
+	{0xfab5, 0xfab5, "\u8779"},
+	{0xfab6, 0xfab6, "\u8941"},
+	{0xfab7, 0xfab7, "\u8986"},
+	{0xfab8, 0xfab8, "\u8996"},
+	{0xfab9, 0xfab9, "\u8abf"},
+	{0xfaba, 0xfaba, "\u8af8"},
+	{0xfabb, 0xfabb, "\u8acb"},
+	{0xfabc, 0xfabc, "\u8b01"},
+	{0xfabd, 0xfabd, "\u8afe"},
+	{0xfabe, 0xfabe, "\u8aed"},
+	{0xfabf, 0xfabf, "\u8b39"},
+	{0xfac0, 0xfac0, "\u8b8a"},
+	{0xfac1, 0xfac1, "\u8d08"},
+	{0xfac2, 0xfac2, "\u8f38"},
+	{0xfac3, 0xfac3, "\u9072"},
+	{0xfac4, 0xfac4, "\u9199"},
+	{0xfac5, 0xfac5, "\u9276"},
+	{0xfac6, 0xfac6, "\u967c"},
+	{0xfac7, 0xfac7, "\u96e3"},
+	{0xfac8, 0xfac8, "\u9756"},
+	{0xfac9, 0xfac9, "\u97db"},
+	{0xfaca, 0xfaca, "\u97ff"},
+	{0xfacb, 0xfacb, "\u980b"},
+	{0xfacc, 0xfacc, "\u983b"},
+	{0xfacd, 0xfacd, "\u9b12"},
+	{0xface, 0xface, "\u9f9c"},
+	{0xfacf, 0xfacf, "\U0002284a"},
+	{0xfad0, 0xfad0, "\U00022844"},
+	{0xfad1, 0xfad1, "\U000233d5"},
+	{0xfad2, 0xfad2, "\u3b9d"},
+	{0xfad3, 0xfad3, "\u4018"},
+	{0xfad4, 0xfad4, "\u4039"},
+	{0xfad5, 0xfad5, "\U00025249"},
+	{0xfad6, 0xfad6, "\U00025cd0"},
+	{0xfad7, 0xfad7, "\U00027ed3"},
+	{0xfad8, 0xfad8, "\u9f43"},
+	{0xfad9, 0xfad9, "\u9f8e"},
+	{0xfb00, 0xfb00, "ff"},
+	{0xfb01, 0xfb01, "fi"},
+	{0xfb02, 0xfb02, "fl"},
+	{0xfb03, 0xfb03, "ffi"},
+	{0xfb04, 0xfb04, "ffl"},
+	{0xfb05, 0xfb06, "st"},
+	{0xfb13, 0xfb13, "\u0574\u0576"},
+	{0xfb14, 0xfb14, "\u0574\u0565"},
+	{0xfb15, 0xfb15, "\u0574\u056b"},
+	{0xfb16, 0xfb16, "\u057e\u0576"},
+	{0xfb17, 0xfb17, "\u0574\u056d"},
+	{0xfb1d, 0xfb1d, "\u05d9\u05b4"},
+	{0xfb1f, 0xfb1f, "\u05f2\u05b7"},
+	{0xfb20, 0xfb20, "\u05e2"},
+	{0xfb21, 0xfb21, "\u05d0"},
+	{0xfb22, 0xfb22, "\u05d3"},
+	{0xfb23, 0xfb23, "\u05d4"},
+	{0xfb24, 0xfb24, "\u05db"},
+	{0xfb25, 0xfb25, "\u05dc"},
+	{0xfb26, 0xfb26, "\u05dd"},
+	{0xfb27, 0xfb27, "\u05e8"},
+	{0xfb28, 0xfb28, "\u05ea"},
+	{0xfb2a, 0xfb2a, "\u05e9\u05c1"},
+	{0xfb2b, 0xfb2b, "\u05e9\u05c2"},
+	{0xfb2c, 0xfb2c, "\u05e9\u05bc\u05c1"},
+	{0xfb2d, 0xfb2d, "\u05e9\u05bc\u05c2"},
+	{0xfb2e, 0xfb2e, "\u05d0\u05b7"},
+	{0xfb2f, 0xfb2f, "\u05d0\u05b8"},
+	{0xfb30, 0xfb30, "\u05d0\u05bc"},
+	{0xfb31, 0xfb31, "\u05d1\u05bc"},
+	{0xfb32, 0xfb32, "\u05d2\u05bc"},
+	{0xfb33, 0xfb33, "\u05d3\u05bc"},
+	{0xfb34, 0xfb34, "\u05d4\u05bc"},
+	{0xfb35, 0xfb35, "\u05d5\u05bc"},
+	{0xfb36, 0xfb36, "\u05d6\u05bc"},
+	{0xfb38, 0xfb38, "\u05d8\u05bc"},
+	{0xfb39, 0xfb39, "\u05d9\u05bc"},
+	{0xfb3a, 0xfb3a, "\u05da\u05bc"},
+	{0xfb3b, 0xfb3b, "\u05db\u05bc"},
+	{0xfb3c, 0xfb3c, "\u05dc\u05bc"},
+	{0xfb3e, 0xfb3e, "\u05de\u05bc"},
+	{0xfb40, 0xfb40, "\u05e0\u05bc"},
+	{0xfb41, 0xfb41, "\u05e1\u05bc"},
+	{0xfb43, 0xfb43, "\u05e3\u05bc"},
+	{0xfb44, 0xfb44, "\u05e4\u05bc"},
+	{0xfb46, 0xfb46, "\u05e6\u05bc"},
+	{0xfb47, 0xfb47, "\u05e7\u05bc"},
+	{0xfb48, 0xfb48, "\u05e8\u05bc"},
+	{0xfb49, 0xfb49, "\u05e9\u05bc"},
+	{0xfb4a, 0xfb4a, "\u05ea\u05bc"},
+	{0xfb4b, 0xfb4b, "\u05d5\u05b9"},
+	{0xfb4c, 0xfb4c, "\u05d1\u05bf"},
+	{0xfb4d, 0xfb4d, "\u05db\u05bf"},
+	{0xfb4e, 0xfb4e, "\u05e4\u05bf"},
+	{0xfb4f, 0xfb4f, "\u05d0\u05dc"},
+	{0xfb50, 0xfb51, "\u0671"},
+	{0xfb52, 0xfb55, "\u067b"},
+	{0xfb56, 0xfb59, "\u067e"},
+	{0xfb5a, 0xfb5d, "\u0680"},
+	{0xfb5e, 0xfb61, "\u067a"},
+	{0xfb62, 0xfb65, "\u067f"},
+	{0xfb66, 0xfb69, "\u0679"},
+	{0xfb6a, 0xfb6d, "\u06a4"},
+	{0xfb6e, 0xfb71, "\u06a6"},
+	{0xfb72, 0xfb75, "\u0684"},
+	{0xfb76, 0xfb79, "\u0683"},
+	{0xfb7a, 0xfb7d, "\u0686"},
+	{0xfb7e, 0xfb81, "\u0687"},
+	{0xfb82, 0xfb83, "\u068d"},
+	{0xfb84, 0xfb85, "\u068c"},
+	{0xfb86, 0xfb87, "\u068e"},
+	{0xfb88, 0xfb89, "\u0688"},
+	{0xfb8a, 0xfb8b, "\u0698"},
+	{0xfb8c, 0xfb8d, "\u0691"},
+	{0xfb8e, 0xfb91, "\u06a9"},
+	{0xfb92, 0xfb95, "\u06af"},
+	{0xfb96, 0xfb99, "\u06b3"},
+	{0xfb9a, 0xfb9d, "\u06b1"},
+	{0xfb9e, 0xfb9f, "\u06ba"},
+	{0xfba0, 0xfba3, "\u06bb"},
+	{0xfba4, 0xfba5, "\u06c0"},
+	{0xfba6, 0xfba9, "\u06c1"},
+	{0xfbaa, 0xfbad, "\u06be"},
+	{0xfbae, 0xfbaf, "\u06d2"},
+	{0xfbb0, 0xfbb1, "\u06d3"},
+	{0xfbd3, 0xfbd6, "\u06ad"},
+	{0xfbd7, 0xfbd8, "\u06c7"},
+	{0xfbd9, 0xfbda, "\u06c6"},
+	{0xfbdb, 0xfbdc, "\u06c8"},
+	{0xfbdd, 0xfbdd, "\u06c7\u0674"},
+	{0xfbde, 0xfbdf, "\u06cb"},
+	{0xfbe0, 0xfbe1, "\u06c5"},
+	{0xfbe2, 0xfbe3, "\u06c9"},
+	{0xfbe4, 0xfbe7, "\u06d0"},
+	{0xfbe8, 0xfbe9, "\u0649"},
+	{0xfbea, 0xfbeb, "\u0626\u0627"},
+	{0xfbec, 0xfbed, "\u0626\u06d5"},
+	{0xfbee, 0xfbef, "\u0626\u0648"},
+	{0xfbf0, 0xfbf1, "\u0626\u06c7"},
+	{0xfbf2, 0xfbf3, "\u0626\u06c6"},
+	{0xfbf4, 0xfbf5, "\u0626\u06c8"},
+	{0xfbf6, 0xfbf8, "\u0626\u06d0"},
+	{0xfbf9, 0xfbfb, "\u0626\u0649"},
+	{0xfbfc, 0xfbff, "\u06cc"},
+	{0xfc00, 0xfc00, "\u0626\u062c"},
+	{0xfc01, 0xfc01, "\u0626\u062d"},
+	{0xfc02, 0xfc02, "\u0626\u0645"},
+	{0xfc03, 0xfc03, "\u0626\u0649"},
+	{0xfc04, 0xfc04, "\u0626\u064a"},
+	{0xfc05, 0xfc05, "\u0628\u062c"},
+	{0xfc06, 0xfc06, "\u0628\u062d"},
+	{0xfc07, 0xfc07, "\u0628\u062e"},
+	{0xfc08, 0xfc08, "\u0628\u0645"},
+	{0xfc09, 0xfc09, "\u0628\u0649"},
+	{0xfc0a, 0xfc0a, "\u0628\u064a"},
+	{0xfc0b, 0xfc0b, "\u062a\u062c"},
+	{0xfc0c, 0xfc0c, "\u062a\u062d"},
+	{0xfc0d, 0xfc0d, "\u062a\u062e"},
+	{0xfc0e, 0xfc0e, "\u062a\u0645"},
+	{0xfc0f, 0xfc0f, "\u062a\u0649"},
+	{0xfc10, 0xfc10, "\u062a\u064a"},
+	{0xfc11, 0xfc11, "\u062b\u062c"},
+	{0xfc12, 0xfc12, "\u062b\u0645"},
+	{0xfc13, 0xfc13, "\u062b\u0649"},
+	{0xfc14, 0xfc14, "\u062b\u064a"},
+	{0xfc15, 0xfc15, "\u062c\u062d"},
+	{0xfc16, 0xfc16, "\u062c\u0645"},
+	{0xfc17, 0xfc17, "\u062d\u062c"},
+	{0xfc18, 0xfc18, "\u062d\u0645"},
+	{0xfc19, 0xfc19, "\u062e\u062c"},
+	{0xfc1a, 0xfc1a, "\u062e\u062d"},
+	{0xfc1b, 0xfc1b, "\u062e\u0645"},
+	{0xfc1c, 0xfc1c, "\u0633\u062c"},
+	{0xfc1d, 0xfc1d, "\u0633\u062d"},
+	{0xfc1e, 0xfc1e, "\u0633\u062e"},
+	{0xfc1f, 0xfc1f, "\u0633\u0645"},
+	{0xfc20, 0xfc20, "\u0635\u062d"},
+	{0xfc21, 0xfc21, "\u0635\u0645"},
+	{0xfc22, 0xfc22, "\u0636\u062c"},
+	{0xfc23, 0xfc23, "\u0636\u062d"},
+	{0xfc24, 0xfc24, "\u0636\u062e"},
+	{0xfc25, 0xfc25, "\u0636\u0645"},
+	{0xfc26, 0xfc26, "\u0637\u062d"},
+	{0xfc27, 0xfc27, "\u0637\u0645"},
+	{0xfc28, 0xfc28, "\u0638\u0645"},
+	{0xfc29, 0xfc29, "\u0639\u062c"},
+	{0xfc2a, 0xfc2a, "\u0639\u0645"},
+	{0xfc2b, 0xfc2b, "\u063a\u062c"},
+	{0xfc2c, 0xfc2c, "\u063a\u0645"},
+	{0xfc2d, 0xfc2d, "\u0641\u062c"},
+	{0xfc2e, 0xfc2e, "\u0641\u062d"},
+	{0xfc2f, 0xfc2f, "\u0641\u062e"},
+	{0xfc30, 0xfc30, "\u0641\u0645"},
+	{0xfc31, 0xfc31, "\u0641\u0649"},
+	{0xfc32, 0xfc32, "\u0641\u064a"},
+	{0xfc33, 0xfc33, "\u0642\u062d"},
+	{0xfc34, 0xfc34, "\u0642\u0645"},
+	{0xfc35, 0xfc35, "\u0642\u0649"},
+	{0xfc36, 0xfc36, "\u0642\u064a"},
+	{0xfc37, 0xfc37, "\u0643\u0627"},
+	{0xfc38, 0xfc38, "\u0643\u062c"},
+	{0xfc39, 0xfc39, "\u0643\u062d"},
+	{0xfc3a, 0xfc3a, "\u0643\u062e"},
+	{0xfc3b, 0xfc3b, "\u0643\u0644"},
+	{0xfc3c, 0xfc3c, "\u0643\u0645"},
+	{0xfc3d, 0xfc3d, "\u0643\u0649"},
+	{0xfc3e, 0xfc3e, "\u0643\u064a"},
+	{0xfc3f, 0xfc3f, "\u0644\u062c"},
+	{0xfc40, 0xfc40, "\u0644\u062d"},
+	{0xfc41, 0xfc41, "\u0644\u062e"},
+	{0xfc42, 0xfc42, "\u0644\u0645"},
+	{0xfc43, 0xfc43, "\u0644\u0649"},
+	{0xfc44, 0xfc44, "\u0644\u064a"},
+	{0xfc45, 0xfc45, "\u0645\u062c"},
+	{0xfc46, 0xfc46, "\u0645\u062d"},
+	{0xfc47, 0xfc47, "\u0645\u062e"},
+	{0xfc48, 0xfc48, "\u0645\u0645"},
+	{0xfc49, 0xfc49, "\u0645\u0649"},
+	{0xfc4a, 0xfc4a, "\u0645\u064a"},
+	{0xfc4b, 0xfc4b, "\u0646\u062c"},
+	{0xfc4c, 0xfc4c, "\u0646\u062d"},
+	{0xfc4d, 0xfc4d, "\u0646\u062e"},
+	{0xfc4e, 0xfc4e, "\u0646\u0645"},
+	{0xfc4f, 0xfc4f, "\u0646\u0649"},
+	{0xfc50, 0xfc50, "\u0646\u064a"},
+	{0xfc51, 0xfc51, "\u0647\u062c"},
+	{0xfc52, 0xfc52, "\u0647\u0645"},
+	{0xfc53, 0xfc53, "\u0647\u0649"},
+	{0xfc54, 0xfc54, "\u0647\u064a"},
+	{0xfc55, 0xfc55, "\u064a\u062c"},
+	{0xfc56, 0xfc56, "\u064a\u062d"},
+	{0xfc57, 0xfc57, "\u064a\u062e"},
+	{0xfc58, 0xfc58, "\u064a\u0645"},
+	{0xfc59, 0xfc59, "\u064a\u0649"},
+	{0xfc5a, 0xfc5a, "\u064a\u064a"},
+	{0xfc5b, 0xfc5b, "\u0630\u0670"},
+	{0xfc5c, 0xfc5c, "\u0631\u0670"},
+	{0xfc5d, 0xfc5d, "\u0649\u0670"},
+	{0xfc64, 0xfc64, "\u0626\u0631"},
+	{0xfc65, 0xfc65, "\u0626\u0632"},
+	{0xfc66, 0xfc66, "\u0626\u0645"},
+	{0xfc67, 0xfc67, "\u0626\u0646"},
+	{0xfc68, 0xfc68, "\u0626\u0649"},
+	{0xfc69, 0xfc69, "\u0626\u064a"},
+	{0xfc6a, 0xfc6a, "\u0628\u0631"},
+	{0xfc6b, 0xfc6b, "\u0628\u0632"},
+	{0xfc6c, 0xfc6c, "\u0628\u0645"},
+	{0xfc6d, 0xfc6d, "\u0628\u0646"},
+	{0xfc6e, 0xfc6e, "\u0628\u0649"},
+	{0xfc6f, 0xfc6f, "\u0628\u064a"},
+	{0xfc70, 0xfc70, "\u062a\u0631"},
+	{0xfc71, 0xfc71, "\u062a\u0632"},
+	{0xfc72, 0xfc72, "\u062a\u0645"},
+	{0xfc73, 0xfc73, "\u062a\u0646"},
+	{0xfc74, 0xfc74, "\u062a\u0649"},
+	{0xfc75, 0xfc75, "\u062a\u064a"},
+	{0xfc76, 0xfc76, "\u062b\u0631"},
+	{0xfc77, 0xfc77, "\u062b\u0632"},
+	{0xfc78, 0xfc78, "\u062b\u0645"},
+	{0xfc79, 0xfc79, "\u062b\u0646"},
+	{0xfc7a, 0xfc7a, "\u062b\u0649"},
+	{0xfc7b, 0xfc7b, "\u062b\u064a"},
+	{0xfc7c, 0xfc7c, "\u0641\u0649"},
+	{0xfc7d, 0xfc7d, "\u0641\u064a"},
+	{0xfc7e, 0xfc7e, "\u0642\u0649"},
+	{0xfc7f, 0xfc7f, "\u0642\u064a"},
+	{0xfc80, 0xfc80, "\u0643\u0627"},
+	{0xfc81, 0xfc81, "\u0643\u0644"},
+	{0xfc82, 0xfc82, "\u0643\u0645"},
+	{0xfc83, 0xfc83, "\u0643\u0649"},
+	{0xfc84, 0xfc84, "\u0643\u064a"},
+	{0xfc85, 0xfc85, "\u0644\u0645"},
+	{0xfc86, 0xfc86, "\u0644\u0649"},
+	{0xfc87, 0xfc87, "\u0644\u064a"},
+	{0xfc88, 0xfc88, "\u0645\u0627"},
+	{0xfc89, 0xfc89, "\u0645\u0645"},
+	{0xfc8a, 0xfc8a, "\u0646\u0631"},
+	{0xfc8b, 0xfc8b, "\u0646\u0632"},
+	{0xfc8c, 0xfc8c, "\u0646\u0645"},
+	{0xfc8d, 0xfc8d, "\u0646\u0646"},
+	{0xfc8e, 0xfc8e, "\u0646\u0649"},
+	{0xfc8f, 0xfc8f, "\u0646\u064a"},
+	{0xfc90, 0xfc90, "\u0649\u0670"},
+	{0xfc91, 0xfc91, "\u064a\u0631"},
+	{0xfc92, 0xfc92, "\u064a\u0632"},
+	{0xfc93, 0xfc93, "\u064a\u0645"},
+	{0xfc94, 0xfc94, "\u064a\u0646"},
+	{0xfc95, 0xfc95, "\u064a\u0649"},
+	{0xfc96, 0xfc96, "\u064a\u064a"},
+	{0xfc97, 0xfc97, "\u0626\u062c"},
+	{0xfc98, 0xfc98, "\u0626\u062d"},
+	{0xfc99, 0xfc99, "\u0626\u062e"},
+	{0xfc9a, 0xfc9a, "\u0626\u0645"},
+	{0xfc9b, 0xfc9b, "\u0626\u0647"},
+	{0xfc9c, 0xfc9c, "\u0628\u062c"},
+	{0xfc9d, 0xfc9d, "\u0628\u062d"},
+	{0xfc9e, 0xfc9e, "\u0628\u062e"},
+	{0xfc9f, 0xfc9f, "\u0628\u0645"},
+	{0xfca0, 0xfca0, "\u0628\u0647"},
+	{0xfca1, 0xfca1, "\u062a\u062c"},
+	{0xfca2, 0xfca2, "\u062a\u062d"},
+	{0xfca3, 0xfca3, "\u062a\u062e"},
+	{0xfca4, 0xfca4, "\u062a\u0645"},
+	{0xfca5, 0xfca5, "\u062a\u0647"},
+	{0xfca6, 0xfca6, "\u062b\u0645"},
+	{0xfca7, 0xfca7, "\u062c\u062d"},
+	{0xfca8, 0xfca8, "\u062c\u0645"},
+	{0xfca9, 0xfca9, "\u062d\u062c"},
+	{0xfcaa, 0xfcaa, "\u062d\u0645"},
+	{0xfcab, 0xfcab, "\u062e\u062c"},
+	{0xfcac, 0xfcac, "\u062e\u0645"},
+	{0xfcad, 0xfcad, "\u0633\u062c"},
+	{0xfcae, 0xfcae, "\u0633\u062d"},
+	{0xfcaf, 0xfcaf, "\u0633\u062e"},
+	{0xfcb0, 0xfcb0, "\u0633\u0645"},
+	{0xfcb1, 0xfcb1, "\u0635\u062d"},
+	{0xfcb2, 0xfcb2, "\u0635\u062e"},
+	{0xfcb3, 0xfcb3, "\u0635\u0645"},
+	{0xfcb4, 0xfcb4, "\u0636\u062c"},
+	{0xfcb5, 0xfcb5, "\u0636\u062d"},
+	{0xfcb6, 0xfcb6, "\u0636\u062e"},
+	{0xfcb7, 0xfcb7, "\u0636\u0645"},
+	{0xfcb8, 0xfcb8, "\u0637\u062d"},
+	{0xfcb9, 0xfcb9, "\u0638\u0645"},
+	{0xfcba, 0xfcba, "\u0639\u062c"},
+	{0xfcbb, 0xfcbb, "\u0639\u0645"},
+	{0xfcbc, 0xfcbc, "\u063a\u062c"},
+	{0xfcbd, 0xfcbd, "\u063a\u0645"},
+	{0xfcbe, 0xfcbe, "\u0641\u062c"},
+	{0xfcbf, 0xfcbf, "\u0641\u062d"},
+	{0xfcc0, 0xfcc0, "\u0641\u062e"},
+	{0xfcc1, 0xfcc1, "\u0641\u0645"},
+	{0xfcc2, 0xfcc2, "\u0642\u062d"},
+	{0xfcc3, 0xfcc3, "\u0642\u0645"},
+	{0xfcc4, 0xfcc4, "\u0643\u062c"},
+	{0xfcc5, 0xfcc5, "\u0643\u062d"},
+	{0xfcc6, 0xfcc6, "\u0643\u062e"},
+	{0xfcc7, 0xfcc7, "\u0643\u0644"},
+	{0xfcc8, 0xfcc8, "\u0643\u0645"},
+	{0xfcc9, 0xfcc9, "\u0644\u062c"},
+	{0xfcca, 0xfcca, "\u0644\u062d"},
+	{0xfccb, 0xfccb, "\u0644\u062e"},
+	{0xfccc, 0xfccc, "\u0644\u0645"},
+	{0xfccd, 0xfccd, "\u0644\u0647"},
+	{0xfcce, 0xfcce, "\u0645\u062c"},
+	{0xfccf, 0xfccf, "\u0645\u062d"},
+	{0xfcd0, 0xfcd0, "\u0645\u062e"},
+	{0xfcd1, 0xfcd1, "\u0645\u0645"},
+	{0xfcd2, 0xfcd2, "\u0646\u062c"},
+	{0xfcd3, 0xfcd3, "\u0646\u062d"},
+	{0xfcd4, 0xfcd4, "\u0646\u062e"},
+	{0xfcd5, 0xfcd5, "\u0646\u0645"},
+	{0xfcd6, 0xfcd6, "\u0646\u0647"},
+	{0xfcd7, 0xfcd7, "\u0647\u062c"},
+	{0xfcd8, 0xfcd8, "\u0647\u0645"},
+	{0xfcd9, 0xfcd9, "\u0647\u0670"},
+	{0xfcda, 0xfcda, "\u064a\u062c"},
+	{0xfcdb, 0xfcdb, "\u064a\u062d"},
+	{0xfcdc, 0xfcdc, "\u064a\u062e"},
+	{0xfcdd, 0xfcdd, "\u064a\u0645"},
+	{0xfcde, 0xfcde, "\u064a\u0647"},
+	{0xfcdf, 0xfcdf, "\u0626\u0645"},
+	{0xfce0, 0xfce0, "\u0626\u0647"},
+	{0xfce1, 0xfce1, "\u0628\u0645"},
+	{0xfce2, 0xfce2, "\u0628\u0647"},
+	{0xfce3, 0xfce3, "\u062a\u0645"},
+	{0xfce4, 0xfce4, "\u062a\u0647"},
+	{0xfce5, 0xfce5, "\u062b\u0645"},
+	{0xfce6, 0xfce6, "\u062b\u0647"},
+	{0xfce7, 0xfce7, "\u0633\u0645"},
+	{0xfce8, 0xfce8, "\u0633\u0647"},
+	{0xfce9, 0xfce9, "\u0634\u0645"},
+	{0xfcea, 0xfcea, "\u0634\u0647"},
+	{0xfceb, 0xfceb, "\u0643\u0644"},
+	{0xfcec, 0xfcec, "\u0643\u0645"},
+	{0xfced, 0xfced, "\u0644\u0645"},
+	{0xfcee, 0xfcee, "\u0646\u0645"},
+	{0xfcef, 0xfcef, "\u0646\u0647"},
+	{0xfcf0, 0xfcf0, "\u064a\u0645"},
+	{0xfcf1, 0xfcf1, "\u064a\u0647"},
+	{0xfcf2, 0xfcf2, "\u0640\u064e\u0651"},
+	{0xfcf3, 0xfcf3, "\u0640\u064f\u0651"},
+	{0xfcf4, 0xfcf4, "\u0640\u0650\u0651"},
+	{0xfcf5, 0xfcf5, "\u0637\u0649"},
+	{0xfcf6, 0xfcf6, "\u0637\u064a"},
+	{0xfcf7, 0xfcf7, "\u0639\u0649"},
+	{0xfcf8, 0xfcf8, "\u0639\u064a"},
+	{0xfcf9, 0xfcf9, "\u063a\u0649"},
+	{0xfcfa, 0xfcfa, "\u063a\u064a"},
+	{0xfcfb, 0xfcfb, "\u0633\u0649"},
+	{0xfcfc, 0xfcfc, "\u0633\u064a"},
+	{0xfcfd, 0xfcfd, "\u0634\u0649"},
+	{0xfcfe, 0xfcfe, "\u0634\u064a"},
+	{0xfcff, 0xfcff, "\u062d\u0649"},
+	{0xfd00, 0xfd00, "\u062d\u064a"},
+	{0xfd01, 0xfd01, "\u062c\u0649"},
+	{0xfd02, 0xfd02, "\u062c\u064a"},
+	{0xfd03, 0xfd03, "\u062e\u0649"},
+	{0xfd04, 0xfd04, "\u062e\u064a"},
+	{0xfd05, 0xfd05, "\u0635\u0649"},
+	{0xfd06, 0xfd06, "\u0635\u064a"},
+	{0xfd07, 0xfd07, "\u0636\u0649"},
+	{0xfd08, 0xfd08, "\u0636\u064a"},
+	{0xfd09, 0xfd09, "\u0634\u062c"},
+	{0xfd0a, 0xfd0a, "\u0634\u062d"},
+	{0xfd0b, 0xfd0b, "\u0634\u062e"},
+	{0xfd0c, 0xfd0c, "\u0634\u0645"},
+	{0xfd0d, 0xfd0d, "\u0634\u0631"},
+	{0xfd0e, 0xfd0e, "\u0633\u0631"},
+	{0xfd0f, 0xfd0f, "\u0635\u0631"},
+	{0xfd10, 0xfd10, "\u0636\u0631"},
+	{0xfd11, 0xfd11, "\u0637\u0649"},
+	{0xfd12, 0xfd12, "\u0637\u064a"},
+	{0xfd13, 0xfd13, "\u0639\u0649"},
+	{0xfd14, 0xfd14, "\u0639\u064a"},
+	{0xfd15, 0xfd15, "\u063a\u0649"},
+	{0xfd16, 0xfd16, "\u063a\u064a"},
+	{0xfd17, 0xfd17, "\u0633\u0649"},
+	{0xfd18, 0xfd18, "\u0633\u064a"},
+	{0xfd19, 0xfd19, "\u0634\u0649"},
+	{0xfd1a, 0xfd1a, "\u0634\u064a"},
+	{0xfd1b, 0xfd1b, "\u062d\u0649"},
+	{0xfd1c, 0xfd1c, "\u062d\u064a"},
+	{0xfd1d, 0xfd1d, "\u062c\u0649"},
+	{0xfd1e, 0xfd1e, "\u062c\u064a"},
+	{0xfd1f, 0xfd1f, "\u062e\u0649"},
+	{0xfd20, 0xfd20, "\u062e\u064a"},
+	{0xfd21, 0xfd21, "\u0635\u0649"},
+	{0xfd22, 0xfd22, "\u0635\u064a"},
+	{0xfd23, 0xfd23, "\u0636\u0649"},
+	{0xfd24, 0xfd24, "\u0636\u064a"},
+	{0xfd25, 0xfd25, "\u0634\u062c"},
+	{0xfd26, 0xfd26, "\u0634\u062d"},
+	{0xfd27, 0xfd27, "\u0634\u062e"},
+	{0xfd28, 0xfd28, "\u0634\u0645"},
+	{0xfd29, 0xfd29, "\u0634\u0631"},
+	{0xfd2a, 0xfd2a, "\u0633\u0631"},
+	{0xfd2b, 0xfd2b, "\u0635\u0631"},
+	{0xfd2c, 0xfd2c, "\u0636\u0631"},
+	{0xfd2d, 0xfd2d, "\u0634\u062c"},
+	{0xfd2e, 0xfd2e, "\u0634\u062d"},
+	{0xfd2f, 0xfd2f, "\u0634\u062e"},
+	{0xfd30, 0xfd30, "\u0634\u0645"},
+	{0xfd31, 0xfd31, "\u0633\u0647"},
+	{0xfd32, 0xfd32, "\u0634\u0647"},
+	{0xfd33, 0xfd33, "\u0637\u0645"},
+	{0xfd34, 0xfd34, "\u0633\u062c"},
+	{0xfd35, 0xfd35, "\u0633\u062d"},
+	{0xfd36, 0xfd36, "\u0633\u062e"},
+	{0xfd37, 0xfd37, "\u0634\u062c"},
+	{0xfd38, 0xfd38, "\u0634\u062d"},
+	{0xfd39, 0xfd39, "\u0634\u062e"},
+	{0xfd3a, 0xfd3a, "\u0637\u0645"},
+	{0xfd3b, 0xfd3b, "\u0638\u0645"},
+	{0xfd3c, 0xfd3d, "\u0627\u064b"},
+	{0xfd50, 0xfd50, "\u062a\u062c\u0645"},
+	{0xfd51, 0xfd52, "\u062a\u062d\u062c"},
+	{0xfd53, 0xfd53, "\u062a\u062d\u0645"},
+	{0xfd54, 0xfd54, "\u062a\u062e\u0645"},
+	{0xfd55, 0xfd55, "\u062a\u0645\u062c"},
+	{0xfd56, 0xfd56, "\u062a\u0645\u062d"},
+	{0xfd57, 0xfd57, "\u062a\u0645\u062e"},
+	{0xfd58, 0xfd59, "\u062c\u0645\u062d"},
+	{0xfd5a, 0xfd5a, "\u062d\u0645\u064a"},
+	{0xfd5b, 0xfd5b, "\u062d\u0645\u0649"},
+	{0xfd5c, 0xfd5c, "\u0633\u062d\u062c"},
+	{0xfd5d, 0xfd5d, "\u0633\u062c\u062d"},
+	{0xfd5e, 0xfd5e, "\u0633\u062c\u0649"},
+	{0xfd5f, 0xfd60, "\u0633\u0645\u062d"},
+	{0xfd61, 0xfd61, "\u0633\u0645\u062c"},
+	{0xfd62, 0xfd63, "\u0633\u0645\u0645"},
+	{0xfd64, 0xfd65, "\u0635\u062d\u062d"},
+	{0xfd66, 0xfd66, "\u0635\u0645\u0645"},
+	{0xfd67, 0xfd68, "\u0634\u062d\u0645"},
+	{0xfd69, 0xfd69, "\u0634\u062c\u064a"},
+	{0xfd6a, 0xfd6b, "\u0634\u0645\u062e"},
+	{0xfd6c, 0xfd6d, "\u0634\u0645\u0645"},
+	{0xfd6e, 0xfd6e, "\u0636\u062d\u0649"},
+	{0xfd6f, 0xfd70, "\u0636\u062e\u0645"},
+	{0xfd71, 0xfd72, "\u0637\u0645\u062d"},
+	{0xfd73, 0xfd73, "\u0637\u0645\u0645"},
+	{0xfd74, 0xfd74, "\u0637\u0645\u064a"},
+	{0xfd75, 0xfd75, "\u0639\u062c\u0645"},
+	{0xfd76, 0xfd77, "\u0639\u0645\u0645"},
+	{0xfd78, 0xfd78, "\u0639\u0645\u0649"},
+	{0xfd79, 0xfd79, "\u063a\u0645\u0645"},
+	{0xfd7a, 0xfd7a, "\u063a\u0645\u064a"},
+	{0xfd7b, 0xfd7b, "\u063a\u0645\u0649"},
+	{0xfd7c, 0xfd7d, "\u0641\u062e\u0645"},
+	{0xfd7e, 0xfd7e, "\u0642\u0645\u062d"},
+	{0xfd7f, 0xfd7f, "\u0642\u0645\u0645"},
+	{0xfd80, 0xfd80, "\u0644\u062d\u0645"},
+	{0xfd81, 0xfd81, "\u0644\u062d\u064a"},
+	{0xfd82, 0xfd82, "\u0644\u062d\u0649"},
+	{0xfd83, 0xfd84, "\u0644\u062c\u062c"},
+	{0xfd85, 0xfd86, "\u0644\u062e\u0645"},
+	{0xfd87, 0xfd88, "\u0644\u0645\u062d"},
+	{0xfd89, 0xfd89, "\u0645\u062d\u062c"},
+	{0xfd8a, 0xfd8a, "\u0645\u062d\u0645"},
+	{0xfd8b, 0xfd8b, "\u0645\u062d\u064a"},
+	{0xfd8c, 0xfd8c, "\u0645\u062c\u062d"},
+	{0xfd8d, 0xfd8d, "\u0645\u062c\u0645"},
+	{0xfd8e, 0xfd8e, "\u0645\u062e\u062c"},
+	{0xfd8f, 0xfd8f, "\u0645\u062e\u0645"},
+	{0xfd92, 0xfd92, "\u0645\u062c\u062e"},
+	{0xfd93, 0xfd93, "\u0647\u0645\u062c"},
+	{0xfd94, 0xfd94, "\u0647\u0645\u0645"},
+	{0xfd95, 0xfd95, "\u0646\u062d\u0645"},
+	{0xfd96, 0xfd96, "\u0646\u062d\u0649"},
+	{0xfd97, 0xfd98, "\u0646\u062c\u0645"},
+	{0xfd99, 0xfd99, "\u0646\u062c\u0649"},
+	{0xfd9a, 0xfd9a, "\u0646\u0645\u064a"},
+	{0xfd9b, 0xfd9b, "\u0646\u0645\u0649"},
+	{0xfd9c, 0xfd9d, "\u064a\u0645\u0645"},
+	{0xfd9e, 0xfd9e, "\u0628\u062e\u064a"},
+	{0xfd9f, 0xfd9f, "\u062a\u062c\u064a"},
+	{0xfda0, 0xfda0, "\u062a\u062c\u0649"},
+	{0xfda1, 0xfda1, "\u062a\u062e\u064a"},
+	{0xfda2, 0xfda2, "\u062a\u062e\u0649"},
+	{0xfda3, 0xfda3, "\u062a\u0645\u064a"},
+	{0xfda4, 0xfda4, "\u062a\u0645\u0649"},
+	{0xfda5, 0xfda5, "\u062c\u0645\u064a"},
+	{0xfda6, 0xfda6, "\u062c\u062d\u0649"},
+	{0xfda7, 0xfda7, "\u062c\u0645\u0649"},
+	{0xfda8, 0xfda8, "\u0633\u062e\u0649"},
+	{0xfda9, 0xfda9, "\u0635\u062d\u064a"},
+	{0xfdaa, 0xfdaa, "\u0634\u062d\u064a"},
+	{0xfdab, 0xfdab, "\u0636\u062d\u064a"},
+	{0xfdac, 0xfdac, "\u0644\u062c\u064a"},
+	{0xfdad, 0xfdad, "\u0644\u0645\u064a"},
+	{0xfdae, 0xfdae, "\u064a\u062d\u064a"},
+	{0xfdaf, 0xfdaf, "\u064a\u062c\u064a"},
+	{0xfdb0, 0xfdb0, "\u064a\u0645\u064a"},
+	{0xfdb1, 0xfdb1, "\u0645\u0645\u064a"},
+	{0xfdb2, 0xfdb2, "\u0642\u0645\u064a"},
+	{0xfdb3, 0xfdb3, "\u0646\u062d\u064a"},
+	{0xfdb4, 0xfdb4, "\u0642\u0645\u062d"},
+	{0xfdb5, 0xfdb5, "\u0644\u062d\u0645"},
+	{0xfdb6, 0xfdb6, "\u0639\u0645\u064a"},
+	{0xfdb7, 0xfdb7, "\u0643\u0645\u064a"},
+	{0xfdb8, 0xfdb8, "\u0646\u062c\u062d"},
+	{0xfdb9, 0xfdb9, "\u0645\u062e\u064a"},
+	{0xfdba, 0xfdba, "\u0644\u062c\u0645"},
+	{0xfdbb, 0xfdbb, "\u0643\u0645\u0645"},
+	{0xfdbc, 0xfdbc, "\u0644\u062c\u0645"},
+	{0xfdbd, 0xfdbd, "\u0646\u062c\u062d"},
+	{0xfdbe, 0xfdbe, "\u062c\u062d\u064a"},
+	{0xfdbf, 0xfdbf, "\u062d\u062c\u064a"},
+	{0xfdc0, 0xfdc0, "\u0645\u062c\u064a"},
+	{0xfdc1, 0xfdc1, "\u0641\u0645\u064a"},
+	{0xfdc2, 0xfdc2, "\u0628\u062d\u064a"},
+	{0xfdc3, 0xfdc3, "\u0643\u0645\u0645"},
+	{0xfdc4, 0xfdc4, "\u0639\u062c\u0645"},
+	{0xfdc5, 0xfdc5, "\u0635\u0645\u0645"},
+	{0xfdc6, 0xfdc6, "\u0633\u062e\u064a"},
+	{0xfdc7, 0xfdc7, "\u0646\u062c\u064a"},
+	{0xfdf0, 0xfdf0, "\u0635\u0644\u06d2"},
+	{0xfdf1, 0xfdf1, "\u0642\u0644\u06d2"},
+	{0xfdf2, 0xfdf2, "\u0627\u0644\u0644\u0647"},
+	{0xfdf3, 0xfdf3, "\u0627\u0643\u0628\u0631"},
+	{0xfdf4, 0xfdf4, "\u0645\u062d\u0645\u062f"},
+	{0xfdf5, 0xfdf5, "\u0635\u0644\u0639\u0645"},
+	{0xfdf6, 0xfdf6, "\u0631\u0633\u0648\u0644"},
+	{0xfdf7, 0xfdf7, "\u0639\u0644\u064a\u0647"},
+	{0xfdf8, 0xfdf8, "\u0648\u0633\u0644\u0645"},
+	{0xfdf9, 0xfdf9, "\u0635\u0644\u0649"},
+	{0xfdfc, 0xfdfc, "\u0631\u06cc\u0627\u0644"},
+	{0xfe11, 0xfe11, "\u3001"},
+	{0xfe17, 0xfe17, "\u3016"},
+	{0xfe18, 0xfe18, "\u3017"},
+	{0xfe31, 0xfe31, "\u2014"},
+	{0xfe32, 0xfe32, "\u2013"},
+	{0xfe39, 0xfe39, "\u3014"},
+	{0xfe3a, 0xfe3a, "\u3015"},
+	{0xfe3b, 0xfe3b, "\u3010"},
+	{0xfe3c, 0xfe3c, "\u3011"},
+	{0xfe3d, 0xfe3d, "\u300a"},
+	{0xfe3e, 0xfe3e, "\u300b"},
+	{0xfe3f, 0xfe3f, "\u3008"},
+	{0xfe40, 0xfe40, "\u3009"},
+	{0xfe41, 0xfe41, "\u300c"},
+	{0xfe42, 0xfe42, "\u300d"},
+	{0xfe43, 0xfe43, "\u300e"},
+	{0xfe44, 0xfe44, "\u300f"},
+	{0xfe51, 0xfe51, "\u3001"},
+	{0xfe58, 0xfe58, "\u2014"},
+	{0xfe5d, 0xfe5d, "\u3014"},
+	{0xfe5e, 0xfe5e, "\u3015"},
+	{0xfe63, 0xfe63, "-"},
+	{0xfe71, 0xfe71, "\u0640\u064b"},
+	{0xfe77, 0xfe77, "\u0640\u064e"},
+	{0xfe79, 0xfe79, "\u0640\u064f"},
+	{0xfe7b, 0xfe7b, "\u0640\u0650"},
+	{0xfe7d, 0xfe7d, "\u0640\u0651"},
+	{0xfe7f, 0xfe7f, "\u0640\u0652"},
+	{0xfe80, 0xfe80, "\u0621"},
+	{0xfe81, 0xfe82, "\u0622"},
+	{0xfe83, 0xfe84, "\u0623"},
+	{0xfe85, 0xfe86, "\u0624"},
+	{0xfe87, 0xfe88, "\u0625"},
+	{0xfe89, 0xfe8c, "\u0626"},
+	{0xfe8d, 0xfe8e, "\u0627"},
+	{0xfe8f, 0xfe92, "\u0628"},
+	{0xfe93, 0xfe94, "\u0629"},
+	{0xfe95, 0xfe98, "\u062a"},
+	{0xfe99, 0xfe9c, "\u062b"},
+	{0xfe9d, 0xfea0, "\u062c"},
+	{0xfea1, 0xfea4, "\u062d"},
+	{0xfea5, 0xfea8, "\u062e"},
+	{0xfea9, 0xfeaa, "\u062f"},
+	{0xfeab, 0xfeac, "\u0630"},
+	{0xfead, 0xfeae, "\u0631"},
+	{0xfeaf, 0xfeb0, "\u0632"},
+	{0xfeb1, 0xfeb4, "\u0633"},
+	{0xfeb5, 0xfeb8, "\u0634"},
+	{0xfeb9, 0xfebc, "\u0635"},
+	{0xfebd, 0xfec0, "\u0636"},
+	{0xfec1, 0xfec4, "\u0637"},
+	{0xfec5, 0xfec8, "\u0638"},
+	{0xfec9, 0xfecc, "\u0639"},
+	{0xfecd, 0xfed0, "\u063a"},
+	{0xfed1, 0xfed4, "\u0641"},
+	{0xfed5, 0xfed8, "\u0642"},
+	{0xfed9, 0xfedc, "\u0643"},
+	{0xfedd, 0xfee0, "\u0644"},
+	{0xfee1, 0xfee4, "\u0645"},
+	{0xfee5, 0xfee8, "\u0646"},
+	{0xfee9, 0xfeec, "\u0647"},
+	{0xfeed, 0xfeee, "\u0648"},
+	{0xfeef, 0xfef0, "\u0649"},
+	{0xfef1, 0xfef4, "\u064a"},
+	{0xfef5, 0xfef6, "\u0644\u0622"},
+	{0xfef7, 0xfef8, "\u0644\u0623"},
+	{0xfef9, 0xfefa, "\u0644\u0625"},
+	{0xfefb, 0xfefc, "\u0644\u0627"},
+	{0xff0d, 0xff0d, "-"},
+	{0xff0e, 0xff0e, "."},
+	{0xff10, 0xff10, "0"},
+	{0xff11, 0xff11, "1"},
+	{0xff12, 0xff12, "2"},
+	{0xff13, 0xff13, "3"},
+	{0xff14, 0xff14, "4"},
+	{0xff15, 0xff15, "5"},
+	{0xff16, 0xff16, "6"},
+	{0xff17, 0xff17, "7"},
+	{0xff18, 0xff18, "8"},
+	{0xff19, 0xff19, "9"},
+	{0xff21, 0xff21, "a"},
+	{0xff22, 0xff22, "b"},
+	{0xff23, 0xff23, "c"},
+	{0xff24, 0xff24, "d"},
+	{0xff25, 0xff25, "e"},
+	{0xff26, 0xff26, "f"},
+	{0xff27, 0xff27, "g"},
+	{0xff28, 0xff28, "h"},
+	{0xff29, 0xff29, "i"},
+	{0xff2a, 0xff2a, "j"},
+	{0xff2b, 0xff2b, "k"},
+	{0xff2c, 0xff2c, "l"},
+	{0xff2d, 0xff2d, "m"},
+	{0xff2e, 0xff2e, "n"},
+	{0xff2f, 0xff2f, "o"},
+	{0xff30, 0xff30, "p"},
+	{0xff31, 0xff31, "q"},
+	{0xff32, 0xff32, "r"},
+	{0xff33, 0xff33, "s"},
+	{0xff34, 0xff34, "t"},
+	{0xff35, 0xff35, "u"},
+	{0xff36, 0xff36, "v"},
+	{0xff37, 0xff37, "w"},
+	{0xff38, 0xff38, "x"},
+	{0xff39, 0xff39, "y"},
+	{0xff3a, 0xff3a, "z"},
+	{0xff41, 0xff41, "a"},
+	{0xff42, 0xff42, "b"},
+	{0xff43, 0xff43, "c"},
+	{0xff44, 0xff44, "d"},
+	{0xff45, 0xff45, "e"},
+	{0xff46, 0xff46, "f"},
+	{0xff47, 0xff47, "g"},
+	{0xff48, 0xff48, "h"},
+	{0xff49, 0xff49, "i"},
+	{0xff4a, 0xff4a, "j"},
+	{0xff4b, 0xff4b, "k"},
+	{0xff4c, 0xff4c, "l"},
+	{0xff4d, 0xff4d, "m"},
+	{0xff4e, 0xff4e, "n"},
+	{0xff4f, 0xff4f, "o"},
+	{0xff50, 0xff50, "p"},
+	{0xff51, 0xff51, "q"},
+	{0xff52, 0xff52, "r"},
+	{0xff53, 0xff53, "s"},
+	{0xff54, 0xff54, "t"},
+	{0xff55, 0xff55, "u"},
+	{0xff56, 0xff56, "v"},
+	{0xff57, 0xff57, "w"},
+	{0xff58, 0xff58, "x"},
+	{0xff59, 0xff59, "y"},
+	{0xff5a, 0xff5a, "z"},
+	{0xff5f, 0xff5f, "\u2985"},
+	{0xff60, 0xff60, "\u2986"},
+	{0xff61, 0xff61, "."},
+	{0xff62, 0xff62, "\u300c"},
+	{0xff63, 0xff63, "\u300d"},
+	{0xff64, 0xff64, "\u3001"},
+	{0xff65, 0xff65, "\u30fb"},
+	{0xff66, 0xff66, "\u30f2"},
+	{0xff67, 0xff67, "\u30a1"},
+	{0xff68, 0xff68, "\u30a3"},
+	{0xff69, 0xff69, "\u30a5"},
+	{0xff6a, 0xff6a, "\u30a7"},
+	{0xff6b, 0xff6b, "\u30a9"},
+	{0xff6c, 0xff6c, "\u30e3"},
+	{0xff6d, 0xff6d, "\u30e5"},
+	{0xff6e, 0xff6e, "\u30e7"},
+	{0xff6f, 0xff6f, "\u30c3"},
+	{0xff70, 0xff70, "\u30fc"},
+	{0xff71, 0xff71, "\u30a2"},
+	{0xff72, 0xff72, "\u30a4"},
+	{0xff73, 0xff73, "\u30a6"},
+	{0xff74, 0xff74, "\u30a8"},
+	{0xff75, 0xff75, "\u30aa"},
+	{0xff76, 0xff76, "\u30ab"},
+	{0xff77, 0xff77, "\u30ad"},
+	{0xff78, 0xff78, "\u30af"},
+	{0xff79, 0xff79, "\u30b1"},
+	{0xff7a, 0xff7a, "\u30b3"},
+	{0xff7b, 0xff7b, "\u30b5"},
+	{0xff7c, 0xff7c, "\u30b7"},
+	{0xff7d, 0xff7d, "\u30b9"},
+	{0xff7e, 0xff7e, "\u30bb"},
+	{0xff7f, 0xff7f, "\u30bd"},
+	{0xff80, 0xff80, "\u30bf"},
+	{0xff81, 0xff81, "\u30c1"},
+	{0xff82, 0xff82, "\u30c4"},
+	{0xff83, 0xff83, "\u30c6"},
+	{0xff84, 0xff84, "\u30c8"},
+	{0xff85, 0xff85, "\u30ca"},
+	{0xff86, 0xff86, "\u30cb"},
+	{0xff87, 0xff87, "\u30cc"},
+	{0xff88, 0xff88, "\u30cd"},
+	{0xff89, 0xff89, "\u30ce"},
+	{0xff8a, 0xff8a, "\u30cf"},
+	{0xff8b, 0xff8b, "\u30d2"},
+	{0xff8c, 0xff8c, "\u30d5"},
+	{0xff8d, 0xff8d, "\u30d8"},
+	{0xff8e, 0xff8e, "\u30db"},
+	{0xff8f, 0xff8f, "\u30de"},
+	{0xff90, 0xff90, "\u30df"},
+	{0xff91, 0xff91, "\u30e0"},
+	{0xff92, 0xff92, "\u30e1"},
+	{0xff93, 0xff93, "\u30e2"},
+	{0xff94, 0xff94, "\u30e4"},
+	{0xff95, 0xff95, "\u30e6"},
+	{0xff96, 0xff96, "\u30e8"},
+	{0xff97, 0xff97, "\u30e9"},
+	{0xff98, 0xff98, "\u30ea"},
+	{0xff99, 0xff99, "\u30eb"},
+	{0xff9a, 0xff9a, "\u30ec"},
+	{0xff9b, 0xff9b, "\u30ed"},
+	{0xff9c, 0xff9c, "\u30ef"},
+	{0xff9d, 0xff9d, "\u30f3"},
+	{0xff9e, 0xff9e, "\u3099"},
+	{0xff9f, 0xff9f, "\u309a"},
+	{0xffa1, 0xffa1, "\u1100"},
+	{0xffa2, 0xffa2, "\u1101"},
+	{0xffa3, 0xffa3, "\u11aa"},
+	{0xffa4, 0xffa4, "\u1102"},
+	{0xffa5, 0xffa5, "\u11ac"},
+	{0xffa6, 0xffa6, "\u11ad"},
+	{0xffa7, 0xffa7, "\u1103"},
+	{0xffa8, 0xffa8, "\u1104"},
+	{0xffa9, 0xffa9, "\u1105"},
+	{0xffaa, 0xffaa, "\u11b0"},
+	{0xffab, 0xffab, "\u11b1"},
+	{0xffac, 0xffac, "\u11b2"},
+	{0xffad, 0xffad, "\u11b3"},
+	{0xffae, 0xffae, "\u11b4"},
+	{0xffaf, 0xffaf, "\u11b5"},
+	{0xffb0, 0xffb0, "\u111a"},
+	{0xffb1, 0xffb1, "\u1106"},
+	{0xffb2, 0xffb2, "\u1107"},
+	{0xffb3, 0xffb3, "\u1108"},
+	{0xffb4, 0xffb4, "\u1121"},
+	{0xffb5, 0xffb5, "\u1109"},
+	{0xffb6, 0xffb6, "\u110a"},
+	{0xffb7, 0xffb7, "\u110b"},
+	{0xffb8, 0xffb8, "\u110c"},
+	{0xffb9, 0xffb9, "\u110d"},
+	{0xffba, 0xffba, "\u110e"},
+	{0xffbb, 0xffbb, "\u110f"},
+	{0xffbc, 0xffbc, "\u1110"},
+	{0xffbd, 0xffbd, "\u1111"},
+	{0xffbe, 0xffbe, "\u1112"},
+	{0xffc2, 0xffc2, "\u1161"},
+	{0xffc3, 0xffc3, "\u1162"},
+	{0xffc4, 0xffc4, "\u1163"},
+	{0xffc5, 0xffc5, "\u1164"},
+	{0xffc6, 0xffc6, "\u1165"},
+	{0xffc7, 0xffc7, "\u1166"},
+	{0xffca, 0xffca, "\u1167"},
+	{0xffcb, 0xffcb, "\u1168"},
+	{0xffcc, 0xffcc, "\u1169"},
+	{0xffcd, 0xffcd, "\u116a"},
+	{0xffce, 0xffce, "\u116b"},
+	{0xffcf, 0xffcf, "\u116c"},
+	{0xffd2, 0xffd2, "\u116d"},
+	{0xffd3, 0xffd3, "\u116e"},
+	{0xffd4, 0xffd4, "\u116f"},
+	{0xffd5, 0xffd5, "\u1170"},
+	{0xffd6, 0xffd6, "\u1171"},
+	{0xffd7, 0xffd7, "\u1172"},
+	{0xffda, 0xffda, "\u1173"},
+	{0xffdb, 0xffdb, "\u1174"},
+	{0xffdc, 0xffdc, "\u1175"},
+	{0xffe0, 0xffe0, "\u00a2"},
+	{0xffe1, 0xffe1, "\u00a3"},
+	{0xffe2, 0xffe2, "\u00ac"},
+	{0xffe4, 0xffe4, "\u00a6"},
+	{0xffe5, 0xffe5, "\u00a5"},
+	{0xffe6, 0xffe6, "\u20a9"},
+	{0xffe8, 0xffe8, "\u2502"},
+	{0xffe9, 0xffe9, "\u2190"},
+	{0xffea, 0xffea, "\u2191"},
+	{0xffeb, 0xffeb, "\u2192"},
+	{0xffec, 0xffec, "\u2193"},
+	{0xffed, 0xffed, "\u25a0"},
+	{0xffee, 0xffee, "\u25cb"},
+	{0x10400, 0x10400, "\U00010428"},
+	{0x10401, 0x10401, "\U00010429"},
+	{0x10402, 0x10402, "\U0001042a"},
+	{0x10403, 0x10403, "\U0001042b"},
+	{0x10404, 0x10404, "\U0001042c"},
+	{0x10405, 0x10405, "\U0001042d"},
+	{0x10406, 0x10406, "\U0001042e"},
+	{0x10407, 0x10407, "\U0001042f"},
+	{0x10408, 0x10408, "\U00010430"},
+	{0x10409, 0x10409, "\U00010431"},
+	{0x1040a, 0x1040a, "\U00010432"},
+	{0x1040b, 0x1040b, "\U00010433"},
+	{0x1040c, 0x1040c, "\U00010434"},
+	{0x1040d, 0x1040d, "\U00010435"},
+	{0x1040e, 0x1040e, "\U00010436"},
+	{0x1040f, 0x1040f, "\U00010437"},
+	{0x10410, 0x10410, "\U00010438"},
+	{0x10411, 0x10411, "\U00010439"},
+	{0x10412, 0x10412, "\U0001043a"},
+	{0x10413, 0x10413, "\U0001043b"},
+	{0x10414, 0x10414, "\U0001043c"},
+	{0x10415, 0x10415, "\U0001043d"},
+	{0x10416, 0x10416, "\U0001043e"},
+	{0x10417, 0x10417, "\U0001043f"},
+	{0x10418, 0x10418, "\U00010440"},
+	{0x10419, 0x10419, "\U00010441"},
+	{0x1041a, 0x1041a, "\U00010442"},
+	{0x1041b, 0x1041b, "\U00010443"},
+	{0x1041c, 0x1041c, "\U00010444"},
+	{0x1041d, 0x1041d, "\U00010445"},
+	{0x1041e, 0x1041e, "\U00010446"},
+	{0x1041f, 0x1041f, "\U00010447"},
+	{0x10420, 0x10420, "\U00010448"},
+	{0x10421, 0x10421, "\U00010449"},
+	{0x10422, 0x10422, "\U0001044a"},
+	{0x10423, 0x10423, "\U0001044b"},
+	{0x10424, 0x10424, "\U0001044c"},
+	{0x10425, 0x10425, "\U0001044d"},
+	{0x10426, 0x10426, "\U0001044e"},
+	{0x10427, 0x10427, "\U0001044f"},
+	{0x104b0, 0x104b0, "\U000104d8"},
+	{0x104b1, 0x104b1, "\U000104d9"},
+	{0x104b2, 0x104b2, "\U000104da"},
+	{0x104b3, 0x104b3, "\U000104db"},
+	{0x104b4, 0x104b4, "\U000104dc"},
+	{0x104b5, 0x104b5, "\U000104dd"},
+	{0x104b6, 0x104b6, "\U000104de"},
+	{0x104b7, 0x104b7, "\U000104df"},
+	{0x104b8, 0x104b8, "\U000104e0"},
+	{0x104b9, 0x104b9, "\U000104e1"},
+	{0x104ba, 0x104ba, "\U000104e2"},
+	{0x104bb, 0x104bb, "\U000104e3"},
+	{0x104bc, 0x104bc, "\U000104e4"},
+	{0x104bd, 0x104bd, "\U000104e5"},
+	{0x104be, 0x104be, "\U000104e6"},
+	{0x104bf, 0x104bf, "\U000104e7"},
+	{0x104c0, 0x104c0, "\U000104e8"},
+	{0x104c1, 0x104c1, "\U000104e9"},
+	{0x104c2, 0x104c2, "\U000104ea"},
+	{0x104c3, 0x104c3, "\U000104eb"},
+	{0x104c4, 0x104c4, "\U000104ec"},
+	{0x104c5, 0x104c5, "\U000104ed"},
+	{0x104c6, 0x104c6, "\U000104ee"},
+	{0x104c7, 0x104c7, "\U000104ef"},
+	{0x104c8, 0x104c8, "\U000104f0"},
+	{0x104c9, 0x104c9, "\U000104f1"},
+	{0x104ca, 0x104ca, "\U000104f2"},
+	{0x104cb, 0x104cb, "\U000104f3"},
+	{0x104cc, 0x104cc, "\U000104f4"},
+	{0x104cd, 0x104cd, "\U000104f5"},
+	{0x104ce, 0x104ce, "\U000104f6"},
+	{0x104cf, 0x104cf, "\U000104f7"},
+	{0x104d0, 0x104d0, "\U000104f8"},
+	{0x104d1, 0x104d1, "\U000104f9"},
+	{0x104d2, 0x104d2, "\U000104fa"},
+	{0x104d3, 0x104d3, "\U000104fb"},
+	{0x10570, 0x10570, "\U00010597"},
+	{0x10571, 0x10571, "\U00010598"},
+	{0x10572, 0x10572, "\U00010599"},
+	{0x10573, 0x10573, "\U0001059a"},
+	{0x10574, 0x10574, "\U0001059b"},
+	{0x10575, 0x10575, "\U0001059c"},
+	{0x10576, 0x10576, "\U0001059d"},
+	{0x10577, 0x10577, "\U0001059e"},
+	{0x10578, 0x10578, "\U0001059f"},
+	{0x10579, 0x10579, "\U000105a0"},
+	{0x1057a, 0x1057a, "\U000105a1"},
+	{0x1057c, 0x1057c, "\U000105a3"},
+	{0x1057d, 0x1057d, "\U000105a4"},
+	{0x1057e, 0x1057e, "\U000105a5"},
+	{0x1057f, 0x1057f, "\U000105a6"},
+	{0x10580, 0x10580, "\U000105a7"},
+	{0x10581, 0x10581, "\U000105a8"},
+	{0x10582, 0x10582, "\U000105a9"},
+	{0x10583, 0x10583, "\U000105aa"},
+	{0x10584, 0x10584, "\U000105ab"},
+	{0x10585, 0x10585, "\U000105ac"},
+	{0x10586, 0x10586, "\U000105ad"},
+	{0x10587, 0x10587, "\U000105ae"},
+	{0x10588, 0x10588, "\U000105af"},
+	{0x10589, 0x10589, "\U000105b0"},
+	{0x1058a, 0x1058a, "\U000105b1"},
+	{0x1058c, 0x1058c, "\U000105b3"},
+	{0x1058d, 0x1058d, "\U000105b4"},
+	{0x1058e, 0x1058e, "\U000105b5"},
+	{0x1058f, 0x1058f, "\U000105b6"},
+	{0x10590, 0x10590, "\U000105b7"},
+	{0x10591, 0x10591, "\U000105b8"},
+	{0x10592, 0x10592, "\U000105b9"},
+	{0x10594, 0x10594, "\U000105bb"},
+	{0x10595, 0x10595, "\U000105bc"},
+	{0x10781, 0x10781, "\u02d0"},
+	{0x10782, 0x10782, "\u02d1"},
+	{0x10783, 0x10783, "\u00e6"},
+	{0x10784, 0x10784, "\u0299"},
+	{0x10785, 0x10785, "\u0253"},
+	{0x10787, 0x10787, "\u02a3"},
+	{0x10788, 0x10788, "\uab66"},
+	{0x10789, 0x10789, "\u02a5"},
+	{0x1078a, 0x1078a, "\u02a4"},
+	{0x1078b, 0x1078b, "\u0256"},
+	{0x1078c, 0x1078c, "\u0257"},
+	{0x1078d, 0x1078d, "\u1d91"},
+	{0x1078e, 0x1078e, "\u0258"},
+	{0x1078f, 0x1078f, "\u025e"},
+	{0x10790, 0x10790, "\u02a9"},
+	{0x10791, 0x10791, "\u0264"},
+	{0x10792, 0x10792, "\u0262"},
+	{0x10793, 0x10793, "\u0260"},
+	{0x10794, 0x10794, "\u029b"},
+	{0x10795, 0x10795, "\u0127"},
+	{0x10796, 0x10796, "\u029c"},
+	{0x10797, 0x10797, "\u0267"},
+	{0x10798, 0x10798, "\u0284"},
+	{0x10799, 0x10799, "\u02aa"},
+	{0x1079a, 0x1079a, "\u02ab"},
+	{0x1079b, 0x1079b, "\u026c"},
+	{0x1079c, 0x1079c, "\U0001df04"},
+	{0x1079d, 0x1079d, "\ua78e"},
+	{0x1079e, 0x1079e, "\u026e"},
+	{0x1079f, 0x1079f, "\U0001df05"},
+	{0x107a0, 0x107a0, "\u028e"},
+	{0x107a1, 0x107a1, "\U0001df06"},
+	{0x107a2, 0x107a2, "\u00f8"},
+	{0x107a3, 0x107a3, "\u0276"},
+	{0x107a4, 0x107a4, "\u0277"},
+	{0x107a5, 0x107a5, "q"},
+	{0x107a6, 0x107a6, "\u027a"},
+	{0x107a7, 0x107a7, "\U0001df08"},
+	{0x107a8, 0x107a8, "\u027d"},
+	{0x107a9, 0x107a9, "\u027e"},
+	{0x107aa, 0x107aa, "\u0280"},
+	{0x107ab, 0x107ab, "\u02a8"},
+	{0x107ac, 0x107ac, "\u02a6"},
+	{0x107ad, 0x107ad, "\uab67"},
+	{0x107ae, 0x107ae, "\u02a7"},
+	{0x107af, 0x107af, "\u0288"},
+	{0x107b0, 0x107b0, "\u2c71"},
+	{0x107b2, 0x107b2, "\u028f"},
+	{0x107b3, 0x107b3, "\u02a1"},
+	{0x107b4, 0x107b4, "\u02a2"},
+	{0x107b5, 0x107b5, "\u0298"},
+	{0x107b6, 0x107b6, "\u01c0"},
+	{0x107b7, 0x107b7, "\u01c1"},
+	{0x107b8, 0x107b8, "\u01c2"},
+	{0x107b9, 0x107b9, "\U0001df0a"},
+	{0x107ba, 0x107ba, "\U0001df1e"},
+	{0x10c80, 0x10c80, "\U00010cc0"},
+	{0x10c81, 0x10c81, "\U00010cc1"},
+	{0x10c82, 0x10c82, "\U00010cc2"},
+	{0x10c83, 0x10c83, "\U00010cc3"},
+	{0x10c84, 0x10c84, "\U00010cc4"},
+	{0x10c85, 0x10c85, "\U00010cc5"},
+	{0x10c86, 0x10c86, "\U00010cc6"},
+	{0x10c87, 0x10c87, "\U00010cc7"},
+	{0x10c88, 0x10c88, "\U00010cc8"},
+	{0x10c89, 0x10c89, "\U00010cc9"},
+	{0x10c8a, 0x10c8a, "\U00010cca"},
+	{0x10c8b, 0x10c8b, "\U00010ccb"},
+	{0x10c8c, 0x10c8c, "\U00010ccc"},
+	{0x10c8d, 0x10c8d, "\U00010ccd"},
+	{0x10c8e, 0x10c8e, "\U00010cce"},
+	{0x10c8f, 0x10c8f, "\U00010ccf"},
+	{0x10c90, 0x10c90, "\U00010cd0"},
+	{0x10c91, 0x10c91, "\U00010cd1"},
+	{0x10c92, 0x10c92, "\U00010cd2"},
+	{0x10c93, 0x10c93, "\U00010cd3"},
+	{0x10c94, 0x10c94, "\U00010cd4"},
+	{0x10c95, 0x10c95, "\U00010cd5"},
+	{0x10c96, 0x10c96, "\U00010cd6"},
+	{0x10c97, 0x10c97, "\U00010cd7"},
+	{0x10c98, 0x10c98, "\U00010cd8"},
+	{0x10c99, 0x10c99, "\U00010cd9"},
+	{0x10c9a, 0x10c9a, "\U00010cda"},
+	{0x10c9b, 0x10c9b, "\U00010cdb"},
+	{0x10c9c, 0x10c9c, "\U00010cdc"},
+	{0x10c9d, 0x10c9d, "\U00010cdd"},
+	{0x10c9e, 0x10c9e, "\U00010cde"},
+	{0x10c9f, 0x10c9f, "\U00010cdf"},
+	{0x10ca0, 0x10ca0, "\U00010ce0"},
+	{0x10ca1, 0x10ca1, "\U00010ce1"},
+	{0x10ca2, 0x10ca2, "\U00010ce2"},
+	{0x10ca3, 0x10ca3, "\U00010ce3"},
+	{0x10ca4, 0x10ca4, "\U00010ce4"},
+	{0x10ca5, 0x10ca5, "\U00010ce5"},
+	{0x10ca6, 0x10ca6, "\U00010ce6"},
+	{0x10ca7, 0x10ca7, "\U00010ce7"},
+	{0x10ca8, 0x10ca8, "\U00010ce8"},
+	{0x10ca9, 0x10ca9, "\U00010ce9"},
+	{0x10caa, 0x10caa, "\U00010cea"},
+	{0x10cab, 0x10cab, "\U00010ceb"},
+	{0x10cac, 0x10cac, "\U00010cec"},
+	{0x10cad, 0x10cad, "\U00010ced"},
+	{0x10cae, 0x10cae, "\U00010cee"},
+	{0x10caf, 0x10caf, "\U00010cef"},
+	{0x10cb0, 0x10cb0, "\U00010cf0"},
+	{0x10cb1, 0x10cb1, "\U00010cf1"},
+	{0x10cb2, 0x10cb2, "\U00010cf2"},
+	{0x118a0, 0x118a0, "\U000118c0"},
+	{0x118a1, 0x118a1, "\U000118c1"},
+	{0x118a2, 0x118a2, "\U000118c2"},
+	{0x118a3, 0x118a3, "\U000118c3"},
+	{0x118a4, 0x118a4, "\U000118c4"},
+	{0x118a5, 0x118a5, "\U000118c5"},
+	{0x118a6, 0x118a6, "\U000118c6"},
+	{0x118a7, 0x118a7, "\U000118c7"},
+	{0x118a8, 0x118a8, "\U000118c8"},
+	{0x118a9, 0x118a9, "\U000118c9"},
+	{0x118aa, 0x118aa, "\U000118ca"},
+	{0x118ab, 0x118ab, "\U000118cb"},
+	{0x118ac, 0x118ac, "\U000118cc"},
+	{0x118ad, 0x118ad, "\U000118cd"},
+	{0x118ae, 0x118ae, "\U000118ce"},
+	{0x118af, 0x118af, "\U000118cf"},
+	{0x118b0, 0x118b0, "\U000118d0"},
+	{0x118b1, 0x118b1, "\U000118d1"},
+	{0x118b2, 0x118b2, "\U000118d2"},
+	{0x118b3, 0x118b3, "\U000118d3"},
+	{0x118b4, 0x118b4, "\U000118d4"},
+	{0x118b5, 0x118b5, "\U000118d5"},
+	{0x118b6, 0x118b6, "\U000118d6"},
+	{0x118b7, 0x118b7, "\U000118d7"},
+	{0x118b8, 0x118b8, "\U000118d8"},
+	{0x118b9, 0x118b9, "\U000118d9"},
+	{0x118ba, 0x118ba, "\U000118da"},
+	{0x118bb, 0x118bb, "\U000118db"},
+	{0x118bc, 0x118bc, "\U000118dc"},
+	{0x118bd, 0x118bd, "\U000118dd"},
+	{0x118be, 0x118be, "\U000118de"},
+	{0x118bf, 0x118bf, "\U000118df"},
+	{0x16e40, 0x16e40, "\U00016e60"},
+	{0x16e41, 0x16e41, "\U00016e61"},
+	{0x16e42, 0x16e42, "\U00016e62"},
+	{0x16e43, 0x16e43, "\U00016e63"},
+	{0x16e44, 0x16e44, "\U00016e64"},
+	{0x16e45, 0x16e45, "\U00016e65"},
+	{0x16e46, 0x16e46, "\U00016e66"},
+	{0x16e47, 0x16e47, "\U00016e67"},
+	{0x16e48, 0x16e48, "\U00016e68"},
+	{0x16e49, 0x16e49, "\U00016e69"},
+	{0x16e4a, 0x16e4a, "\U00016e6a"},
+	{0x16e4b, 0x16e4b, "\U00016e6b"},
+	{0x16e4c, 0x16e4c, "\U00016e6c"},
+	{0x16e4d, 0x16e4d, "\U00016e6d"},
+	{0x16e4e, 0x16e4e, "\U00016e6e"},
+	{0x16e4f, 0x16e4f, "\U00016e6f"},
+	{0x16e50, 0x16e50, "\U00016e70"},
+	{0x16e51, 0x16e51, "\U00016e71"},
+	{0x16e52, 0x16e52, "\U00016e72"},
+	{0x16e53, 0x16e53, "\U00016e73"},
+	{0x16e54, 0x16e54, "\U00016e74"},
+	{0x16e55, 0x16e55, "\U00016e75"},
+	{0x16e56, 0x16e56, "\U00016e76"},
+	{0x16e57, 0x16e57, "\U00016e77"},
+	{0x16e58, 0x16e58, "\U00016e78"},
+	{0x16e59, 0x16e59, "\U00016e79"},
+	{0x16e5a, 0x16e5a, "\U00016e7a"},
+	{0x16e5b, 0x16e5b, "\U00016e7b"},
+	{0x16e5c, 0x16e5c, "\U00016e7c"},
+	{0x16e5d, 0x16e5d, "\U00016e7d"},
+	{0x16e5e, 0x16e5e, "\U00016e7e"},
+	{0x16e5f, 0x16e5f, "\U00016e7f"},
+	{0x1d15e, 0x1d15e, "\U0001d157\U0001d165"},
+	{0x1d15f, 0x1d15f, "\U0001d158\U0001d165"},
+	{0x1d160, 0x1d160, "\U0001d158\U0001d165\U0001d16e"},
+	{0x1d161, 0x1d161, "\U0001d158\U0001d165\U0001d16f"},
+	{0x1d162, 0x1d162, "\U0001d158\U0001d165\U0001d170"},
+	{0x1d163, 0x1d163, "\U0001d158\U0001d165\U0001d171"},
+	{0x1d164, 0x1d164, "\U0001d158\U0001d165\U0001d172"},
+	{0x1d1bb, 0x1d1bb, "\U0001d1b9\U0001d165"},
+	{0x1d1bc, 0x1d1bc, "\U0001d1ba\U0001d165"},
+	{0x1d1bd, 0x1d1bd, "\U0001d1b9\U0001d165\U0001d16e"},
+	{0x1d1be, 0x1d1be, "\U0001d1ba\U0001d165\U0001d16e"},
+	{0x1d1bf, 0x1d1bf, "\U0001d1b9\U0001d165\U0001d16f"},
+	{0x1d1c0, 0x1d1c0, "\U0001d1ba\U0001d165\U0001d16f"},
+	{0x1d400, 0x1d400, "a"},
+	{0x1d401, 0x1d401, "b"},
+	{0x1d402, 0x1d402, "c"},
+	{0x1d403, 0x1d403, "d"},
+	{0x1d404, 0x1d404, "e"},
+	{0x1d405, 0x1d405, "f"},
+	{0x1d406, 0x1d406, "g"},
+	{0x1d407, 0x1d407, "h"},
+	{0x1d408, 0x1d408, "i"},
+	{0x1d409, 0x1d409, "j"},
+	{0x1d40a, 0x1d40a, "k"},
+	{0x1d40b, 0x1d40b, "l"},
+	{0x1d40c, 0x1d40c, "m"},
+	{0x1d40d, 0x1d40d, "n"},
+	{0x1d40e, 0x1d40e, "o"},
+	{0x1d40f, 0x1d40f, "p"},
+	{0x1d410, 0x1d410, "q"},
+	{0x1d411, 0x1d411, "r"},
+	{0x1d412, 0x1d412, "s"},
+	{0x1d413, 0x1d413, "t"},
+	{0x1d414, 0x1d414, "u"},
+	{0x1d415, 0x1d415, "v"},
+	{0x1d416, 0x1d416, "w"},
+	{0x1d417, 0x1d417, "x"},
+	{0x1d418, 0x1d418, "y"},
+	{0x1d419, 0x1d419, "z"},
+	{0x1d41a, 0x1d41a, "a"},
+	{0x1d41b, 0x1d41b, "b"},
+	{0x1d41c, 0x1d41c, "c"},
+	{0x1d41d, 0x1d41d, "d"},
+	{0x1d41e, 0x1d41e, "e"},
+	{0x1d41f, 0x1d41f, "f"},
+	{0x1d420, 0x1d420, "g"},
+	{0x1d421, 0x1d421, "h"},
+	{0x1d422, 0x1d422, "i"},
+	{0x1d423, 0x1d423, "j"},
+	{0x1d424, 0x1d424, "k"},
+	{0x1d425, 0x1d425, "l"},
+	{0x1d426, 0x1d426, "m"},
+	{0x1d427, 0x1d427, "n"},
+	{0x1d428, 0x1d428, "o"},
+	{0x1d429, 0x1d429, "p"},
+	{0x1d42a, 0x1d42a, "q"},
+	{0x1d42b, 0x1d42b, "r"},
+	{0x1d42c, 0x1d42c, "s"},
+	{0x1d42d, 0x1d42d, "t"},
+	{0x1d42e, 0x1d42e, "u"},
+	{0x1d42f, 0x1d42f, "v"},
+	{0x1d430, 0x1d430, "w"},
+	{0x1d431, 0x1d431, "x"},
+	{0x1d432, 0x1d432, "y"},
+	{0x1d433, 0x1d433, "z"},
+	{0x1d434, 0x1d434, "a"},
+	{0x1d435, 0x1d435, "b"},
+	{0x1d436, 0x1d436, "c"},
+	{0x1d437, 0x1d437, "d"},
+	{0x1d438, 0x1d438, "e"},
+	{0x1d439, 0x1d439, "f"},
+	{0x1d43a, 0x1d43a, "g"},
+	{0x1d43b, 0x1d43b, "h"},
+	{0x1d43c, 0x1d43c, "i"},
+	{0x1d43d, 0x1d43d, "j"},
+	{0x1d43e, 0x1d43e, "k"},
+	{0x1d43f, 0x1d43f, "l"},
+	{0x1d440, 0x1d440, "m"},
+	{0x1d441, 0x1d441, "n"},
+	{0x1d442, 0x1d442, "o"},
+	{0x1d443, 0x1d443, "p"},
+	{0x1d444, 0x1d444, "q"},
+	{0x1d445, 0x1d445, "r"},
+	{0x1d446, 0x1d446, "s"},
+	{0x1d447, 0x1d447, "t"},
+	{0x1d448, 0x1d448, "u"},
+	{0x1d449, 0x1d449, "v"},
+	{0x1d44a, 0x1d44a, "w"},
+	{0x1d44b, 0x1d44b, "x"},
+	{0x1d44c, 0x1d44c, "y"},
+	{0x1d44d, 0x1d44d, "z"},
+	{0x1d44e, 0x1d44e, "a"},
+	{0x1d44f, 0x1d44f, "b"},
+	{0x1d450, 0x1d450, "c"},
+	{0x1d451, 0x1d451, "d"},
+	{0x1d452, 0x1d452, "e"},
+	{0x1d453, 0x1d453, "f"},
+	{0x1d454, 0x1d454, "g"},
+	{0x1d456, 0x1d456, "i"},
+	{0x1d457, 0x1d457, "j"},
+	{0x1d458, 0x1d458, "k"},
+	{0x1d459, 0x1d459, "l"},
+	{0x1d45a, 0x1d45a, "m"},
+	{0x1d45b, 0x1d45b, "n"},
+	{0x1d45c, 0x1d45c, "o"},
+	{0x1d45d, 0x1d45d, "p"},
+	{0x1d45e, 0x1d45e, "q"},
+	{0x1d45f, 0x1d45f, "r"},
+	{0x1d460, 0x1d460, "s"},
+	{0x1d461, 0x1d461, "t"},
+	{0x1d462, 0x1d462, "u"},
+	{0x1d463, 0x1d463, "v"},
+	{0x1d464, 0x1d464, "w"},
+	{0x1d465, 0x1d465, "x"},
+	{0x1d466, 0x1d466, "y"},
+	{0x1d467, 0x1d467, "z"},
+	{0x1d468, 0x1d468, "a"},
+	{0x1d469, 0x1d469, "b"},
+	{0x1d46a, 0x1d46a, "c"},
+	{0x1d46b, 0x1d46b, "d"},
+	{0x1d46c, 0x1d46c, "e"},
+	{0x1d46d, 0x1d46d, "f"},
+	{0x1d46e, 0x1d46e, "g"},
+	{0x1d46f, 0x1d46f, "h"},
+	{0x1d470, 0x1d470, "i"},
+	{0x1d471, 0x1d471, "j"},
+	{0x1d472, 0x1d472, "k"},
+	{0x1d473, 0x1d473, "l"},
+	{0x1d474, 0x1d474, "m"},
+	{0x1d475, 0x1d475, "n"},
+	{0x1d476, 0x1d476, "o"},
+	{0x1d477, 0x1d477, "p"},
+	{0x1d478, 0x1d478, "q"},
+	{0x1d479, 0x1d479, "r"},
+	{0x1d47a, 0x1d47a, "s"},
+	{0x1d47b, 0x1d47b, "t"},
+	{0x1d47c, 0x1d47c, "u"},
+	{0x1d47d, 0x1d47d, "v"},
+	{0x1d47e, 0x1d47e, "w"},
+	{0x1d47f, 0x1d47f, "x"},
+	{0x1d480, 0x1d480, "y"},
+	{0x1d481, 0x1d481, "z"},
+	{0x1d482, 0x1d482, "a"},
+	{0x1d483, 0x1d483, "b"},
+	{0x1d484, 0x1d484, "c"},
+	{0x1d485, 0x1d485, "d"},
+	{0x1d486, 0x1d486, "e"},
+	{0x1d487, 0x1d487, "f"},
+	{0x1d488, 0x1d488, "g"},
+	{0x1d489, 0x1d489, "h"},
+	{0x1d48a, 0x1d48a, "i"},
+	{0x1d48b, 0x1d48b, "j"},
+	{0x1d48c, 0x1d48c, "k"},
+	{0x1d48d, 0x1d48d, "l"},
+	{0x1d48e, 0x1d48e, "m"},
+	{0x1d48f, 0x1d48f, "n"},
+	{0x1d490, 0x1d490, "o"},
+	{0x1d491, 0x1d491, "p"},
+	{0x1d492, 0x1d492, "q"},
+	{0x1d493, 0x1d493, "r"},
+	{0x1d494, 0x1d494, "s"},
+	{0x1d495, 0x1d495, "t"},
+	{0x1d496, 0x1d496, "u"},
+	{0x1d497, 0x1d497, "v"},
+	{0x1d498, 0x1d498, "w"},
+	{0x1d499, 0x1d499, "x"},
+	{0x1d49a, 0x1d49a, "y"},
+	{0x1d49b, 0x1d49b, "z"},
+	{0x1d49c, 0x1d49c, "a"},
+	{0x1d49e, 0x1d49e, "c"},
+	{0x1d49f, 0x1d49f, "d"},
+	{0x1d4a2, 0x1d4a2, "g"},
+	{0x1d4a5, 0x1d4a5, "j"},
+	{0x1d4a6, 0x1d4a6, "k"},
+	{0x1d4a9, 0x1d4a9, "n"},
+	{0x1d4aa, 0x1d4aa, "o"},
+	{0x1d4ab, 0x1d4ab, "p"},
+	{0x1d4ac, 0x1d4ac, "q"},
+	{0x1d4ae, 0x1d4ae, "s"},
+	{0x1d4af, 0x1d4af, "t"},
+	{0x1d4b0, 0x1d4b0, "u"},
+	{0x1d4b1, 0x1d4b1, "v"},
+	{0x1d4b2, 0x1d4b2, "w"},
+	{0x1d4b3, 0x1d4b3, "x"},
+	{0x1d4b4, 0x1d4b4, "y"},
+	{0x1d4b5, 0x1d4b5, "z"},
+	{0x1d4b6, 0x1d4b6, "a"},
+	{0x1d4b7, 0x1d4b7, "b"},
+	{0x1d4b8, 0x1d4b8, "c"},
+	{0x1d4b9, 0x1d4b9, "d"},
+	{0x1d4bb, 0x1d4bb, "f"},
+	{0x1d4bd, 0x1d4bd, "h"},
+	{0x1d4be, 0x1d4be, "i"},
+	{0x1d4bf, 0x1d4bf, "j"},
+	{0x1d4c0, 0x1d4c0, "k"},
+	{0x1d4c1, 0x1d4c1, "l"},
+	{0x1d4c2, 0x1d4c2, "m"},
+	{0x1d4c3, 0x1d4c3, "n"},
+	{0x1d4c5, 0x1d4c5, "p"},
+	{0x1d4c6, 0x1d4c6, "q"},
+	{0x1d4c7, 0x1d4c7, "r"},
+	{0x1d4c8, 0x1d4c8, "s"},
+	{0x1d4c9, 0x1d4c9, "t"},
+	{0x1d4ca, 0x1d4ca, "u"},
+	{0x1d4cb, 0x1d4cb, "v"},
+	{0x1d4cc, 0x1d4cc, "w"},
+	{0x1d4cd, 0x1d4cd, "x"},
+	{0x1d4ce, 0x1d4ce, "y"},
+	{0x1d4cf, 0x1d4cf, "z"},
+	{0x1d4d0, 0x1d4d0, "a"},
+	{0x1d4d1, 0x1d4d1, "b"},
+	{0x1d4d2, 0x1d4d2, "c"},
+	{0x1d4d3, 0x1d4d3, "d"},
+	{0x1d4d4, 0x1d4d4, "e"},
+	{0x1d4d5, 0x1d4d5, "f"},
+	{0x1d4d6, 0x1d4d6, "g"},
+	{0x1d4d7, 0x1d4d7, "h"},
+	{0x1d4d8, 0x1d4d8, "i"},
+	{0x1d4d9, 0x1d4d9, "j"},
+	{0x1d4da, 0x1d4da, "k"},
+	{0x1d4db, 0x1d4db, "l"},
+	{0x1d4dc, 0x1d4dc, "m"},
+	{0x1d4dd, 0x1d4dd, "n"},
+	{0x1d4de, 0x1d4de, "o"},
+	{0x1d4df, 0x1d4df, "p"},
+	{0x1d4e0, 0x1d4e0, "q"},
+	{0x1d4e1, 0x1d4e1, "r"},
+	{0x1d4e2, 0x1d4e2, "s"},
+	{0x1d4e3, 0x1d4e3, "t"},
+	{0x1d4e4, 0x1d4e4, "u"},
+	{0x1d4e5, 0x1d4e5, "v"},
+	{0x1d4e6, 0x1d4e6, "w"},
+	{0x1d4e7, 0x1d4e7, "x"},
+	{0x1d4e8, 0x1d4e8, "y"},
+	{0x1d4e9, 0x1d4e9, "z"},
+	{0x1d4ea, 0x1d4ea, "a"},
+	{0x1d4eb, 0x1d4eb, "b"},
+	{0x1d4ec, 0x1d4ec, "c"},
+	{0x1d4ed, 0x1d4ed, "d"},
+	{0x1d4ee, 0x1d4ee, "e"},
+	{0x1d4ef, 0x1d4ef, "f"},
+	{0x1d4f0, 0x1d4f0, "g"},
+	{0x1d4f1, 0x1d4f1, "h"},
+	{0x1d4f2, 0x1d4f2, "i"},
+	{0x1d4f3, 0x1d4f3, "j"},
+	{0x1d4f4, 0x1d4f4, "k"},
+	{0x1d4f5, 0x1d4f5, "l"},
+	{0x1d4f6, 0x1d4f6, "m"},
+	{0x1d4f7, 0x1d4f7, "n"},
+	{0x1d4f8, 0x1d4f8, "o"},
+	{0x1d4f9, 0x1d4f9, "p"},
+	{0x1d4fa, 0x1d4fa, "q"},
+	{0x1d4fb, 0x1d4fb, "r"},
+	{0x1d4fc, 0x1d4fc, "s"},
+	{0x1d4fd, 0x1d4fd, "t"},
+	{0x1d4fe, 0x1d4fe, "u"},
+	{0x1d4ff, 0x1d4ff, "v"},
+	{0x1d500, 0x1d500, "w"},
+	{0x1d501, 0x1d501, "x"},
+	{0x1d502, 0x1d502, "y"},
+	{0x1d503, 0x1d503, "z"},
+	{0x1d504, 0x1d504, "a"},
+	{0x1d505, 0x1d505, "b"},
+	{0x1d507, 0x1d507, "d"},
+	{0x1d508, 0x1d508, "e"},
+	{0x1d509, 0x1d509, "f"},
+	{0x1d50a, 0x1d50a, "g"},
+	{0x1d50d, 0x1d50d, "j"},
+	{0x1d50e, 0x1d50e, "k"},
+	{0x1d50f, 0x1d50f, "l"},
+	{0x1d510, 0x1d510, "m"},
+	{0x1d511, 0x1d511, "n"},
+	{0x1d512, 0x1d512, "o"},
+	{0x1d513, 0x1d513, "p"},
+	{0x1d514, 0x1d514, "q"},
+	{0x1d516, 0x1d516, "s"},
+	{0x1d517, 0x1d517, "t"},
+	{0x1d518, 0x1d518, "u"},
+	{0x1d519, 0x1d519, "v"},
+	{0x1d51a, 0x1d51a, "w"},
+	{0x1d51b, 0x1d51b, "x"},
+	{0x1d51c, 0x1d51c, "y"},
+	{0x1d51e, 0x1d51e, "a"},
+	{0x1d51f, 0x1d51f, "b"},
+	{0x1d520, 0x1d520, "c"},
+	{0x1d521, 0x1d521, "d"},
+	{0x1d522, 0x1d522, "e"},
+	{0x1d523, 0x1d523, "f"},
+	{0x1d524, 0x1d524, "g"},
+	{0x1d525, 0x1d525, "h"},
+	{0x1d526, 0x1d526, "i"},
+	{0x1d527, 0x1d527, "j"},
+	{0x1d528, 0x1d528, "k"},
+	{0x1d529, 0x1d529, "l"},
+	{0x1d52a, 0x1d52a, "m"},
+	{0x1d52b, 0x1d52b, "n"},
+	{0x1d52c, 0x1d52c, "o"},
+	{0x1d52d, 0x1d52d, "p"},
+	{0x1d52e, 0x1d52e, "q"},
+	{0x1d52f, 0x1d52f, "r"},
+	{0x1d530, 0x1d530, "s"},
+	{0x1d531, 0x1d531, "t"},
+	{0x1d532, 0x1d532, "u"},
+	{0x1d533, 0x1d533, "v"},
+	{0x1d534, 0x1d534, "w"},
+	{0x1d535, 0x1d535, "x"},
+	{0x1d536, 0x1d536, "y"},
+	{0x1d537, 0x1d537, "z"},
+	{0x1d538, 0x1d538, "a"},
+	{0x1d539, 0x1d539, "b"},
+	{0x1d53b, 0x1d53b, "d"},
+	{0x1d53c, 0x1d53c, "e"},
+	{0x1d53d, 0x1d53d, "f"},
+	{0x1d53e, 0x1d53e, "g"},
+	{0x1d540, 0x1d540, "i"},
+	{0x1d541, 0x1d541, "j"},
+	{0x1d542, 0x1d542, "k"},
+	{0x1d543, 0x1d543, "l"},
+	{0x1d544, 0x1d544, "m"},
+	{0x1d546, 0x1d546, "o"},
+	{0x1d54a, 0x1d54a, "s"},
+	{0x1d54b, 0x1d54b, "t"},
+	{0x1d54c, 0x1d54c, "u"},
+	{0x1d54d, 0x1d54d, "v"},
+	{0x1d54e, 0x1d54e, "w"},
+	{0x1d54f, 0x1d54f, "x"},
+	{0x1d550, 0x1d550, "y"},
+	{0x1d552, 0x1d552, "a"},
+	{0x1d553, 0x1d553, "b"},
+	{0x1d554, 0x1d554, "c"},
+	{0x1d555, 0x1d555, "d"},
+	{0x1d556, 0x1d556, "e"},
+	{0x1d557, 0x1d557, "f"},
+	{0x1d558, 0x1d558, "g"},
+	{0x1d559, 0x1d559, "h"},
+	{0x1d55a, 0x1d55a, "i"},
+	{0x1d55b, 0x1d55b, "j"},
+	{0x1d55c, 0x1d55c, "k"},
+	{0x1d55d, 0x1d55d, "l"},
+	{0x1d55e, 0x1d55e, "m"},
+	{0x1d55f, 0x1d55f, "n"},
+	{0x1d560, 0x1d560, "o"},
+	{0x1d561, 0x1d561, "p"},
+	{0x1d562, 0x1d562, "q"},
+	{0x1d563, 0x1d563, "r"},
+	{0x1d564, 0x1d564, "s"},
+	{0x1d565, 0x1d565, "t"},
+	{0x1d566, 0x1d566, "u"},
+	{0x1d567, 0x1d567, "v"},
+	{0x1d568, 0x1d568, "w"},
+	{0x1d569, 0x1d569, "x"},
+	{0x1d56a, 0x1d56a, "y"},
+	{0x1d56b, 0x1d56b, "z"},
+	{0x1d56c, 0x1d56c, "a"},
+	{0x1d56d, 0x1d56d, "b"},
+	{0x1d56e, 0x1d56e, "c"},
+	{0x1d56f, 0x1d56f, "d"},
+	{0x1d570, 0x1d570, "e"},
+	{0x1d571, 0x1d571, "f"},
+	{0x1d572, 0x1d572, "g"},
+	{0x1d573, 0x1d573, "h"},
+	{0x1d574, 0x1d574, "i"},
+	{0x1d575, 0x1d575, "j"},
+	{0x1d576, 0x1d576, "k"},
+	{0x1d577, 0x1d577, "l"},
+	{0x1d578, 0x1d578, "m"},
+	{0x1d579, 0x1d579, "n"},
+	{0x1d57a, 0x1d57a, "o"},
+	{0x1d57b, 0x1d57b, "p"},
+	{0x1d57c, 0x1d57c, "q"},
+	{0x1d57d, 0x1d57d, "r"},
+	{0x1d57e, 0x1d57e, "s"},
+	{0x1d57f, 0x1d57f, "t"},
+	{0x1d580, 0x1d580, "u"},
+	{0x1d581, 0x1d581, "v"},
+	{0x1d582, 0x1d582, "w"},
+	{0x1d583, 0x1d583, "x"},
+	{0x1d584, 0x1d584, "y"},
+	{0x1d585, 0x1d585, "z"},
+	{0x1d586, 0x1d586, "a"},
+	{0x1d587, 0x1d587, "b"},
+	{0x1d588, 0x1d588, "c"},
+	{0x1d589, 0x1d589, "d"},
+	{0x1d58a, 0x1d58a, "e"},
+	{0x1d58b, 0x1d58b, "f"},
+	{0x1d58c, 0x1d58c, "g"},
+	{0x1d58d, 0x1d58d, "h"},
+	{0x1d58e, 0x1d58e, "i"},
+	{0x1d58f, 0x1d58f, "j"},
+	{0x1d590, 0x1d590, "k"},
+	{0x1d591, 0x1d591, "l"},
+	{0x1d592, 0x1d592, "m"},
+	{0x1d593, 0x1d593, "n"},
+	{0x1d594, 0x1d594, "o"},
+	{0x1d595, 0x1d595, "p"},
+	{0x1d596, 0x1d596, "q"},
+	{0x1d597, 0x1d597, "r"},
+	{0x1d598, 0x1d598, "s"},
+	{0x1d599, 0x1d599, "t"},
+	{0x1d59a, 0x1d59a, "u"},
+	{0x1d59b, 0x1d59b, "v"},
+	{0x1d59c, 0x1d59c, "w"},
+	{0x1d59d, 0x1d59d, "x"},
+	{0x1d59e, 0x1d59e, "y"},
+	{0x1d59f, 0x1d59f, "z"},
+	{0x1d5a0, 0x1d5a0, "a"},
+	{0x1d5a1, 0x1d5a1, "b"},
+	{0x1d5a2, 0x1d5a2, "c"},
+	{0x1d5a3, 0x1d5a3, "d"},
+	{0x1d5a4, 0x1d5a4, "e"},
+	{0x1d5a5, 0x1d5a5, "f"},
+	{0x1d5a6, 0x1d5a6, "g"},
+	{0x1d5a7, 0x1d5a7, "h"},
+	{0x1d5a8, 0x1d5a8, "i"},
+	{0x1d5a9, 0x1d5a9, "j"},
+	{0x1d5aa, 0x1d5aa, "k"},
+	{0x1d5ab, 0x1d5ab, "l"},
+	{0x1d5ac, 0x1d5ac, "m"},
+	{0x1d5ad, 0x1d5ad, "n"},
+	{0x1d5ae, 0x1d5ae, "o"},
+	{0x1d5af, 0x1d5af, "p"},
+	{0x1d5b0, 0x1d5b0, "q"},
+	{0x1d5b1, 0x1d5b1, "r"},
+	{0x1d5b2, 0x1d5b2, "s"},
+	{0x1d5b3, 0x1d5b3, "t"},
+	{0x1d5b4, 0x1d5b4, "u"},
+	{0x1d5b5, 0x1d5b5, "v"},
+	{0x1d5b6, 0x1d5b6, "w"},
+	{0x1d5b7, 0x1d5b7, "x"},
+	{0x1d5b8, 0x1d5b8, "y"},
+	{0x1d5b9, 0x1d5b9, "z"},
+	{0x1d5ba, 0x1d5ba, "a"},
+	{0x1d5bb, 0x1d5bb, "b"},
+	{0x1d5bc, 0x1d5bc, "c"},
+	{0x1d5bd, 0x1d5bd, "d"},
+	{0x1d5be, 0x1d5be, "e"},
+	{0x1d5bf, 0x1d5bf, "f"},
+	{0x1d5c0, 0x1d5c0, "g"},
+	{0x1d5c1, 0x1d5c1, "h"},
+	{0x1d5c2, 0x1d5c2, "i"},
+	{0x1d5c3, 0x1d5c3, "j"},
+	{0x1d5c4, 0x1d5c4, "k"},
+	{0x1d5c5, 0x1d5c5, "l"},
+	{0x1d5c6, 0x1d5c6, "m"},
+	{0x1d5c7, 0x1d5c7, "n"},
+	{0x1d5c8, 0x1d5c8, "o"},
+	{0x1d5c9, 0x1d5c9, "p"},
+	{0x1d5ca, 0x1d5ca, "q"},
+	{0x1d5cb, 0x1d5cb, "r"},
+	{0x1d5cc, 0x1d5cc, "s"},
+	{0x1d5cd, 0x1d5cd, "t"},
+	{0x1d5ce, 0x1d5ce, "u"},
+	{0x1d5cf, 0x1d5cf, "v"},
+	{0x1d5d0, 0x1d5d0, "w"},
+	{0x1d5d1, 0x1d5d1, "x"},
+	{0x1d5d2, 0x1d5d2, "y"},
+	{0x1d5d3, 0x1d5d3, "z"},
+	{0x1d5d4, 0x1d5d4, "a"},
+	{0x1d5d5, 0x1d5d5, "b"},
+	{0x1d5d6, 0x1d5d6, "c"},
+	{0x1d5d7, 0x1d5d7, "d"},
+	{0x1d5d8, 0x1d5d8, "e"},
+	{0x1d5d9, 0x1d5d9, "f"},
+	{0x1d5da, 0x1d5da, "g"},
+	{0x1d5db, 0x1d5db, "h"},
+	{0x1d5dc, 0x1d5dc, "i"},
+	{0x1d5dd, 0x1d5dd, "j"},
+	{0x1d5de, 0x1d5de, "k"},
+	{0x1d5df, 0x1d5df, "l"},
+	{0x1d5e0, 0x1d5e0, "m"},
+	{0x1d5e1, 0x1d5e1, "n"},
+	{0x1d5e2, 0x1d5e2, "o"},
+	{0x1d5e3, 0x1d5e3, "p"},
+	{0x1d5e4, 0x1d5e4, "q"},
+	{0x1d5e5, 0x1d5e5, "r"},
+	{0x1d5e6, 0x1d5e6, "s"},
+	{0x1d5e7, 0x1d5e7, "t"},
+	{0x1d5e8, 0x1d5e8, "u"},
+	{0x1d5e9, 0x1d5e9, "v"},
+	{0x1d5ea, 0x1d5ea, "w"},
+	{0x1d5eb, 0x1d5eb, "x"},
+	{0x1d5ec, 0x1d5ec, "y"},
+	{0x1d5ed, 0x1d5ed, "z"},
+	{0x1d5ee, 0x1d5ee, "a"},
+	{0x1d5ef, 0x1d5ef, "b"},
+	{0x1d5f0, 0x1d5f0, "c"},
+	{0x1d5f1, 0x1d5f1, "d"},
+	{0x1d5f2, 0x1d5f2, "e"},
+	{0x1d5f3, 0x1d5f3, "f"},
+	{0x1d5f4, 0x1d5f4, "g"},
+	{0x1d5f5, 0x1d5f5, "h"},
+	{0x1d5f6, 0x1d5f6, "i"},
+	{0x1d5f7, 0x1d5f7, "j"},
+	{0x1d5f8, 0x1d5f8, "k"},
+	{0x1d5f9, 0x1d5f9, "l"},
+	{0x1d5fa, 0x1d5fa, "m"},
+	{0x1d5fb, 0x1d5fb, "n"},
+	{0x1d5fc, 0x1d5fc, "o"},
+	{0x1d5fd, 0x1d5fd, "p"},
+	{0x1d5fe, 0x1d5fe, "q"},
+	{0x1d5ff, 0x1d5ff, "r"},
+	{0x1d600, 0x1d600, "s"},
+	{0x1d601, 0x1d601, "t"},
+	{0x1d602, 0x1d602, "u"},
+	{0x1d603, 0x1d603, "v"},
+	{0x1d604, 0x1d604, "w"},
+	{0x1d605, 0x1d605, "x"},
+	{0x1d606, 0x1d606, "y"},
+	{0x1d607, 0x1d607, "z"},
+	{0x1d608, 0x1d608, "a"},
+	{0x1d609, 0x1d609, "b"},
+	{0x1d60a, 0x1d60a, "c"},
+	{0x1d60b, 0x1d60b, "d"},
+	{0x1d60c, 0x1d60c, "e"},
+	{0x1d60d, 0x1d60d, "f"},
+	{0x1d60e, 0x1d60e, "g"},
+	{0x1d60f, 0x1d60f, "h"},
+	{0x1d610, 0x1d610, "i"},
+	{0x1d611, 0x1d611, "j"},
+	{0x1d612, 0x1d612, "k"},
+	{0x1d613, 0x1d613, "l"},
+	{0x1d614, 0x1d614, "m"},
+	{0x1d615, 0x1d615, "n"},
+	{0x1d616, 0x1d616, "o"},
+	{0x1d617, 0x1d617, "p"},
+	{0x1d618, 0x1d618, "q"},
+	{0x1d619, 0x1d619, "r"},
+	{0x1d61a, 0x1d61a, "s"},
+	{0x1d61b, 0x1d61b, "t"},
+	{0x1d61c, 0x1d61c, "u"},
+	{0x1d61d, 0x1d61d, "v"},
+	{0x1d61e, 0x1d61e, "w"},
+	{0x1d61f, 0x1d61f, "x"},
+	{0x1d620, 0x1d620, "y"},
+	{0x1d621, 0x1d621, "z"},
+	{0x1d622, 0x1d622, "a"},
+	{0x1d623, 0x1d623, "b"},
+	{0x1d624, 0x1d624, "c"},
+	{0x1d625, 0x1d625, "d"},
+	{0x1d626, 0x1d626, "e"},
+	{0x1d627, 0x1d627, "f"},
+	{0x1d628, 0x1d628, "g"},
+	{0x1d629, 0x1d629, "h"},
+	{0x1d62a, 0x1d62a, "i"},
+	{0x1d62b, 0x1d62b, "j"},
+	{0x1d62c, 0x1d62c, "k"},
+	{0x1d62d, 0x1d62d, "l"},
+	{0x1d62e, 0x1d62e, "m"},
+	{0x1d62f, 0x1d62f, "n"},
+	{0x1d630, 0x1d630, "o"},
+	{0x1d631, 0x1d631, "p"},
+	{0x1d632, 0x1d632, "q"},
+	{0x1d633, 0x1d633, "r"},
+	{0x1d634, 0x1d634, "s"},
+	{0x1d635, 0x1d635, "t"},
+	{0x1d636, 0x1d636, "u"},
+	{0x1d637, 0x1d637, "v"},
+	{0x1d638, 0x1d638, "w"},
+	{0x1d639, 0x1d639, "x"},
+	{0x1d63a, 0x1d63a, "y"},
+	{0x1d63b, 0x1d63b, "z"},
+	{0x1d63c, 0x1d63c, "a"},
+	{0x1d63d, 0x1d63d, "b"},
+	{0x1d63e, 0x1d63e, "c"},
+	{0x1d63f, 0x1d63f, "d"},
+	{0x1d640, 0x1d640, "e"},
+	{0x1d641, 0x1d641, "f"},
+	{0x1d642, 0x1d642, "g"},
+	{0x1d643, 0x1d643, "h"},
+	{0x1d644, 0x1d644, "i"},
+	{0x1d645, 0x1d645, "j"},
+	{0x1d646, 0x1d646, "k"},
+	{0x1d647, 0x1d647, "l"},
+	{0x1d648, 0x1d648, "m"},
+	{0x1d649, 0x1d649, "n"},
+	{0x1d64a, 0x1d64a, "o"},
+	{0x1d64b, 0x1d64b, "p"},
+	{0x1d64c, 0x1d64c, "q"},
+	{0x1d64d, 0x1d64d, "r"},
+	{0x1d64e, 0x1d64e, "s"},
+	{0x1d64f, 0x1d64f, "t"},
+	{0x1d650, 0x1d650, "u"},
+	{0x1d651, 0x1d651, "v"},
+	{0x1d652, 0x1d652, "w"},
+	{0x1d653, 0x1d653, "x"},
+	{0x1d654, 0x1d654, "y"},
+	{0x1d655, 0x1d655, "z"},
+	{0x1d656, 0x1d656, "a"},
+	{0x1d657, 0x1d657, "b"},
+	{0x1d658, 0x1d658, "c"},
+	{0x1d659, 0x1d659, "d"},
+	{0x1d65a, 0x1d65a, "e"},
+	{0x1d65b, 0x1d65b, "f"},
+	{0x1d65c, 0x1d65c, "g"},
+	{0x1d65d, 0x1d65d, "h"},
+	{0x1d65e, 0x1d65e, "i"},
+	{0x1d65f, 0x1d65f, "j"},
+	{0x1d660, 0x1d660, "k"},
+	{0x1d661, 0x1d661, "l"},
+	{0x1d662, 0x1d662, "m"},
+	{0x1d663, 0x1d663, "n"},
+	{0x1d664, 0x1d664, "o"},
+	{0x1d665, 0x1d665, "p"},
+	{0x1d666, 0x1d666, "q"},
+	{0x1d667, 0x1d667, "r"},
+	{0x1d668, 0x1d668, "s"},
+	{0x1d669, 0x1d669, "t"},
+	{0x1d66a, 0x1d66a, "u"},
+	{0x1d66b, 0x1d66b, "v"},
+	{0x1d66c, 0x1d66c, "w"},
+	{0x1d66d, 0x1d66d, "x"},
+	{0x1d66e, 0x1d66e, "y"},
+	{0x1d66f, 0x1d66f, "z"},
+	{0x1d670, 0x1d670, "a"},
+	{0x1d671, 0x1d671, "b"},
+	{0x1d672, 0x1d672, "c"},
+	{0x1d673, 0x1d673, "d"},
+	{0x1d674, 0x1d674, "e"},
+	{0x1d675, 0x1d675, "f"},
+	{0x1d676, 0x1d676, "g"},
+	{0x1d677, 0x1d677, "h"},
+	{0x1d678, 0x1d678, "i"},
+	{0x1d679, 0x1d679, "j"},
+	{0x1d67a, 0x1d67a, "k"},
+	{0x1d67b, 0x1d67b, "l"},
+	{0x1d67c, 0x1d67c, "m"},
+	{0x1d67d, 0x1d67d, "n"},
+	{0x1d67e, 0x1d67e, "o"},
+	{0x1d67f, 0x1d67f, "p"},
+	{0x1d680, 0x1d680, "q"},
+	{0x1d681, 0x1d681, "r"},
+	{0x1d682, 0x1d682, "s"},
+	{0x1d683, 0x1d683, "t"},
+	{0x1d684, 0x1d684, "u"},
+	{0x1d685, 0x1d685, "v"},
+	{0x1d686, 0x1d686, "w"},
+	{0x1d687, 0x1d687, "x"},
+	{0x1d688, 0x1d688, "y"},
+	{0x1d689, 0x1d689, "z"},
+	{0x1d68a, 0x1d68a, "a"},
+	{0x1d68b, 0x1d68b, "b"},
+	{0x1d68c, 0x1d68c, "c"},
+	{0x1d68d, 0x1d68d, "d"},
+	{0x1d68e, 0x1d68e, "e"},
+	{0x1d68f, 0x1d68f, "f"},
+	{0x1d690, 0x1d690, "g"},
+	{0x1d691, 0x1d691, "h"},
+	{0x1d692, 0x1d692, "i"},
+	{0x1d693, 0x1d693, "j"},
+	{0x1d694, 0x1d694, "k"},
+	{0x1d695, 0x1d695, "l"},
+	{0x1d696, 0x1d696, "m"},
+	{0x1d697, 0x1d697, "n"},
+	{0x1d698, 0x1d698, "o"},
+	{0x1d699, 0x1d699, "p"},
+	{0x1d69a, 0x1d69a, "q"},
+	{0x1d69b, 0x1d69b, "r"},
+	{0x1d69c, 0x1d69c, "s"},
+	{0x1d69d, 0x1d69d, "t"},
+	{0x1d69e, 0x1d69e, "u"},
+	{0x1d69f, 0x1d69f, "v"},
+	{0x1d6a0, 0x1d6a0, "w"},
+	{0x1d6a1, 0x1d6a1, "x"},
+	{0x1d6a2, 0x1d6a2, "y"},
+	{0x1d6a3, 0x1d6a3, "z"},
+	{0x1d6a4, 0x1d6a4, "\u0131"},
+	{0x1d6a5, 0x1d6a5, "\u0237"},
+	{0x1d6a8, 0x1d6a8, "\u03b1"},
+	{0x1d6a9, 0x1d6a9, "\u03b2"},
+	{0x1d6aa, 0x1d6aa, "\u03b3"},
+	{0x1d6ab, 0x1d6ab, "\u03b4"},
+	{0x1d6ac, 0x1d6ac, "\u03b5"},
+	{0x1d6ad, 0x1d6ad, "\u03b6"},
+	{0x1d6ae, 0x1d6ae, "\u03b7"},
+	{0x1d6af, 0x1d6af, "\u03b8"},
+	{0x1d6b0, 0x1d6b0, "\u03b9"},
+	{0x1d6b1, 0x1d6b1, "\u03ba"},
+	{0x1d6b2, 0x1d6b2, "\u03bb"},
+	{0x1d6b3, 0x1d6b3, "\u03bc"},
+	{0x1d6b4, 0x1d6b4, "\u03bd"},
+	{0x1d6b5, 0x1d6b5, "\u03be"},
+	{0x1d6b6, 0x1d6b6, "\u03bf"},
+	{0x1d6b7, 0x1d6b7, "\u03c0"},
+	{0x1d6b8, 0x1d6b8, "\u03c1"},
+	{0x1d6b9, 0x1d6b9, "\u03b8"},
+	{0x1d6ba, 0x1d6ba, "\u03c3"},
+	{0x1d6bb, 0x1d6bb, "\u03c4"},
+	{0x1d6bc, 0x1d6bc, "\u03c5"},
+	{0x1d6bd, 0x1d6bd, "\u03c6"},
+	{0x1d6be, 0x1d6be, "\u03c7"},
+	{0x1d6bf, 0x1d6bf, "\u03c8"},
+	{0x1d6c0, 0x1d6c0, "\u03c9"},
+	{0x1d6c1, 0x1d6c1, "\u2207"},
+	{0x1d6c2, 0x1d6c2, "\u03b1"},
+	{0x1d6c3, 0x1d6c3, "\u03b2"},
+	{0x1d6c4, 0x1d6c4, "\u03b3"},
+	{0x1d6c5, 0x1d6c5, "\u03b4"},
+	{0x1d6c6, 0x1d6c6, "\u03b5"},
+	{0x1d6c7, 0x1d6c7, "\u03b6"},
+	{0x1d6c8, 0x1d6c8, "\u03b7"},
+	{0x1d6c9, 0x1d6c9, "\u03b8"},
+	{0x1d6ca, 0x1d6ca, "\u03b9"},
+	{0x1d6cb, 0x1d6cb, "\u03ba"},
+	{0x1d6cc, 0x1d6cc, "\u03bb"},
+	{0x1d6cd, 0x1d6cd, "\u03bc"},
+	{0x1d6ce, 0x1d6ce, "\u03bd"},
+	{0x1d6cf, 0x1d6cf, "\u03be"},
+	{0x1d6d0, 0x1d6d0, "\u03bf"},
+	{0x1d6d1, 0x1d6d1, "\u03c0"},
+	{0x1d6d2, 0x1d6d2, "\u03c1"},
+	{0x1d6d3, 0x1d6d4, "\u03c3"},
+	{0x1d6d5, 0x1d6d5, "\u03c4"},
+	{0x1d6d6, 0x1d6d6, "\u03c5"},
+	{0x1d6d7, 0x1d6d7, "\u03c6"},
+	{0x1d6d8, 0x1d6d8, "\u03c7"},
+	{0x1d6d9, 0x1d6d9, "\u03c8"},
+	{0x1d6da, 0x1d6da, "\u03c9"},
+	{0x1d6db, 0x1d6db, "\u2202"},
+	{0x1d6dc, 0x1d6dc, "\u03b5"},
+	{0x1d6dd, 0x1d6dd, "\u03b8"},
+	{0x1d6de, 0x1d6de, "\u03ba"},
+	{0x1d6df, 0x1d6df, "\u03c6"},
+	{0x1d6e0, 0x1d6e0, "\u03c1"},
+	{0x1d6e1, 0x1d6e1, "\u03c0"},
+	{0x1d6e2, 0x1d6e2, "\u03b1"},
+	{0x1d6e3, 0x1d6e3, "\u03b2"},
+	{0x1d6e4, 0x1d6e4, "\u03b3"},
+	{0x1d6e5, 0x1d6e5, "\u03b4"},
+	{0x1d6e6, 0x1d6e6, "\u03b5"},
+	{0x1d6e7, 0x1d6e7, "\u03b6"},
+	{0x1d6e8, 0x1d6e8, "\u03b7"},
+	{0x1d6e9, 0x1d6e9, "\u03b8"},
+	{0x1d6ea, 0x1d6ea, "\u03b9"},
+	{0x1d6eb, 0x1d6eb, "\u03ba"},
+	{0x1d6ec, 0x1d6ec, "\u03bb"},
+	{0x1d6ed, 0x1d6ed, "\u03bc"},
+	{0x1d6ee, 0x1d6ee, "\u03bd"},
+	{0x1d6ef, 0x1d6ef, "\u03be"},
+	{0x1d6f0, 0x1d6f0, "\u03bf"},
+	{0x1d6f1, 0x1d6f1, "\u03c0"},
+	{0x1d6f2, 0x1d6f2, "\u03c1"},
+	{0x1d6f3, 0x1d6f3, "\u03b8"},
+	{0x1d6f4, 0x1d6f4, "\u03c3"},
+	{0x1d6f5, 0x1d6f5, "\u03c4"},
+	{0x1d6f6, 0x1d6f6, "\u03c5"},
+	{0x1d6f7, 0x1d6f7, "\u03c6"},
+	{0x1d6f8, 0x1d6f8, "\u03c7"},
+	{0x1d6f9, 0x1d6f9, "\u03c8"},
+	{0x1d6fa, 0x1d6fa, "\u03c9"},
+	{0x1d6fb, 0x1d6fb, "\u2207"},
+	{0x1d6fc, 0x1d6fc, "\u03b1"},
+	{0x1d6fd, 0x1d6fd, "\u03b2"},
+	{0x1d6fe, 0x1d6fe, "\u03b3"},
+	{0x1d6ff, 0x1d6ff, "\u03b4"},
+	{0x1d700, 0x1d700, "\u03b5"},
+	{0x1d701, 0x1d701, "\u03b6"},
+	{0x1d702, 0x1d702, "\u03b7"},
+	{0x1d703, 0x1d703, "\u03b8"},
+	{0x1d704, 0x1d704, "\u03b9"},
+	{0x1d705, 0x1d705, "\u03ba"},
+	{0x1d706, 0x1d706, "\u03bb"},
+	{0x1d707, 0x1d707, "\u03bc"},
+	{0x1d708, 0x1d708, "\u03bd"},
+	{0x1d709, 0x1d709, "\u03be"},
+	{0x1d70a, 0x1d70a, "\u03bf"},
+	{0x1d70b, 0x1d70b, "\u03c0"},
+	{0x1d70c, 0x1d70c, "\u03c1"},
+	{0x1d70d, 0x1d70e, "\u03c3"},
+	{0x1d70f, 0x1d70f, "\u03c4"},
+	{0x1d710, 0x1d710, "\u03c5"},
+	{0x1d711, 0x1d711, "\u03c6"},
+	{0x1d712, 0x1d712, "\u03c7"},
+	{0x1d713, 0x1d713, "\u03c8"},
+	{0x1d714, 0x1d714, "\u03c9"},
+	{0x1d715, 0x1d715, "\u2202"},
+	{0x1d716, 0x1d716, "\u03b5"},
+	{0x1d717, 0x1d717, "\u03b8"},
+	{0x1d718, 0x1d718, "\u03ba"},
+	{0x1d719, 0x1d719, "\u03c6"},
+	{0x1d71a, 0x1d71a, "\u03c1"},
+	{0x1d71b, 0x1d71b, "\u03c0"},
+	{0x1d71c, 0x1d71c, "\u03b1"},
+	{0x1d71d, 0x1d71d, "\u03b2"},
+	{0x1d71e, 0x1d71e, "\u03b3"},
+	{0x1d71f, 0x1d71f, "\u03b4"},
+	{0x1d720, 0x1d720, "\u03b5"},
+	{0x1d721, 0x1d721, "\u03b6"},
+	{0x1d722, 0x1d722, "\u03b7"},
+	{0x1d723, 0x1d723, "\u03b8"},
+	{0x1d724, 0x1d724, "\u03b9"},
+	{0x1d725, 0x1d725, "\u03ba"},
+	{0x1d726, 0x1d726, "\u03bb"},
+	{0x1d727, 0x1d727, "\u03bc"},
+	{0x1d728, 0x1d728, "\u03bd"},
+	{0x1d729, 0x1d729, "\u03be"},
+	{0x1d72a, 0x1d72a, "\u03bf"},
+	{0x1d72b, 0x1d72b, "\u03c0"},
+	{0x1d72c, 0x1d72c, "\u03c1"},
+	{0x1d72d, 0x1d72d, "\u03b8"},
+	{0x1d72e, 0x1d72e, "\u03c3"},
+	{0x1d72f, 0x1d72f, "\u03c4"},
+	{0x1d730, 0x1d730, "\u03c5"},
+	{0x1d731, 0x1d731, "\u03c6"},
+	{0x1d732, 0x1d732, "\u03c7"},
+	{0x1d733, 0x1d733, "\u03c8"},
+	{0x1d734, 0x1d734, "\u03c9"},
+	{0x1d735, 0x1d735, "\u2207"},
+	{0x1d736, 0x1d736, "\u03b1"},
+	{0x1d737, 0x1d737, "\u03b2"},
+	{0x1d738, 0x1d738, "\u03b3"},
+	{0x1d739, 0x1d739, "\u03b4"},
+	{0x1d73a, 0x1d73a, "\u03b5"},
+	{0x1d73b, 0x1d73b, "\u03b6"},
+	{0x1d73c, 0x1d73c, "\u03b7"},
+	{0x1d73d, 0x1d73d, "\u03b8"},
+	{0x1d73e, 0x1d73e, "\u03b9"},
+	{0x1d73f, 0x1d73f, "\u03ba"},
+	{0x1d740, 0x1d740, "\u03bb"},
+	{0x1d741, 0x1d741, "\u03bc"},
+	{0x1d742, 0x1d742, "\u03bd"},
+	{0x1d743, 0x1d743, "\u03be"},
+	{0x1d744, 0x1d744, "\u03bf"},
+	{0x1d745, 0x1d745, "\u03c0"},
+	{0x1d746, 0x1d746, "\u03c1"},
+	{0x1d747, 0x1d748, "\u03c3"},
+	{0x1d749, 0x1d749, "\u03c4"},
+	{0x1d74a, 0x1d74a, "\u03c5"},
+	{0x1d74b, 0x1d74b, "\u03c6"},
+	{0x1d74c, 0x1d74c, "\u03c7"},
+	{0x1d74d, 0x1d74d, "\u03c8"},
+	{0x1d74e, 0x1d74e, "\u03c9"},
+	{0x1d74f, 0x1d74f, "\u2202"},
+	{0x1d750, 0x1d750, "\u03b5"},
+	{0x1d751, 0x1d751, "\u03b8"},
+	{0x1d752, 0x1d752, "\u03ba"},
+	{0x1d753, 0x1d753, "\u03c6"},
+	{0x1d754, 0x1d754, "\u03c1"},
+	{0x1d755, 0x1d755, "\u03c0"},
+	{0x1d756, 0x1d756, "\u03b1"},
+	{0x1d757, 0x1d757, "\u03b2"},
+	{0x1d758, 0x1d758, "\u03b3"},
+	{0x1d759, 0x1d759, "\u03b4"},
+	{0x1d75a, 0x1d75a, "\u03b5"},
+	{0x1d75b, 0x1d75b, "\u03b6"},
+	{0x1d75c, 0x1d75c, "\u03b7"},
+	{0x1d75d, 0x1d75d, "\u03b8"},
+	{0x1d75e, 0x1d75e, "\u03b9"},
+	{0x1d75f, 0x1d75f, "\u03ba"},
+	{0x1d760, 0x1d760, "\u03bb"},
+	{0x1d761, 0x1d761, "\u03bc"},
+	{0x1d762, 0x1d762, "\u03bd"},
+	{0x1d763, 0x1d763, "\u03be"},
+	{0x1d764, 0x1d764, "\u03bf"},
+	{0x1d765, 0x1d765, "\u03c0"},
+	{0x1d766, 0x1d766, "\u03c1"},
+	{0x1d767, 0x1d767, "\u03b8"},
+	{0x1d768, 0x1d768, "\u03c3"},
+	{0x1d769, 0x1d769, "\u03c4"},
+	{0x1d76a, 0x1d76a, "\u03c5"},
+	{0x1d76b, 0x1d76b, "\u03c6"},
+	{0x1d76c, 0x1d76c, "\u03c7"},
+	{0x1d76d, 0x1d76d, "\u03c8"},
+	{0x1d76e, 0x1d76e, "\u03c9"},
+	{0x1d76f, 0x1d76f, "\u2207"},
+	{0x1d770, 0x1d770, "\u03b1"},
+	{0x1d771, 0x1d771, "\u03b2"},
+	{0x1d772, 0x1d772, "\u03b3"},
+	{0x1d773, 0x1d773, "\u03b4"},
+	{0x1d774, 0x1d774, "\u03b5"},
+	{0x1d775, 0x1d775, "\u03b6"},
+	{0x1d776, 0x1d776, "\u03b7"},
+	{0x1d777, 0x1d777, "\u03b8"},
+	{0x1d778, 0x1d778, "\u03b9"},
+	{0x1d779, 0x1d779, "\u03ba"},
+	{0x1d77a, 0x1d77a, "\u03bb"},
+	{0x1d77b, 0x1d77b, "\u03bc"},
+	{0x1d77c, 0x1d77c, "\u03bd"},
+	{0x1d77d, 0x1d77d, "\u03be"},
+	{0x1d77e, 0x1d77e, "\u03bf"},
+	{0x1d77f, 0x1d77f, "\u03c0"},
+	{0x1d780, 0x1d780, "\u03c1"},
+	{0x1d781, 0x1d782, "\u03c3"},
+	{0x1d783, 0x1d783, "\u03c4"},
+	{0x1d784, 0x1d784, "\u03c5"},
+	{0x1d785, 0x1d785, "\u03c6"},
+	{0x1d786, 0x1d786, "\u03c7"},
+	{0x1d787, 0x1d787, "\u03c8"},
+	{0x1d788, 0x1d788, "\u03c9"},
+	{0x1d789, 0x1d789, "\u2202"},
+	{0x1d78a, 0x1d78a, "\u03b5"},
+	{0x1d78b, 0x1d78b, "\u03b8"},
+	{0x1d78c, 0x1d78c, "\u03ba"},
+	{0x1d78d, 0x1d78d, "\u03c6"},
+	{0x1d78e, 0x1d78e, "\u03c1"},
+	{0x1d78f, 0x1d78f, "\u03c0"},
+	{0x1d790, 0x1d790, "\u03b1"},
+	{0x1d791, 0x1d791, "\u03b2"},
+	{0x1d792, 0x1d792, "\u03b3"},
+	{0x1d793, 0x1d793, "\u03b4"},
+	{0x1d794, 0x1d794, "\u03b5"},
+	{0x1d795, 0x1d795, "\u03b6"},
+	{0x1d796, 0x1d796, "\u03b7"},
+	{0x1d797, 0x1d797, "\u03b8"},
+	{0x1d798, 0x1d798, "\u03b9"},
+	{0x1d799, 0x1d799, "\u03ba"},
+	{0x1d79a, 0x1d79a, "\u03bb"},
+	{0x1d79b, 0x1d79b, "\u03bc"},
+	{0x1d79c, 0x1d79c, "\u03bd"},
+	{0x1d79d, 0x1d79d, "\u03be"},
+	{0x1d79e, 0x1d79e, "\u03bf"},
+	{0x1d79f, 0x1d79f, "\u03c0"},
+	{0x1d7a0, 0x1d7a0, "\u03c1"},
+	{0x1d7a1, 0x1d7a1, "\u03b8"},
+	{0x1d7a2, 0x1d7a2, "\u03c3"},
+	{0x1d7a3, 0x1d7a3, "\u03c4"},
+	{0x1d7a4, 0x1d7a4, "\u03c5"},
+	{0x1d7a5, 0x1d7a5, "\u03c6"},
+	{0x1d7a6, 0x1d7a6, "\u03c7"},
+	{0x1d7a7, 0x1d7a7, "\u03c8"},
+	{0x1d7a8, 0x1d7a8, "\u03c9"},
+	{0x1d7a9, 0x1d7a9, "\u2207"},
+	{0x1d7aa, 0x1d7aa, "\u03b1"},
+	{0x1d7ab, 0x1d7ab, "\u03b2"},
+	{0x1d7ac, 0x1d7ac, "\u03b3"},
+	{0x1d7ad, 0x1d7ad, "\u03b4"},
+	{0x1d7ae, 0x1d7ae, "\u03b5"},
+	{0x1d7af, 0x1d7af, "\u03b6"},
+	{0x1d7b0, 0x1d7b0, "\u03b7"},
+	{0x1d7b1, 0x1d7b1, "\u03b8"},
+	{0x1d7b2, 0x1d7b2, "\u03b9"},
+	{0x1d7b3, 0x1d7b3, "\u03ba"},
+	{0x1d7b4, 0x1d7b4, "\u03bb"},
+	{0x1d7b5, 0x1d7b5, "\u03bc"},
+	{0x1d7b6, 0x1d7b6, "\u03bd"},
+	{0x1d7b7, 0x1d7b7, "\u03be"},
+	{0x1d7b8, 0x1d7b8, "\u03bf"},
+	{0x1d7b9, 0x1d7b9, "\u03c0"},
+	{0x1d7ba, 0x1d7ba, "\u03c1"},
+	{0x1d7bb, 0x1d7bc, "\u03c3"},
+	{0x1d7bd, 0x1d7bd, "\u03c4"},
+	{0x1d7be, 0x1d7be, "\u03c5"},
+	{0x1d7bf, 0x1d7bf, "\u03c6"},
+	{0x1d7c0, 0x1d7c0, "\u03c7"},
+	{0x1d7c1, 0x1d7c1, "\u03c8"},
+	{0x1d7c2, 0x1d7c2, "\u03c9"},
+	{0x1d7c3, 0x1d7c3, "\u2202"},
+	{0x1d7c4, 0x1d7c4, "\u03b5"},
+	{0x1d7c5, 0x1d7c5, "\u03b8"},
+	{0x1d7c6, 0x1d7c6, "\u03ba"},
+	{0x1d7c7, 0x1d7c7, "\u03c6"},
+	{0x1d7c8, 0x1d7c8, "\u03c1"},
+	{0x1d7c9, 0x1d7c9, "\u03c0"},
+	{0x1d7ca, 0x1d7cb, "\u03dd"},
+	{0x1d7ce, 0x1d7ce, "0"},
+	{0x1d7cf, 0x1d7cf, "1"},
+	{0x1d7d0, 0x1d7d0, "2"},
+	{0x1d7d1, 0x1d7d1, "3"},
+	{0x1d7d2, 0x1d7d2, "4"},
+	{0x1d7d3, 0x1d7d3, "5"},
+	{0x1d7d4, 0x1d7d4, "6"},
+	{0x1d7d5, 0x1d7d5, "7"},
+	{0x1d7d6, 0x1d7d6, "8"},
+	{0x1d7d7, 0x1d7d7, "9"},
+	{0x1d7d8, 0x1d7d8, "0"},
+	{0x1d7d9, 0x1d7d9, "1"},
+	{0x1d7da, 0x1d7da, "2"},
+	{0x1d7db, 0x1d7db, "3"},
+	{0x1d7dc, 0x1d7dc, "4"},
+	{0x1d7dd, 0x1d7dd, "5"},
+	{0x1d7de, 0x1d7de, "6"},
+	{0x1d7df, 0x1d7df, "7"},
+	{0x1d7e0, 0x1d7e0, "8"},
+	{0x1d7e1, 0x1d7e1, "9"},
+	{0x1d7e2, 0x1d7e2, "0"},
+	{0x1d7e3, 0x1d7e3, "1"},
+	{0x1d7e4, 0x1d7e4, "2"},
+	{0x1d7e5, 0x1d7e5, "3"},
+	{0x1d7e6, 0x1d7e6, "4"},
+	{0x1d7e7, 0x1d7e7, "5"},
+	{0x1d7e8, 0x1d7e8, "6"},
+	{0x1d7e9, 0x1d7e9, "7"},
+	{0x1d7ea, 0x1d7ea, "8"},
+	{0x1d7eb, 0x1d7eb, "9"},
+	{0x1d7ec, 0x1d7ec, "0"},
+	{0x1d7ed, 0x1d7ed, "1"},
+	{0x1d7ee, 0x1d7ee, "2"},
+	{0x1d7ef, 0x1d7ef, "3"},
+	{0x1d7f0, 0x1d7f0, "4"},
+	{0x1d7f1, 0x1d7f1, "5"},
+	{0x1d7f2, 0x1d7f2, "6"},
+	{0x1d7f3, 0x1d7f3, "7"},
+	{0x1d7f4, 0x1d7f4, "8"},
+	{0x1d7f5, 0x1d7f5, "9"},
+	{0x1d7f6, 0x1d7f6, "0"},
+	{0x1d7f7, 0x1d7f7, "1"},
+	{0x1d7f8, 0x1d7f8, "2"},
+	{0x1d7f9, 0x1d7f9, "3"},
+	{0x1d7fa, 0x1d7fa, "4"},
+	{0x1d7fb, 0x1d7fb, "5"},
+	{0x1d7fc, 0x1d7fc, "6"},
+	{0x1d7fd, 0x1d7fd, "7"},
+	{0x1d7fe, 0x1d7fe, "8"},
+	{0x1d7ff, 0x1d7ff, "9"},
+	{0x1e030, 0x1e030, "\u0430"},
+	{0x1e031, 0x1e031, "\u0431"},
+	{0x1e032, 0x1e032, "\u0432"},
+	{0x1e033, 0x1e033, "\u0433"},
+	{0x1e034, 0x1e034, "\u0434"},
+	{0x1e035, 0x1e035, "\u0435"},
+	{0x1e036, 0x1e036, "\u0436"},
+	{0x1e037, 0x1e037, "\u0437"},
+	{0x1e038, 0x1e038, "\u0438"},
+	{0x1e039, 0x1e039, "\u043a"},
+	{0x1e03a, 0x1e03a, "\u043b"},
+	{0x1e03b, 0x1e03b, "\u043c"},
+	{0x1e03c, 0x1e03c, "\u043e"},
+	{0x1e03d, 0x1e03d, "\u043f"},
+	{0x1e03e, 0x1e03e, "\u0440"},
+	{0x1e03f, 0x1e03f, "\u0441"},
+	{0x1e040, 0x1e040, "\u0442"},
+	{0x1e041, 0x1e041, "\u0443"},
+	{0x1e042, 0x1e042, "\u0444"},
+	{0x1e043, 0x1e043, "\u0445"},
+	{0x1e044, 0x1e044, "\u0446"},
+	{0x1e045, 0x1e045, "\u0447"},
+	{0x1e046, 0x1e046, "\u0448"},
+	{0x1e047, 0x1e047, "\u044b"},
+	{0x1e048, 0x1e048, "\u044d"},
+	{0x1e049, 0x1e049, "\u044e"},
+	{0x1e04a, 0x1e04a, "\ua689"},
+	{0x1e04b, 0x1e04b, "\u04d9"},
+	{0x1e04c, 0x1e04c, "\u0456"},
+	{0x1e04d, 0x1e04d, "\u0458"},
+	{0x1e04e, 0x1e04e, "\u04e9"},
+	{0x1e04f, 0x1e04f, "\u04af"},
+	{0x1e050, 0x1e050, "\u04cf"},
+	{0x1e051, 0x1e051, "\u0430"},
+	{0x1e052, 0x1e052, "\u0431"},
+	{0x1e053, 0x1e053, "\u0432"},
+	{0x1e054, 0x1e054, "\u0433"},
+	{0x1e055, 0x1e055, "\u0434"},
+	{0x1e056, 0x1e056, "\u0435"},
+	{0x1e057, 0x1e057, "\u0436"},
+	{0x1e058, 0x1e058, "\u0437"},
+	{0x1e059, 0x1e059, "\u0438"},
+	{0x1e05a, 0x1e05a, "\u043a"},
+	{0x1e05b, 0x1e05b, "\u043b"},
+	{0x1e05c, 0x1e05c, "\u043e"},
+	{0x1e05d, 0x1e05d, "\u043f"},
+	{0x1e05e, 0x1e05e, "\u0441"},
+	{0x1e05f, 0x1e05f, "\u0443"},
+	{0x1e060, 0x1e060, "\u0444"},
+	{0x1e061, 0x1e061, "\u0445"},
+	{0x1e062, 0x1e062, "\u0446"},
+	{0x1e063, 0x1e063, "\u0447"},
+	{0x1e064, 0x1e064, "\u0448"},
+	{0x1e065, 0x1e065, "\u044a"},
+	{0x1e066, 0x1e066, "\u044b"},
+	{0x1e067, 0x1e067, "\u0491"},
+	{0x1e068, 0x1e068, "\u0456"},
+	{0x1e069, 0x1e069, "\u0455"},
+	{0x1e06a, 0x1e06a, "\u045f"},
+	{0x1e06b, 0x1e06b, "\u04ab"},
+	{0x1e06c, 0x1e06c, "\ua651"},
+	{0x1e06d, 0x1e06d, "\u04b1"},
+	{0x1e900, 0x1e900, "\U0001e922"},
+	{0x1e901, 0x1e901, "\U0001e923"},
+	{0x1e902, 0x1e902, "\U0001e924"},
+	{0x1e903, 0x1e903, "\U0001e925"},
+	{0x1e904, 0x1e904, "\U0001e926"},
+	{0x1e905, 0x1e905, "\U0001e927"},
+	{0x1e906, 0x1e906, "\U0001e928"},
+	{0x1e907, 0x1e907, "\U0001e929"},
+	{0x1e908, 0x1e908, "\U0001e92a"},
+	{0x1e909, 0x1e909, "\U0001e92b"},
+	{0x1e90a, 0x1e90a, "\U0001e92c"},
+	{0x1e90b, 0x1e90b, "\U0001e92d"},
+	{0x1e90c, 0x1e90c, "\U0001e92e"},
+	{0x1e90d, 0x1e90d, "\U0001e92f"},
+	{0x1e90e, 0x1e90e, "\U0001e930"},
+	{0x1e90f, 0x1e90f, "\U0001e931"},
+	{0x1e910, 0x1e910, "\U0001e932"},
+	{0x1e911, 0x1e911, "\U0001e933"},
+	{0x1e912, 0x1e912, "\U0001e934"},
+	{0x1e913, 0x1e913, "\U0001e935"},
+	{0x1e914, 0x1e914, "\U0001e936"},
+	{0x1e915, 0x1e915, "\U0001e937"},
+	{0x1e916, 0x1e916, "\U0001e938"},
+	{0x1e917, 0x1e917, "\U0001e939"},
+	{0x1e918, 0x1e918, "\U0001e93a"},
+	{0x1e919, 0x1e919, "\U0001e93b"},
+	{0x1e91a, 0x1e91a, "\U0001e93c"},
+	{0x1e91b, 0x1e91b, "\U0001e93d"},
+	{0x1e91c, 0x1e91c, "\U0001e93e"},
+	{0x1e91d, 0x1e91d, "\U0001e93f"},
+	{0x1e91e, 0x1e91e, "\U0001e940"},
+	{0x1e91f, 0x1e91f, "\U0001e941"},
+	{0x1e920, 0x1e920, "\U0001e942"},
+	{0x1e921, 0x1e921, "\U0001e943"},
+	{0x1ee00, 0x1ee00, "\u0627"},
+	{0x1ee01, 0x1ee01, "\u0628"},
+	{0x1ee02, 0x1ee02, "\u062c"},
+	{0x1ee03, 0x1ee03, "\u062f"},
+	{0x1ee05, 0x1ee05, "\u0648"},
+	{0x1ee06, 0x1ee06, "\u0632"},
+	{0x1ee07, 0x1ee07, "\u062d"},
+	{0x1ee08, 0x1ee08, "\u0637"},
+	{0x1ee09, 0x1ee09, "\u064a"},
+	{0x1ee0a, 0x1ee0a, "\u0643"},
+	{0x1ee0b, 0x1ee0b, "\u0644"},
+	{0x1ee0c, 0x1ee0c, "\u0645"},
+	{0x1ee0d, 0x1ee0d, "\u0646"},
+	{0x1ee0e, 0x1ee0e, "\u0633"},
+	{0x1ee0f, 0x1ee0f, "\u0639"},
+	{0x1ee10, 0x1ee10, "\u0641"},
+	{0x1ee11, 0x1ee11, "\u0635"},
+	{0x1ee12, 0x1ee12, "\u0642"},
+	{0x1ee13, 0x1ee13, "\u0631"},
+	{0x1ee14, 0x1ee14, "\u0634"},
+	{0x1ee15, 0x1ee15, "\u062a"},
+	{0x1ee16, 0x1ee16, "\u062b"},
+	{0x1ee17, 0x1ee17, "\u062e"},
+	{0x1ee18, 0x1ee18, "\u0630"},
+	{0x1ee19, 0x1ee19, "\u0636"},
+	{0x1ee1a, 0x1ee1a, "\u0638"},
+	{0x1ee1b, 0x1ee1b, "\u063a"},
+	{0x1ee1c, 0x1ee1c, "\u066e"},
+	{0x1ee1d, 0x1ee1d, "\u06ba"},
+	{0x1ee1e, 0x1ee1e, "\u06a1"},
+	{0x1ee1f, 0x1ee1f, "\u066f"},
+	{0x1ee21, 0x1ee21, "\u0628"},
+	{0x1ee22, 0x1ee22, "\u062c"},
+	{0x1ee24, 0x1ee24, "\u0647"},
+	{0x1ee27, 0x1ee27, "\u062d"},
+	{0x1ee29, 0x1ee29, "\u064a"},
+	{0x1ee2a, 0x1ee2a, "\u0643"},
+	{0x1ee2b, 0x1ee2b, "\u0644"},
+	{0x1ee2c, 0x1ee2c, "\u0645"},
+	{0x1ee2d, 0x1ee2d, "\u0646"},
+	{0x1ee2e, 0x1ee2e, "\u0633"},
+	{0x1ee2f, 0x1ee2f, "\u0639"},
+	{0x1ee30, 0x1ee30, "\u0641"},
+	{0x1ee31, 0x1ee31, "\u0635"},
+	{0x1ee32, 0x1ee32, "\u0642"},
+	{0x1ee34, 0x1ee34, "\u0634"},
+	{0x1ee35, 0x1ee35, "\u062a"},
+	{0x1ee36, 0x1ee36, "\u062b"},
+	{0x1ee37, 0x1ee37, "\u062e"},
+	{0x1ee39, 0x1ee39, "\u0636"},
+	{0x1ee3b, 0x1ee3b, "\u063a"},
+	{0x1ee42, 0x1ee42, "\u062c"},
+	{0x1ee47, 0x1ee47, "\u062d"},
+	{0x1ee49, 0x1ee49, "\u064a"},
+	{0x1ee4b, 0x1ee4b, "\u0644"},
+	{0x1ee4d, 0x1ee4d, "\u0646"},
+	{0x1ee4e, 0x1ee4e, "\u0633"},
+	{0x1ee4f, 0x1ee4f, "\u0639"},
+	{0x1ee51, 0x1ee51, "\u0635"},
+	{0x1ee52, 0x1ee52, "\u0642"},
+	{0x1ee54, 0x1ee54, "\u0634"},
+	{0x1ee57, 0x1ee57, "\u062e"},
+	{0x1ee59, 0x1ee59, "\u0636"},
+	{0x1ee5b, 0x1ee5b, "\u063a"},
+	{0x1ee5d, 0x1ee5d, "\u06ba"},
+	{0x1ee5f, 0x1ee5f, "\u066f"},
+	{0x1ee61, 0x1ee61, "\u0628"},
+	{0x1ee62, 0x1ee62, "\u062c"},
+	{0x1ee64, 0x1ee64, "\u0647"},
+	{0x1ee67, 0x1ee67, "\u062d"},
+	{0x1ee68, 0x1ee68, "\u0637"},
+	{0x1ee69, 0x1ee69, "\u064a"},
+	{0x1ee6a, 0x1ee6a, "\u0643"},
+	{0x1ee6c, 0x1ee6c, "\u0645"},
+	{0x1ee6d, 0x1ee6d, "\u0646"},
+	{0x1ee6e, 0x1ee6e, "\u0633"},
+	{0x1ee6f, 0x1ee6f, "\u0639"},
+	{0x1ee70, 0x1ee70, "\u0641"},
+	{0x1ee71, 0x1ee71, "\u0635"},
+	{0x1ee72, 0x1ee72, "\u0642"},
+	{0x1ee74, 0x1ee74, "\u0634"},
+	{0x1ee75, 0x1ee75, "\u062a"},
+	{0x1ee76, 0x1ee76, "\u062b"},
+	{0x1ee77, 0x1ee77, "\u062e"},
+	{0x1ee79, 0x1ee79, "\u0636"},
+	{0x1ee7a, 0x1ee7a, "\u0638"},
+	{0x1ee7b, 0x1ee7b, "\u063a"},
+	{0x1ee7c, 0x1ee7c, "\u066e"},
+	{0x1ee7e, 0x1ee7e, "\u06a1"},
+	{0x1ee80, 0x1ee80, "\u0627"},
+	{0x1ee81, 0x1ee81, "\u0628"},
+	{0x1ee82, 0x1ee82, "\u062c"},
+	{0x1ee83, 0x1ee83, "\u062f"},
+	{0x1ee84, 0x1ee84, "\u0647"},
+	{0x1ee85, 0x1ee85, "\u0648"},
+	{0x1ee86, 0x1ee86, "\u0632"},
+	{0x1ee87, 0x1ee87, "\u062d"},
+	{0x1ee88, 0x1ee88, "\u0637"},
+	{0x1ee89, 0x1ee89, "\u064a"},
+	{0x1ee8b, 0x1ee8b, "\u0644"},
+	{0x1ee8c, 0x1ee8c, "\u0645"},
+	{0x1ee8d, 0x1ee8d, "\u0646"},
+	{0x1ee8e, 0x1ee8e, "\u0633"},
+	{0x1ee8f, 0x1ee8f, "\u0639"},
+	{0x1ee90, 0x1ee90, "\u0641"},
+	{0x1ee91, 0x1ee91, "\u0635"},
+	{0x1ee92, 0x1ee92, "\u0642"},
+	{0x1ee93, 0x1ee93, "\u0631"},
+	{0x1ee94, 0x1ee94, "\u0634"},
+	{0x1ee95, 0x1ee95, "\u062a"},
+	{0x1ee96, 0x1ee96, "\u062b"},
+	{0x1ee97, 0x1ee97, "\u062e"},
+	{0x1ee98, 0x1ee98, "\u0630"},
+	{0x1ee99, 0x1ee99, "\u0636"},
+	{0x1ee9a, 0x1ee9a, "\u0638"},
+	{0x1ee9b, 0x1ee9b, "\u063a"},
+	{0x1eea1, 0x1eea1, "\u0628"},
+	{0x1eea2, 0x1eea2, "\u062c"},
+	{0x1eea3, 0x1eea3, "\u062f"},
+	{0x1eea5, 0x1eea5, "\u0648"},
+	{0x1eea6, 0x1eea6, "\u0632"},
+	{0x1eea7, 0x1eea7, "\u062d"},
+	{0x1eea8, 0x1eea8, "\u0637"},
+	{0x1eea9, 0x1eea9, "\u064a"},
+	{0x1eeab, 0x1eeab, "\u0644"},
+	{0x1eeac, 0x1eeac, "\u0645"},
+	{0x1eead, 0x1eead, "\u0646"},
+	{0x1eeae, 0x1eeae, "\u0633"},
+	{0x1eeaf, 0x1eeaf, "\u0639"},
+	{0x1eeb0, 0x1eeb0, "\u0641"},
+	{0x1eeb1, 0x1eeb1, "\u0635"},
+	{0x1eeb2, 0x1eeb2, "\u0642"},
+	{0x1eeb3, 0x1eeb3, "\u0631"},
+	{0x1eeb4, 0x1eeb4, "\u0634"},
+	{0x1eeb5, 0x1eeb5, "\u062a"},
+	{0x1eeb6, 0x1eeb6, "\u062b"},
+	{0x1eeb7, 0x1eeb7, "\u062e"},
+	{0x1eeb8, 0x1eeb8, "\u0630"},
+	{0x1eeb9, 0x1eeb9, "\u0636"},
+	{0x1eeba, 0x1eeba, "\u0638"},
+	{0x1eebb, 0x1eebb, "\u063a"},
+	{0x1f12a, 0x1f12a, "\u3014s\u3015"},
+	{0x1f12b, 0x1f12b, "c"},
+	{0x1f12c, 0x1f12c, "r"},
+	{0x1f12d, 0x1f12d, "cd"},
+	{0x1f12e, 0x1f12e, "wz"},
+	{0x1f130, 0x1f130, "a"},
+	{0x1f131, 0x1f131, "b"},
+	{0x1f132, 0x1f132, "c"},
+	{0x1f133, 0x1f133, "d"},
+	{0x1f134, 0x1f134, "e"},
+	{0x1f135, 0x1f135, "f"},
+	{0x1f136, 0x1f136, "g"},
+	{0x1f137, 0x1f137, "h"},
+	{0x1f138, 0x1f138, "i"},
+	{0x1f139, 0x1f139, "j"},
+	{0x1f13a, 0x1f13a, "k"},
+	{0x1f13b, 0x1f13b, "l"},
+	{0x1f13c, 0x1f13c, "m"},
+	{0x1f13d, 0x1f13d, "n"},
+	{0x1f13e, 0x1f13e, "o"},
+	{0x1f13f, 0x1f13f, "p"},
+	{0x1f140, 0x1f140, "q"},
+	{0x1f141, 0x1f141, "r"},
+	{0x1f142, 0x1f142, "s"},
+	{0x1f143, 0x1f143, "t"},
+	{0x1f144, 0x1f144, "u"},
+	{0x1f145, 0x1f145, "v"},
+	{0x1f146, 0x1f146, "w"},
+	{0x1f147, 0x1f147, "x"},
+	{0x1f148, 0x1f148, "y"},
+	{0x1f149, 0x1f149, "z"},
+	{0x1f14a, 0x1f14a, "hv"},
+	{0x1f14b, 0x1f14b, "mv"},
+	{0x1f14c, 0x1f14c, "sd"},
+	{0x1f14d, 0x1f14d, "ss"},
+	{0x1f14e, 0x1f14e, "ppv"},
+	{0x1f14f, 0x1f14f, "wc"},
+	{0x1f16a, 0x1f16a, "mc"},
+	{0x1f16b, 0x1f16b, "md"},
+	{0x1f16c, 0x1f16c, "mr"},
+	{0x1f190, 0x1f190, "dj"},
+	{0x1f200, 0x1f200, "\u307b\u304b"},
+	{0x1f201, 0x1f201, "\u30b3\u30b3"},
+	{0x1f202, 0x1f202, "\u30b5"},
+	{0x1f210, 0x1f210, "\u624b"},
+	{0x1f211, 0x1f211, "\u5b57"},
+	{0x1f212, 0x1f212, "\u53cc"},
+	{0x1f213, 0x1f213, "\u30c7"},
+	{0x1f214, 0x1f214, "\u4e8c"},
+	{0x1f215, 0x1f215, "\u591a"},
+	{0x1f216, 0x1f216, "\u89e3"},
+	{0x1f217, 0x1f217, "\u5929"},
+	{0x1f218, 0x1f218, "\u4ea4"},
+	{0x1f219, 0x1f219, "\u6620"},
+	{0x1f21a, 0x1f21a, "\u7121"},
+	{0x1f21b, 0x1f21b, "\u6599"},
+	{0x1f21c, 0x1f21c, "\u524d"},
+	{0x1f21d, 0x1f21d, "\u5f8c"},
+	{0x1f21e, 0x1f21e, "\u518d"},
+	{0x1f21f, 0x1f21f, "\u65b0"},
+	{0x1f220, 0x1f220, "\u521d"},
+	{0x1f221, 0x1f221, "\u7d42"},
+	{0x1f222, 0x1f222, "\u751f"},
+	{0x1f223, 0x1f223, "\u8ca9"},
+	{0x1f224, 0x1f224, "\u58f0"},
+	{0x1f225, 0x1f225, "\u5439"},
+	{0x1f226, 0x1f226, "\u6f14"},
+	{0x1f227, 0x1f227, "\u6295"},
+	{0x1f228, 0x1f228, "\u6355"},
+	{0x1f229, 0x1f229, "\u4e00"},
+	{0x1f22a, 0x1f22a, "\u4e09"},
+	{0x1f22b, 0x1f22b, "\u904a"},
+	{0x1f22c, 0x1f22c, "\u5de6"},
+	{0x1f22d, 0x1f22d, "\u4e2d"},
+	{0x1f22e, 0x1f22e, "\u53f3"},
+	{0x1f22f, 0x1f22f, "\u6307"},
+	{0x1f230, 0x1f230, "\u8d70"},
+	{0x1f231, 0x1f231, "\u6253"},
+	{0x1f232, 0x1f232, "\u7981"},
+	{0x1f233, 0x1f233, "\u7a7a"},
+	{0x1f234, 0x1f234, "\u5408"},
+	{0x1f235, 0x1f235, "\u6e80"},
+	{0x1f236, 0x1f236, "\u6709"},
+	{0x1f237, 0x1f237, "\u6708"},
+	{0x1f238, 0x1f238, "\u7533"},
+	{0x1f239, 0x1f239, "\u5272"},
+	{0x1f23a, 0x1f23a, "\u55b6"},
+	{0x1f23b, 0x1f23b, "\u914d"},
+	{0x1f240, 0x1f240, "\u3014\u672c\u3015"},
+	{0x1f241, 0x1f241, "\u3014\u4e09\u3015"},
+	{0x1f242, 0x1f242, "\u3014\u4e8c\u3015"},
+	{0x1f243, 0x1f243, "\u3014\u5b89\u3015"},
+	{0x1f244, 0x1f244, "\u3014\u70b9\u3015"},
+	{0x1f245, 0x1f245, "\u3014\u6253\u3015"},
+	{0x1f246, 0x1f246, "\u3014\u76d7\u3015"},
+	{0x1f247, 0x1f247, "\u3014\u52dd\u3015"},
+	{0x1f248, 0x1f248, "\u3014\u6557\u3015"},
+	{0x1f250, 0x1f250, "\u5f97"},
+	{0x1f251, 0x1f251, "\u53ef"},
+	{0x1fbf0, 0x1fbf0, "0"},
+	{0x1fbf1, 0x1fbf1, "1"},
+	{0x1fbf2, 0x1fbf2, "2"},
+	{0x1fbf3, 0x1fbf3, "3"},
+	{0x1fbf4, 0x1fbf4, "4"},
+	{0x1fbf5, 0x1fbf5, "5"},
+	{0x1fbf6, 0x1fbf6, "6"},
+	{0x1fbf7, 0x1fbf7, "7"},
+	{0x1fbf8, 0x1fbf8, "8"},
+	{0x1fbf9, 0x1fbf9, "9"},
+	{0x2f800, 0x2f800, "\u4e3d"},
+	{0x2f801, 0x2f801, "\u4e38"},
+	{0x2f802, 0x2f802, "\u4e41"},
+	{0x2f803, 0x2f803, "\U00020122"},
+	{0x2f804, 0x2f804, "\u4f60"},
+	{0x2f805, 0x2f805, "\u4fae"},
+	{0x2f806, 0x2f806, "\u4fbb"},
+	{0x2f807, 0x2f807, "\u5002"},
+	{0x2f808, 0x2f808, "\u507a"},
+	{0x2f809, 0x2f809, "\u5099"},
+	{0x2f80a, 0x2f80a, "\u50e7"},
+	{0x2f80b, 0x2f80b, "\u50cf"},
+	{0x2f80c, 0x2f80c, "\u349e"},
+	{0x2f80d, 0x2f80d, "\U0002063a"},
+	{0x2f80e, 0x2f80e, "\u514d"},
+	{0x2f80f, 0x2f80f, "\u5154"},
+	{0x2f810, 0x2f810, "\u5164"},
+	{0x2f811, 0x2f811, "\u5177"},
+	{0x2f812, 0x2f812, "\U0002051c"},
+	{0x2f813, 0x2f813, "\u34b9"},
+	{0x2f814, 0x2f814, "\u5167"},
+	{0x2f815, 0x2f815, "\u518d"},
+	{0x2f816, 0x2f816, "\U0002054b"},
+	{0x2f817, 0x2f817, "\u5197"},
+	{0x2f818, 0x2f818, "\u51a4"},
+	{0x2f819, 0x2f819, "\u4ecc"},
+	{0x2f81a, 0x2f81a, "\u51ac"},
+	{0x2f81b, 0x2f81b, "\u51b5"},
+	{0x2f81c, 0x2f81c, "\U000291df"},
+	{0x2f81d, 0x2f81d, "\u51f5"},
+	{0x2f81e, 0x2f81e, "\u5203"},
+	{0x2f81f, 0x2f81f, "\u34df"},
+	{0x2f820, 0x2f820, "\u523b"},
+	{0x2f821, 0x2f821, "\u5246"},
+	{0x2f822, 0x2f822, "\u5272"},
+	{0x2f823, 0x2f823, "\u5277"},
+	{0x2f824, 0x2f824, "\u3515"},
+	{0x2f825, 0x2f825, "\u52c7"},
+	{0x2f826, 0x2f826, "\u52c9"},
+	{0x2f827, 0x2f827, "\u52e4"},
+	{0x2f828, 0x2f828, "\u52fa"},
+	{0x2f829, 0x2f829, "\u5305"},
+	{0x2f82a, 0x2f82a, "\u5306"},
+	{0x2f82b, 0x2f82b, "\u5317"},
+	{0x2f82c, 0x2f82c, "\u5349"},
+	{0x2f82d, 0x2f82d, "\u5351"},
+	{0x2f82e, 0x2f82e, "\u535a"},
+	{0x2f82f, 0x2f82f, "\u5373"},
+	{0x2f830, 0x2f830, "\u537d"},
+	{0x2f831, 0x2f833, "\u537f"},
+	{0x2f834, 0x2f834, "\U00020a2c"},
+	{0x2f835, 0x2f835, "\u7070"},
+	{0x2f836, 0x2f836, "\u53ca"},
+	{0x2f837, 0x2f837, "\u53df"},
+	{0x2f838, 0x2f838, "\U00020b63"},
+	{0x2f839, 0x2f839, "\u53eb"},
+	{0x2f83a, 0x2f83a, "\u53f1"},
+	{0x2f83b, 0x2f83b, "\u5406"},
+	{0x2f83c, 0x2f83c, "\u549e"},
+	{0x2f83d, 0x2f83d, "\u5438"},
+	{0x2f83e, 0x2f83e, "\u5448"},
+	{0x2f83f, 0x2f83f, "\u5468"},
+	{0x2f840, 0x2f840, "\u54a2"},
+	{0x2f841, 0x2f841, "\u54f6"},
+	{0x2f842, 0x2f842, "\u5510"},
+	{0x2f843, 0x2f843, "\u5553"},
+	{0x2f844, 0x2f844, "\u5563"},
+	{0x2f845, 0x2f846, "\u5584"},
+	{0x2f847, 0x2f847, "\u5599"},
+	{0x2f848, 0x2f848, "\u55ab"},
+	{0x2f849, 0x2f849, "\u55b3"},
+	{0x2f84a, 0x2f84a, "\u55c2"},
+	{0x2f84b, 0x2f84b, "\u5716"},
+	{0x2f84c, 0x2f84c, "\u5606"},
+	{0x2f84d, 0x2f84d, "\u5717"},
+	{0x2f84e, 0x2f84e, "\u5651"},
+	{0x2f84f, 0x2f84f, "\u5674"},
+	{0x2f850, 0x2f850, "\u5207"},
+	{0x2f851, 0x2f851, "\u58ee"},
+	{0x2f852, 0x2f852, "\u57ce"},
+	{0x2f853, 0x2f853, "\u57f4"},
+	{0x2f854, 0x2f854, "\u580d"},
+	{0x2f855, 0x2f855, "\u578b"},
+	{0x2f856, 0x2f856, "\u5832"},
+	{0x2f857, 0x2f857, "\u5831"},
+	{0x2f858, 0x2f858, "\u58ac"},
+	{0x2f859, 0x2f859, "\U000214e4"},
+	{0x2f85a, 0x2f85a, "\u58f2"},
+	{0x2f85b, 0x2f85b, "\u58f7"},
+	{0x2f85c, 0x2f85c, "\u5906"},
+	{0x2f85d, 0x2f85d, "\u591a"},
+	{0x2f85e, 0x2f85e, "\u5922"},
+	{0x2f85f, 0x2f85f, "\u5962"},
+	{0x2f860, 0x2f860, "\U000216a8"},
+	{0x2f861, 0x2f861, "\U000216ea"},
+	{0x2f862, 0x2f862, "\u59ec"},
+	{0x2f863, 0x2f863, "\u5a1b"},
+	{0x2f864, 0x2f864, "\u5a27"},
+	{0x2f865, 0x2f865, "\u59d8"},
+	{0x2f866, 0x2f866, "\u5a66"},
+	{0x2f867, 0x2f867, "\u36ee"},
+	{0x2f869, 0x2f869, "\u5b08"},
+	{0x2f86a, 0x2f86b, "\u5b3e"},
+	{0x2f86c, 0x2f86c, "\U000219c8"},
+	{0x2f86d, 0x2f86d, "\u5bc3"},
+	{0x2f86e, 0x2f86e, "\u5bd8"},
+	{0x2f86f, 0x2f86f, "\u5be7"},
+	{0x2f870, 0x2f870, "\u5bf3"},
+	{0x2f871, 0x2f871, "\U00021b18"},
+	{0x2f872, 0x2f872, "\u5bff"},
+	{0x2f873, 0x2f873, "\u5c06"},
+	{0x2f875, 0x2f875, "\u5c22"},
+	{0x2f876, 0x2f876, "\u3781"},
+	{0x2f877, 0x2f877, "\u5c60"},
+	{0x2f878, 0x2f878, "\u5c6e"},
+	{0x2f879, 0x2f879, "\u5cc0"},
+	{0x2f87a, 0x2f87a, "\u5c8d"},
+	{0x2f87b, 0x2f87b, "\U00021de4"},
+	{0x2f87c, 0x2f87c, "\u5d43"},
+	{0x2f87d, 0x2f87d, "\U00021de6"},
+	{0x2f87e, 0x2f87e, "\u5d6e"},
+	{0x2f87f, 0x2f87f, "\u5d6b"},
+	{0x2f880, 0x2f880, "\u5d7c"},
+	{0x2f881, 0x2f881, "\u5de1"},
+	{0x2f882, 0x2f882, "\u5de2"},
+	{0x2f883, 0x2f883, "\u382f"},
+	{0x2f884, 0x2f884, "\u5dfd"},
+	{0x2f885, 0x2f885, "\u5e28"},
+	{0x2f886, 0x2f886, "\u5e3d"},
+	{0x2f887, 0x2f887, "\u5e69"},
+	{0x2f888, 0x2f888, "\u3862"},
+	{0x2f889, 0x2f889, "\U00022183"},
+	{0x2f88a, 0x2f88a, "\u387c"},
+	{0x2f88b, 0x2f88b, "\u5eb0"},
+	{0x2f88c, 0x2f88c, "\u5eb3"},
+	{0x2f88d, 0x2f88d, "\u5eb6"},
+	{0x2f88e, 0x2f88e, "\u5eca"},
+	{0x2f88f, 0x2f88f, "\U0002a392"},
+	{0x2f890, 0x2f890, "\u5efe"},
+	{0x2f891, 0x2f892, "\U00022331"},
+	{0x2f893, 0x2f893, "\u8201"},
+	{0x2f894, 0x2f895, "\u5f22"},
+	{0x2f896, 0x2f896, "\u38c7"},
+	{0x2f897, 0x2f897, "\U000232b8"},
+	{0x2f898, 0x2f898, "\U000261da"},
+	{0x2f899, 0x2f899, "\u5f62"},
+	{0x2f89a, 0x2f89a, "\u5f6b"},
+	{0x2f89b, 0x2f89b, "\u38e3"},
+	{0x2f89c, 0x2f89c, "\u5f9a"},
+	{0x2f89d, 0x2f89d, "\u5fcd"},
+	{0x2f89e, 0x2f89e, "\u5fd7"},
+	{0x2f89f, 0x2f89f, "\u5ff9"},
+	{0x2f8a0, 0x2f8a0, "\u6081"},
+	{0x2f8a1, 0x2f8a1, "\u393a"},
+	{0x2f8a2, 0x2f8a2, "\u391c"},
+	{0x2f8a3, 0x2f8a3, "\u6094"},
+	{0x2f8a4, 0x2f8a4, "\U000226d4"},
+	{0x2f8a5, 0x2f8a5, "\u60c7"},
+	{0x2f8a6, 0x2f8a6, "\u6148"},
+	{0x2f8a7, 0x2f8a7, "\u614c"},
+	{0x2f8a8, 0x2f8a8, "\u614e"},
+	{0x2f8a9, 0x2f8a9, "\u614c"},
+	{0x2f8aa, 0x2f8aa, "\u617a"},
+	{0x2f8ab, 0x2f8ab, "\u618e"},
+	{0x2f8ac, 0x2f8ac, "\u61b2"},
+	{0x2f8ad, 0x2f8ad, "\u61a4"},
+	{0x2f8ae, 0x2f8ae, "\u61af"},
+	{0x2f8af, 0x2f8af, "\u61de"},
+	{0x2f8b0, 0x2f8b0, "\u61f2"},
+	{0x2f8b1, 0x2f8b1, "\u61f6"},
+	{0x2f8b2, 0x2f8b2, "\u6210"},
+	{0x2f8b3, 0x2f8b3, "\u621b"},
+	{0x2f8b4, 0x2f8b4, "\u625d"},
+	{0x2f8b5, 0x2f8b5, "\u62b1"},
+	{0x2f8b6, 0x2f8b6, "\u62d4"},
+	{0x2f8b7, 0x2f8b7, "\u6350"},
+	{0x2f8b8, 0x2f8b8, "\U00022b0c"},
+	{0x2f8b9, 0x2f8b9, "\u633d"},
+	{0x2f8ba, 0x2f8ba, "\u62fc"},
+	{0x2f8bb, 0x2f8bb, "\u6368"},
+	{0x2f8bc, 0x2f8bc, "\u6383"},
+	{0x2f8bd, 0x2f8bd, "\u63e4"},
+	{0x2f8be, 0x2f8be, "\U00022bf1"},
+	{0x2f8bf, 0x2f8bf, "\u6422"},
+	{0x2f8c0, 0x2f8c0, "\u63c5"},
+	{0x2f8c1, 0x2f8c1, "\u63a9"},
+	{0x2f8c2, 0x2f8c2, "\u3a2e"},
+	{0x2f8c3, 0x2f8c3, "\u6469"},
+	{0x2f8c4, 0x2f8c4, "\u647e"},
+	{0x2f8c5, 0x2f8c5, "\u649d"},
+	{0x2f8c6, 0x2f8c6, "\u6477"},
+	{0x2f8c7, 0x2f8c7, "\u3a6c"},
+	{0x2f8c8, 0x2f8c8, "\u654f"},
+	{0x2f8c9, 0x2f8c9, "\u656c"},
+	{0x2f8ca, 0x2f8ca, "\U0002300a"},
+	{0x2f8cb, 0x2f8cb, "\u65e3"},
+	{0x2f8cc, 0x2f8cc, "\u66f8"},
+	{0x2f8cd, 0x2f8cd, "\u6649"},
+	{0x2f8ce, 0x2f8ce, "\u3b19"},
+	{0x2f8cf, 0x2f8cf, "\u6691"},
+	{0x2f8d0, 0x2f8d0, "\u3b08"},
+	{0x2f8d1, 0x2f8d1, "\u3ae4"},
+	{0x2f8d2, 0x2f8d2, "\u5192"},
+	{0x2f8d3, 0x2f8d3, "\u5195"},
+	{0x2f8d4, 0x2f8d4, "\u6700"},
+	{0x2f8d5, 0x2f8d5, "\u669c"},
+	{0x2f8d6, 0x2f8d6, "\u80ad"},
+	{0x2f8d7, 0x2f8d7, "\u43d9"},
+	{0x2f8d8, 0x2f8d8, "\u6717"},
+	{0x2f8d9, 0x2f8d9, "\u671b"},
+	{0x2f8da, 0x2f8da, "\u6721"},
+	{0x2f8db, 0x2f8db, "\u675e"},
+	{0x2f8dc, 0x2f8dc, "\u6753"},
+	{0x2f8dd, 0x2f8dd, "\U000233c3"},
+	{0x2f8de, 0x2f8de, "\u3b49"},
+	{0x2f8df, 0x2f8df, "\u67fa"},
+	{0x2f8e0, 0x2f8e0, "\u6785"},
+	{0x2f8e1, 0x2f8e1, "\u6852"},
+	{0x2f8e2, 0x2f8e2, "\u6885"},
+	{0x2f8e3, 0x2f8e3, "\U0002346d"},
+	{0x2f8e4, 0x2f8e4, "\u688e"},
+	{0x2f8e5, 0x2f8e5, "\u681f"},
+	{0x2f8e6, 0x2f8e6, "\u6914"},
+	{0x2f8e7, 0x2f8e7, "\u3b9d"},
+	{0x2f8e8, 0x2f8e8, "\u6942"},
+	{0x2f8e9, 0x2f8e9, "\u69a3"},
+	{0x2f8ea, 0x2f8ea, "\u69ea"},
+	{0x2f8eb, 0x2f8eb, "\u6aa8"},
+	{0x2f8ec, 0x2f8ec, "\U000236a3"},
+	{0x2f8ed, 0x2f8ed, "\u6adb"},
+	{0x2f8ee, 0x2f8ee, "\u3c18"},
+	{0x2f8ef, 0x2f8ef, "\u6b21"},
+	{0x2f8f0, 0x2f8f0, "\U000238a7"},
+	{0x2f8f1, 0x2f8f1, "\u6b54"},
+	{0x2f8f2, 0x2f8f2, "\u3c4e"},
+	{0x2f8f3, 0x2f8f3, "\u6b72"},
+	{0x2f8f4, 0x2f8f4, "\u6b9f"},
+	{0x2f8f5, 0x2f8f5, "\u6bba"},
+	{0x2f8f6, 0x2f8f6, "\u6bbb"},
+	{0x2f8f7, 0x2f8f7, "\U00023a8d"},
+	{0x2f8f8, 0x2f8f8, "\U00021d0b"},
+	{0x2f8f9, 0x2f8f9, "\U00023afa"},
+	{0x2f8fa, 0x2f8fa, "\u6c4e"},
+	{0x2f8fb, 0x2f8fb, "\U00023cbc"},
+	{0x2f8fc, 0x2f8fc, "\u6cbf"},
+	{0x2f8fd, 0x2f8fd, "\u6ccd"},
+	{0x2f8fe, 0x2f8fe, "\u6c67"},
+	{0x2f8ff, 0x2f8ff, "\u6d16"},
+	{0x2f900, 0x2f900, "\u6d3e"},
+	{0x2f901, 0x2f901, "\u6d77"},
+	{0x2f902, 0x2f902, "\u6d41"},
+	{0x2f903, 0x2f903, "\u6d69"},
+	{0x2f904, 0x2f904, "\u6d78"},
+	{0x2f905, 0x2f905, "\u6d85"},
+	{0x2f906, 0x2f906, "\U00023d1e"},
+	{0x2f907, 0x2f907, "\u6d34"},
+	{0x2f908, 0x2f908, "\u6e2f"},
+	{0x2f909, 0x2f909, "\u6e6e"},
+	{0x2f90a, 0x2f90a, "\u3d33"},
+	{0x2f90b, 0x2f90b, "\u6ecb"},
+	{0x2f90c, 0x2f90c, "\u6ec7"},
+	{0x2f90d, 0x2f90d, "\U00023ed1"},
+	{0x2f90e, 0x2f90e, "\u6df9"},
+	{0x2f90f, 0x2f90f, "\u6f6e"},
+	{0x2f910, 0x2f910, "\U00023f5e"},
+	{0x2f911, 0x2f911, "\U00023f8e"},
+	{0x2f912, 0x2f912, "\u6fc6"},
+	{0x2f913, 0x2f913, "\u7039"},
+	{0x2f914, 0x2f914, "\u701e"},
+	{0x2f915, 0x2f915, "\u701b"},
+	{0x2f916, 0x2f916, "\u3d96"},
+	{0x2f917, 0x2f917, "\u704a"},
+	{0x2f918, 0x2f918, "\u707d"},
+	{0x2f919, 0x2f919, "\u7077"},
+	{0x2f91a, 0x2f91a, "\u70ad"},
+	{0x2f91b, 0x2f91b, "\U00020525"},
+	{0x2f91c, 0x2f91c, "\u7145"},
+	{0x2f91d, 0x2f91d, "\U00024263"},
+	{0x2f91e, 0x2f91e, "\u719c"},
+	{0x2f920, 0x2f920, "\u7228"},
+	{0x2f921, 0x2f921, "\u7235"},
+	{0x2f922, 0x2f922, "\u7250"},
+	{0x2f923, 0x2f923, "\U00024608"},
+	{0x2f924, 0x2f924, "\u7280"},
+	{0x2f925, 0x2f925, "\u7295"},
+	{0x2f926, 0x2f926, "\U00024735"},
+	{0x2f927, 0x2f927, "\U00024814"},
+	{0x2f928, 0x2f928, "\u737a"},
+	{0x2f929, 0x2f929, "\u738b"},
+	{0x2f92a, 0x2f92a, "\u3eac"},
+	{0x2f92b, 0x2f92b, "\u73a5"},
+	{0x2f92c, 0x2f92d, "\u3eb8"},
+	{0x2f92e, 0x2f92e, "\u7447"},
+	{0x2f92f, 0x2f92f, "\u745c"},
+	{0x2f930, 0x2f930, "\u7471"},
+	{0x2f931, 0x2f931, "\u7485"},
+	{0x2f932, 0x2f932, "\u74ca"},
+	{0x2f933, 0x2f933, "\u3f1b"},
+	{0x2f934, 0x2f934, "\u7524"},
+	{0x2f935, 0x2f935, "\U00024c36"},
+	{0x2f936, 0x2f936, "\u753e"},
+	{0x2f937, 0x2f937, "\U00024c92"},
+	{0x2f938, 0x2f938, "\u7570"},
+	{0x2f939, 0x2f939, "\U0002219f"},
+	{0x2f93a, 0x2f93a, "\u7610"},
+	{0x2f93b, 0x2f93b, "\U00024fa1"},
+	{0x2f93c, 0x2f93c, "\U00024fb8"},
+	{0x2f93d, 0x2f93d, "\U00025044"},
+	{0x2f93e, 0x2f93e, "\u3ffc"},
+	{0x2f93f, 0x2f93f, "\u4008"},
+	{0x2f940, 0x2f940, "\u76f4"},
+	{0x2f941, 0x2f941, "\U000250f3"},
+	{0x2f942, 0x2f942, "\U000250f2"},
+	{0x2f943, 0x2f943, "\U00025119"},
+	{0x2f944, 0x2f944, "\U00025133"},
+	{0x2f945, 0x2f945, "\u771e"},
+	{0x2f946, 0x2f947, "\u771f"},
+	{0x2f948, 0x2f948, "\u774a"},
+	{0x2f949, 0x2f949, "\u4039"},
+	{0x2f94a, 0x2f94a, "\u778b"},
+	{0x2f94b, 0x2f94b, "\u4046"},
+	{0x2f94c, 0x2f94c, "\u4096"},
+	{0x2f94d, 0x2f94d, "\U0002541d"},
+	{0x2f94e, 0x2f94e, "\u784e"},
+	{0x2f94f, 0x2f94f, "\u788c"},
+	{0x2f950, 0x2f950, "\u78cc"},
+	{0x2f951, 0x2f951, "\u40e3"},
+	{0x2f952, 0x2f952, "\U00025626"},
+	{0x2f953, 0x2f953, "\u7956"},
+	{0x2f954, 0x2f954, "\U0002569a"},
+	{0x2f955, 0x2f955, "\U000256c5"},
+	{0x2f956, 0x2f956, "\u798f"},
+	{0x2f957, 0x2f957, "\u79eb"},
+	{0x2f958, 0x2f958, "\u412f"},
+	{0x2f959, 0x2f959, "\u7a40"},
+	{0x2f95a, 0x2f95a, "\u7a4a"},
+	{0x2f95b, 0x2f95b, "\u7a4f"},
+	{0x2f95c, 0x2f95c, "\U0002597c"},
+	{0x2f95d, 0x2f95e, "\U00025aa7"},
+	{0x2f960, 0x2f960, "\u4202"},
+	{0x2f961, 0x2f961, "\U00025bab"},
+	{0x2f962, 0x2f962, "\u7bc6"},
+	{0x2f963, 0x2f963, "\u7bc9"},
+	{0x2f964, 0x2f964, "\u4227"},
+	{0x2f965, 0x2f965, "\U00025c80"},
+	{0x2f966, 0x2f966, "\u7cd2"},
+	{0x2f967, 0x2f967, "\u42a0"},
+	{0x2f968, 0x2f968, "\u7ce8"},
+	{0x2f969, 0x2f969, "\u7ce3"},
+	{0x2f96a, 0x2f96a, "\u7d00"},
+	{0x2f96b, 0x2f96b, "\U00025f86"},
+	{0x2f96c, 0x2f96c, "\u7d63"},
+	{0x2f96d, 0x2f96d, "\u4301"},
+	{0x2f96e, 0x2f96e, "\u7dc7"},
+	{0x2f96f, 0x2f96f, "\u7e02"},
+	{0x2f970, 0x2f970, "\u7e45"},
+	{0x2f971, 0x2f971, "\u4334"},
+	{0x2f972, 0x2f972, "\U00026228"},
+	{0x2f973, 0x2f973, "\U00026247"},
+	{0x2f974, 0x2f974, "\u4359"},
+	{0x2f975, 0x2f975, "\U000262d9"},
+	{0x2f976, 0x2f976, "\u7f7a"},
+	{0x2f977, 0x2f977, "\U0002633e"},
+	{0x2f978, 0x2f978, "\u7f95"},
+	{0x2f979, 0x2f979, "\u7ffa"},
+	{0x2f97a, 0x2f97a, "\u8005"},
+	{0x2f97b, 0x2f97b, "\U000264da"},
+	{0x2f97c, 0x2f97c, "\U00026523"},
+	{0x2f97d, 0x2f97d, "\u8060"},
+	{0x2f97e, 0x2f97e, "\U000265a8"},
+	{0x2f97f, 0x2f97f, "\u8070"},
+	{0x2f980, 0x2f980, "\U0002335f"},
+	{0x2f981, 0x2f981, "\u43d5"},
+	{0x2f982, 0x2f982, "\u80b2"},
+	{0x2f983, 0x2f983, "\u8103"},
+	{0x2f984, 0x2f984, "\u440b"},
+	{0x2f985, 0x2f985, "\u813e"},
+	{0x2f986, 0x2f986, "\u5ab5"},
+	{0x2f987, 0x2f987, "\U000267a7"},
+	{0x2f988, 0x2f988, "\U000267b5"},
+	{0x2f989, 0x2f989, "\U00023393"},
+	{0x2f98a, 0x2f98a, "\U0002339c"},
+	{0x2f98b, 0x2f98b, "\u8201"},
+	{0x2f98c, 0x2f98c, "\u8204"},
+	{0x2f98d, 0x2f98d, "\u8f9e"},
+	{0x2f98e, 0x2f98e, "\u446b"},
+	{0x2f98f, 0x2f98f, "\u8291"},
+	{0x2f990, 0x2f990, "\u828b"},
+	{0x2f991, 0x2f991, "\u829d"},
+	{0x2f992, 0x2f992, "\u52b3"},
+	{0x2f993, 0x2f993, "\u82b1"},
+	{0x2f994, 0x2f994, "\u82b3"},
+	{0x2f995, 0x2f995, "\u82bd"},
+	{0x2f996, 0x2f996, "\u82e6"},
+	{0x2f997, 0x2f997, "\U00026b3c"},
+	{0x2f998, 0x2f998, "\u82e5"},
+	{0x2f999, 0x2f999, "\u831d"},
+	{0x2f99a, 0x2f99a, "\u8363"},
+	{0x2f99b, 0x2f99b, "\u83ad"},
+	{0x2f99c, 0x2f99c, "\u8323"},
+	{0x2f99d, 0x2f99d, "\u83bd"},
+	{0x2f99e, 0x2f99e, "\u83e7"},
+	{0x2f99f, 0x2f99f, "\u8457"},
+	{0x2f9a0, 0x2f9a0, "\u8353"},
+	{0x2f9a1, 0x2f9a1, "\u83ca"},
+	{0x2f9a2, 0x2f9a2, "\u83cc"},
+	{0x2f9a3, 0x2f9a3, "\u83dc"},
+	{0x2f9a4, 0x2f9a4, "\U00026c36"},
+	{0x2f9a5, 0x2f9a5, "\U00026d6b"},
+	{0x2f9a6, 0x2f9a6, "\U00026cd5"},
+	{0x2f9a7, 0x2f9a7, "\u452b"},
+	{0x2f9a8, 0x2f9a8, "\u84f1"},
+	{0x2f9a9, 0x2f9a9, "\u84f3"},
+	{0x2f9aa, 0x2f9aa, "\u8516"},
+	{0x2f9ab, 0x2f9ab, "\U000273ca"},
+	{0x2f9ac, 0x2f9ac, "\u8564"},
+	{0x2f9ad, 0x2f9ad, "\U00026f2c"},
+	{0x2f9ae, 0x2f9ae, "\u455d"},
+	{0x2f9af, 0x2f9af, "\u4561"},
+	{0x2f9b0, 0x2f9b0, "\U00026fb1"},
+	{0x2f9b1, 0x2f9b1, "\U000270d2"},
+	{0x2f9b2, 0x2f9b2, "\u456b"},
+	{0x2f9b3, 0x2f9b3, "\u8650"},
+	{0x2f9b4, 0x2f9b4, "\u865c"},
+	{0x2f9b5, 0x2f9b5, "\u8667"},
+	{0x2f9b6, 0x2f9b6, "\u8669"},
+	{0x2f9b7, 0x2f9b7, "\u86a9"},
+	{0x2f9b8, 0x2f9b8, "\u8688"},
+	{0x2f9b9, 0x2f9b9, "\u870e"},
+	{0x2f9ba, 0x2f9ba, "\u86e2"},
+	{0x2f9bb, 0x2f9bb, "\u8779"},
+	{0x2f9bc, 0x2f9bc, "\u8728"},
+	{0x2f9bd, 0x2f9bd, "\u876b"},
+	{0x2f9be, 0x2f9be, "\u8786"},
+	{0x2f9c0, 0x2f9c0, "\u87e1"},
+	{0x2f9c1, 0x2f9c1, "\u8801"},
+	{0x2f9c2, 0x2f9c2, "\u45f9"},
+	{0x2f9c3, 0x2f9c3, "\u8860"},
+	{0x2f9c4, 0x2f9c4, "\u8863"},
+	{0x2f9c5, 0x2f9c5, "\U00027667"},
+	{0x2f9c6, 0x2f9c6, "\u88d7"},
+	{0x2f9c7, 0x2f9c7, "\u88de"},
+	{0x2f9c8, 0x2f9c8, "\u4635"},
+	{0x2f9c9, 0x2f9c9, "\u88fa"},
+	{0x2f9ca, 0x2f9ca, "\u34bb"},
+	{0x2f9cb, 0x2f9cb, "\U000278ae"},
+	{0x2f9cc, 0x2f9cc, "\U00027966"},
+	{0x2f9cd, 0x2f9cd, "\u46be"},
+	{0x2f9ce, 0x2f9ce, "\u46c7"},
+	{0x2f9cf, 0x2f9cf, "\u8aa0"},
+	{0x2f9d0, 0x2f9d0, "\u8aed"},
+	{0x2f9d1, 0x2f9d1, "\u8b8a"},
+	{0x2f9d2, 0x2f9d2, "\u8c55"},
+	{0x2f9d3, 0x2f9d3, "\U00027ca8"},
+	{0x2f9d4, 0x2f9d4, "\u8cab"},
+	{0x2f9d5, 0x2f9d5, "\u8cc1"},
+	{0x2f9d6, 0x2f9d6, "\u8d1b"},
+	{0x2f9d7, 0x2f9d7, "\u8d77"},
+	{0x2f9d8, 0x2f9d8, "\U00027f2f"},
+	{0x2f9d9, 0x2f9d9, "\U00020804"},
+	{0x2f9da, 0x2f9da, "\u8dcb"},
+	{0x2f9db, 0x2f9db, "\u8dbc"},
+	{0x2f9dc, 0x2f9dc, "\u8df0"},
+	{0x2f9dd, 0x2f9dd, "\U000208de"},
+	{0x2f9de, 0x2f9de, "\u8ed4"},
+	{0x2f9df, 0x2f9df, "\u8f38"},
+	{0x2f9e0, 0x2f9e0, "\U000285d2"},
+	{0x2f9e1, 0x2f9e1, "\U000285ed"},
+	{0x2f9e2, 0x2f9e2, "\u9094"},
+	{0x2f9e3, 0x2f9e3, "\u90f1"},
+	{0x2f9e4, 0x2f9e4, "\u9111"},
+	{0x2f9e5, 0x2f9e5, "\U0002872e"},
+	{0x2f9e6, 0x2f9e6, "\u911b"},
+	{0x2f9e7, 0x2f9e7, "\u9238"},
+	{0x2f9e8, 0x2f9e8, "\u92d7"},
+	{0x2f9e9, 0x2f9e9, "\u92d8"},
+	{0x2f9ea, 0x2f9ea, "\u927c"},
+	{0x2f9eb, 0x2f9eb, "\u93f9"},
+	{0x2f9ec, 0x2f9ec, "\u9415"},
+	{0x2f9ed, 0x2f9ed, "\U00028bfa"},
+	{0x2f9ee, 0x2f9ee, "\u958b"},
+	{0x2f9ef, 0x2f9ef, "\u4995"},
+	{0x2f9f0, 0x2f9f0, "\u95b7"},
+	{0x2f9f1, 0x2f9f1, "\U00028d77"},
+	{0x2f9f2, 0x2f9f2, "\u49e6"},
+	{0x2f9f3, 0x2f9f3, "\u96c3"},
+	{0x2f9f4, 0x2f9f4, "\u5db2"},
+	{0x2f9f5, 0x2f9f5, "\u9723"},
+	{0x2f9f6, 0x2f9f6, "\U00029145"},
+	{0x2f9f7, 0x2f9f7, "\U0002921a"},
+	{0x2f9f8, 0x2f9f8, "\u4a6e"},
+	{0x2f9f9, 0x2f9f9, "\u4a76"},
+	{0x2f9fa, 0x2f9fa, "\u97e0"},
+	{0x2f9fb, 0x2f9fb, "\U0002940a"},
+	{0x2f9fc, 0x2f9fc, "\u4ab2"},
+	{0x2f9fd, 0x2f9fd, "\U00029496"},
+	{0x2f9fe, 0x2f9ff, "\u980b"},
+	{0x2fa00, 0x2fa00, "\u9829"},
+	{0x2fa01, 0x2fa01, "\U000295b6"},
+	{0x2fa02, 0x2fa02, "\u98e2"},
+	{0x2fa03, 0x2fa03, "\u4b33"},
+	{0x2fa04, 0x2fa04, "\u9929"},
+	{0x2fa05, 0x2fa05, "\u99a7"},
+	{0x2fa06, 0x2fa06, "\u99c2"},
+	{0x2fa07, 0x2fa07, "\u99fe"},
+	{0x2fa08, 0x2fa08, "\u4bce"},
+	{0x2fa09, 0x2fa09, "\U00029b30"},
+	{0x2fa0a, 0x2fa0a, "\u9b12"},
+	{0x2fa0b, 0x2fa0b, "\u9c40"},
+	{0x2fa0c, 0x2fa0c, "\u9cfd"},
+	{0x2fa0d, 0x2fa0d, "\u4cce"},
+	{0x2fa0e, 0x2fa0e, "\u4ced"},
+	{0x2fa0f, 0x2fa0f, "\u9d67"},
+	{0x2fa10, 0x2fa10, "\U0002a0ce"},
+	{0x2fa11, 0x2fa11, "\u4cf8"},
+	{0x2fa12, 0x2fa12, "\U0002a105"},
+	{0x2fa13, 0x2fa13, "\U0002a20e"},
+	{0x2fa14, 0x2fa14, "\U0002a291"},
+	{0x2fa15, 0x2fa15, "\u9ebb"},
+	{0x2fa16, 0x2fa16, "\u4d56"},
+	{0x2fa17, 0x2fa17, "\u9ef9"},
+	{0x2fa18, 0x2fa18, "\u9efe"},
+	{0x2fa19, 0x2fa19, "\u9f05"},
+	{0x2fa1a, 0x2fa1a, "\u9f0f"},
+	{0x2fa1b, 0x2fa1b, "\u9f16"},
+	{0x2fa1c, 0x2fa1c, "\u9f3b"},
+	{0x2fa1d, 0x2fa1d, "\U0002a600"},
+}
+
+// emojiSequences are the fully-qualified emoji without presentation selectors
+var emojiSequences = []string{
+	"#\u20e3",
+	"*\u20e3",
+	"0\u20e3",
+	"1\u20e3",
+	"2\u20e3",
+	"3\u20e3",
+	"4\u20e3",
+	"5\u20e3",
+	"6\u20e3",
+	"7\u20e3",
+	"8\u20e3",
+	"9\u20e3",
+	"\u00a9",
+	"\u00ae",
+	"\u203c",
+	"\u2049",
+	"\u2194",
+	"\u2195",
+	"\u2196",
+	"\u2197",
+	"\u2198",
+	"\u2199",
+	"\u21a9",
+	"\u21aa",
+	"\u231a",
+	"\u231b",
+	"\u2328",
+	"\u23cf",
+	"\u23e9",
+	"\u23ea",
+	"\u23eb",
+	"\u23ec",
+	"\u23ed",
+	"\u23ee",
+	"\u23ef",
+	"\u23f0",
+	"\u23f1",
+	"\u23f2",
+	"\u23f3",
+	"\u23f8",
+	"\u23f9",
+	"\u23fa",
+	"\u25aa",
+	"\u25ab",
+	"\u25b6",
+	"\u25c0",
+	"\u25fb",
+	"\u25fc",
+	"\u25fd",
+	"\u25fe",
+	"\u2600",
+	"\u2601",
+	"\u2602",
+	"\u2603",
+	"\u2604",
+	"\u260e",
+	"\u2611",
+	"\u2614",
+	"\u2615",
+	"\u2618",
+	"\u261d",
+	"\u261d\U0001f3fb",
+	"\u261d\U0001f3fc",
+	"\u261d\U0001f3fd",
+	"\u261d\U0001f3fe",
+	"\u261d\U0001f3ff",
+	"\u2620",
+	"\u2622",
+	"\u2623",
+	"\u2626",
+	"\u262a",
+	"\u262e",
+	"\u262f",
+	"\u2638",
+	"\u2639",
+	"\u263a",
+	"\u2640",
+	"\u2642",
+	"\u2648",
+	"\u2649",
+	"\u264a",
+	"\u264b",
+	"\u264c",
+	"\u264d",
+	"\u264e",
+	"\u264f",
+	"\u2650",
+	"\u2651",
+	"\u2652",
+	"\u2653",
+	"\u265f",
+	"\u2660",
+	"\u2663",
+	"\u2665",
+	"\u2666",
+	"\u2668",
+	"\u267b",
+	"\u267e",
+	"\u267f",
+	"\u2692",
+	"\u2693",
+	"\u2694",
+	"\u2695",
+	"\u2696",
+	"\u2697",
+	"\u2699",
+	"\u269b",
+	"\u269c",
+	"\u26a0",
+	"\u26a1",
+	"\u26a7",
+	"\u26aa",
+	"\u26ab",
+	"\u26b0",
+	"\u26b1",
+	"\u26bd",
+	"\u26be",
+	"\u26c4",
+	"\u26c5",
+	"\u26c8",
+	"\u26ce",
+	"\u26cf",
+	"\u26d1",
+	"\u26d3",
+	"\u26d3\u200d\U0001f4a5",
+	"\u26d4",
+	"\u26e9",
+	"\u26ea",
+	"\u26f0",
+	"\u26f1",
+	"\u26f2",
+	"\u26f3",
+	"\u26f4",
+	"\u26f5",
+	"\u26f7",
+	"\u26f8",
+	"\u26f9",
+	"\u26f9\u200d\u2640",
+	"\u26f9\u200d\u2642",
+	"\u26f9\U0001f3fb",
+	"\u26f9\U0001f3fb\u200d\u2640",
+	"\u26f9\U0001f3fb\u200d\u2642",
+	"\u26f9\U0001f3fc",
+	"\u26f9\U0001f3fc\u200d\u2640",
+	"\u26f9\U0001f3fc\u200d\u2642",
+	"\u26f9\U0001f3fd",
+	"\u26f9\U0001f3fd\u200d\u2640",
+	"\u26f9\U0001f3fd\u200d\u2642",
+	"\u26f9\U0001f3fe",
+	"\u26f9\U0001f3fe\u200d\u2640",
+	"\u26f9\U0001f3fe\u200d\u2642",
+	"\u26f9\U0001f3ff",
+	"\u26f9\U0001f3ff\u200d\u2640",
+	"\u26f9\U0001f3ff\u200d\u2642",
+	"\u26fa",
+	"\u26fd",
+	"\u2702",
+	"\u2705",
+	"\u2708",
+	"\u2709",
+	"\u270a",
+	"\u270a\U0001f3fb",
+	"\u270a\U0001f3fc",
+	"\u270a\U0001f3fd",
+	"\u270a\U0001f3fe",
+	"\u270a\U0001f3ff",
+	"\u270b",
+	"\u270b\U0001f3fb",
+	"\u270b\U0001f3fc",
+	"\u270b\U0001f3fd",
+	"\u270b\U0001f3fe",
+	"\u270b\U0001f3ff",
+	"\u270c",
+	"\u270c\U0001f3fb",
+	"\u270c\U0001f3fc",
+	"\u270c\U0001f3fd",
+	"\u270c\U0001f3fe",
+	"\u270c\U0001f3ff",
+	"\u270d",
+	"\u270d\U0001f3fb",
+	"\u270d\U0001f3fc",
+	"\u270d\U0001f3fd",
+	"\u270d\U0001f3fe",
+	"\u270d\U0001f3ff",
+	"\u270f",
+	"\u2712",
+	"\u2714",
+	"\u2716",
+	"\u271d",
+	"\u2721",
+	"\u2728",
+	"\u2733",
+	"\u2734",
+	"\u2744",
+	"\u2747",
+	"\u274c",
+	"\u274e",
+	"\u2753",
+	"\u2754",
+	"\u2755",
+	"\u2757",
+	"\u2763",
+	"\u2764",
+	"\u2764\u200d\U0001f525",
+	"\u2764\u200d\U0001fa79",
+	"\u2795",
+	"\u2796",
+	"\u2797",
+	"\u27a1",
+	"\u27b0",
+	"\u27bf",
+	"\u2934",
+	"\u2935",
+	"\u2b05",
+	"\u2b06",
+	"\u2b07",
+	"\u2b1b",
+	"\u2b1c",
+	"\u2b50",
+	"\u2b55",
+	"\u3030",
+	"\u303d",
+	"\U0001f004",
+	"\U0001f0cf",
+	"\U0001f170",
+	"\U0001f171",
+	"\U0001f17e",
+	"\U0001f17f",
+	"\U0001f18e",
+	"\U0001f191",
+	"\U0001f192",
+	"\U0001f193",
+	"\U0001f194",
+	"\U0001f195",
+	"\U0001f196",
+	"\U0001f197",
+	"\U0001f198",
+	"\U0001f199",
+	"\U0001f19a",
+	"\U0001f1e6\U0001f1e8",
+	"\U0001f1e6\U0001f1e9",
+	"\U0001f1e6\U0001f1ea",
+	"\U0001f1e6\U0001f1eb",
+	"\U0001f1e6\U0001f1ec",
+	"\U0001f1e6\U0001f1ee",
+	"\U0001f1e6\U0001f1f1",
+	"\U0001f1e6\U0001f1f2",
+	"\U0001f1e6\U0001f1f4",
+	"\U0001f1e6\U0001f1f6",
+	"\U0001f1e6\U0001f1f7",
+	"\U0001f1e6\U0001f1f8",
+	"\U0001f1e6\U0001f1f9",
+	"\U0001f1e6\U0001f1fa",
+	"\U0001f1e6\U0001f1fc",
+	"\U0001f1e6\U0001f1fd",
+	"\U0001f1e6\U0001f1ff",
+	"\U0001f1e7\U0001f1e6",
+	"\U0001f1e7\U0001f1e7",
+	"\U0001f1e7\U0001f1e9",
+	"\U0001f1e7\U0001f1ea",
+	"\U0001f1e7\U0001f1eb",
+	"\U0001f1e7\U0001f1ec",
+	"\U0001f1e7\U0001f1ed",
+	"\U0001f1e7\U0001f1ee",
+	"\U0001f1e7\U0001f1ef",
+	"\U0001f1e7\U0001f1f1",
+	"\U0001f1e7\U0001f1f2",
+	"\U0001f1e7\U0001f1f3",
+	"\U0001f1e7\U0001f1f4",
+	"\U0001f1e7\U0001f1f6",
+	"\U0001f1e7\U0001f1f7",
+	"\U0001f1e7\U0001f1f8",
+	"\U0001f1e7\U0001f1f9",
+	"\U0001f1e7\U0001f1fb",
+	"\U0001f1e7\U0001f1fc",
+	"\U0001f1e7\U0001f1fe",
+	"\U0001f1e7\U0001f1ff",
+	"\U0001f1e8\U0001f1e6",
+	"\U0001f1e8\U0001f1e8",
+	"\U0001f1e8\U0001f1e9",
+	"\U0001f1e8\U0001f1eb",
+	"\U0001f1e8\U0001f1ec",
+	"\U0001f1e8\U0001f1ed",
+	"\U0001f1e8\U0001f1ee",
+	"\U0001f1e8\U0001f1f0",
+	"\U0001f1e8\U0001f1f1",
+	"\U0001f1e8\U0001f1f2",
+	"\U0001f1e8\U0001f1f3",
+	"\U0001f1e8\U0001f1f4",
+	"\U0001f1e8\U0001f1f5",
+	"\U0001f1e8\U0001f1f7",
+	"\U0001f1e8\U0001f1fa",
+	"\U0001f1e8\U0001f1fb",
+	"\U0001f1e8\U0001f1fc",
+	"\U0001f1e8\U0001f1fd",
+	"\U0001f1e8\U0001f1fe",
+	"\U0001f1e8\U0001f1ff",
+	"\U0001f1e9\U0001f1ea",
+	"\U0001f1e9\U0001f1ec",
+	"\U0001f1e9\U0001f1ef",
+	"\U0001f1e9\U0001f1f0",
+	"\U0001f1e9\U0001f1f2",
+	"\U0001f1e9\U0001f1f4",
+	"\U0001f1e9\U0001f1ff",
+	"\U0001f1ea\U0001f1e6",
+	"\U0001f1ea\U0001f1e8",
+	"\U0001f1ea\U0001f1ea",
+	"\U0001f1ea\U0001f1ec",
+	"\U0001f1ea\U0001f1ed",
+	"\U0001f1ea\U0001f1f7",
+	"\U0001f1ea\U0001f1f8",
+	"\U0001f1ea\U0001f1f9",
+	"\U0001f1ea\U0001f1fa",
+	"\U0001f1eb\U0001f1ee",
+	"\U0001f1eb\U0001f1ef",
+	"\U0001f1eb\U0001f1f0",
+	"\U0001f1eb\U0001f1f2",
+	"\U0001f1eb\U0001f1f4",
+	"\U0001f1eb\U0001f1f7",
+	"\U0001f1ec\U0001f1e6",
+	"\U0001f1ec\U0001f1e7",
+	"\U0001f1ec\U0001f1e9",
+	"\U0001f1ec\U0001f1ea",
+	"\U0001f1ec\U0001f1eb",
+	"\U0001f1ec\U0001f1ec",
+	"\U0001f1ec\U0001f1ed",
+	"\U0001f1ec\U0001f1ee",
+	"\U0001f1ec\U0001f1f1",
+	"\U0001f1ec\U0001f1f2",
+	"\U0001f1ec\U0001f1f3",
+	"\U0001f1ec\U0001f1f5",
+	"\U0001f1ec\U0001f1f6",
+	"\U0001f1ec\U0001f1f7",
+	"\U0001f1ec\U0001f1f8",
+	"\U0001f1ec\U0001f1f9",
+	"\U0001f1ec\U0001f1fa",
+	"\U0001f1ec\U0001f1fc",
+	"\U0001f1ec\U0001f1fe",
+	"\U0001f1ed\U0001f1f0",
+	"\U0001f1ed\U0001f1f2",
+	"\U0001f1ed\U0001f1f3",
+	"\U0001f1ed\U0001f1f7",
+	"\U0001f1ed\U0001f1f9",
+	"\U0001f1ed\U0001f1fa",
+	"\U0001f1ee\U0001f1e8",
+	"\U0001f1ee\U0001f1e9",
+	"\U0001f1ee\U0001f1ea",
+	"\U0001f1ee\U0001f1f1",
+	"\U0001f1ee\U0001f1f2",
+	"\U0001f1ee\U0001f1f3",
+	"\U0001f1ee\U0001f1f4",
+	"\U0001f1ee\U0001f1f6",
+	"\U0001f1ee\U0001f1f7",
+	"\U0001f1ee\U0001f1f8",
+	"\U0001f1ee\U0001f1f9",
+	"\U0001f1ef\U0001f1ea",
+	"\U0001f1ef\U0001f1f2",
+	"\U0001f1ef\U0001f1f4",
+	"\U0001f1ef\U0001f1f5",
+	"\U0001f1f0\U0001f1ea",
+	"\U0001f1f0\U0001f1ec",
+	"\U0001f1f0\U0001f1ed",
+	"\U0001f1f0\U0001f1ee",
+	"\U0001f1f0\U0001f1f2",
+	"\U0001f1f0\U0001f1f3",
+	"\U0001f1f0\U0001f1f5",
+	"\U0001f1f0\U0001f1f7",
+	"\U0001f1f0\U0001f1fc",
+	"\U0001f1f0\U0001f1fe",
+	"\U0001f1f0\U0001f1ff",
+	"\U0001f1f1\U0001f1e6",
+	"\U0001f1f1\U0001f1e7",
+	"\U0001f1f1\U0001f1e8",
+	"\U0001f1f1\U0001f1ee",
+	"\U0001f1f1\U0001f1f0",
+	"\U0001f1f1\U0001f1f7",
+	"\U0001f1f1\U0001f1f8",
+	"\U0001f1f1\U0001f1f9",
+	"\U0001f1f1\U0001f1fa",
+	"\U0001f1f1\U0001f1fb",
+	"\U0001f1f1\U0001f1fe",
+	"\U0001f1f2\U0001f1e6",
+	"\U0001f1f2\U0001f1e8",
+	"\U0001f1f2\U0001f1e9",
+	"\U0001f1f2\U0001f1ea",
+	"\U0001f1f2\U0001f1eb",
+	"\U0001f1f2\U0001f1ec",
+	"\U0001f1f2\U0001f1ed",
+	"\U0001f1f2\U0001f1f0",
+	"\U0001f1f2\U0001f1f1",
+	"\U0001f1f2\U0001f1f2",
+	"\U0001f1f2\U0001f1f3",
+	"\U0001f1f2\U0001f1f4",
+	"\U0001f1f2\U0001f1f5",
+	"\U0001f1f2\U0001f1f6",
+	"\U0001f1f2\U0001f1f7",
+	"\U0001f1f2\U0001f1f8",
+	"\U0001f1f2\U0001f1f9",
+	"\U0001f1f2\U0001f1fa",
+	"\U0001f1f2\U0001f1fb",
+	"\U0001f1f2\U0001f1fc",
+	"\U0001f1f2\U0001f1fd",
+	"\U0001f1f2\U0001f1fe",
+	"\U0001f1f2\U0001f1ff",
+	"\U0001f1f3\U0001f1e6",
+	"\U0001f1f3\U0001f1e8",
+	"\U0001f1f3\U0001f1ea",
+	"\U0001f1f3\U0001f1eb",
+	"\U0001f1f3\U0001f1ec",
+	"\U0001f1f3\U0001f1ee",
+	"\U0001f1f3\U0001f1f1",
+	"\U0001f1f3\U0001f1f4",
+	"\U0001f1f3\U0001f1f5",
+	"\U0001f1f3\U0001f1f7",
+	"\U0001f1f3\U0001f1fa",
+	"\U0001f1f3\U0001f1ff",
+	"\U0001f1f4\U0001f1f2",
+	"\U0001f1f5\U0001f1e6",
+	"\U0001f1f5\U0001f1ea",
+	"\U0001f1f5\U0001f1eb",
+	"\U0001f1f5\U0001f1ec",
+	"\U0001f1f5\U0001f1ed",
+	"\U0001f1f5\U0001f1f0",
+	"\U0001f1f5\U0001f1f1",
+	"\U0001f1f5\U0001f1f2",
+	"\U0001f1f5\U0001f1f3",
+	"\U0001f1f5\U0001f1f7",
+	"\U0001f1f5\U0001f1f8",
+	"\U0001f1f5\U0001f1f9",
+	"\U0001f1f5\U0001f1fc",
+	"\U0001f1f5\U0001f1fe",
+	"\U0001f1f6\U0001f1e6",
+	"\U0001f1f7\U0001f1ea",
+	"\U0001f1f7\U0001f1f4",
+	"\U0001f1f7\U0001f1f8",
+	"\U0001f1f7\U0001f1fa",
+	"\U0001f1f7\U0001f1fc",
+	"\U0001f1f8\U0001f1e6",
+	"\U0001f1f8\U0001f1e7",
+	"\U0001f1f8\U0001f1e8",
+	"\U0001f1f8\U0001f1e9",
+	"\U0001f1f8\U0001f1ea",
+	"\U0001f1f8\U0001f1ec",
+	"\U0001f1f8\U0001f1ed",
+	"\U0001f1f8\U0001f1ee",
+	"\U0001f1f8\U0001f1ef",
+	"\U0001f1f8\U0001f1f0",
+	"\U0001f1f8\U0001f1f1",
+	"\U0001f1f8\U0001f1f2",
+	"\U0001f1f8\U0001f1f3",
+	"\U0001f1f8\U0001f1f4",
+	"\U0001f1f8\U0001f1f7",
+	"\U0001f1f8\U0001f1f8",
+	"\U0001f1f8\U0001f1f9",
+	"\U0001f1f8\U0001f1fb",
+	"\U0001f1f8\U0001f1fd",
+	"\U0001f1f8\U0001f1fe",
+	"\U0001f1f8\U0001f1ff",
+	"\U0001f1f9\U0001f1e6",
+	"\U0001f1f9\U0001f1e8",
+	"\U0001f1f9\U0001f1e9",
+	"\U0001f1f9\U0001f1eb",
+	"\U0001f1f9\U0001f1ec",
+	"\U0001f1f9\U0001f1ed",
+	"\U0001f1f9\U0001f1ef",
+	"\U0001f1f9\U0001f1f0",
+	"\U0001f1f9\U0001f1f1",
+	"\U0001f1f9\U0001f1f2",
+	"\U0001f1f9\U0001f1f3",
+	"\U0001f1f9\U0001f1f4",
+	"\U0001f1f9\U0001f1f7",
+	"\U0001f1f9\U0001f1f9",
+	"\U0001f1f9\U0001f1fb",
+	"\U0001f1f9\U0001f1fc",
+	"\U0001f1f9\U0001f1ff",
+	"\U0001f1fa\U0001f1e6",
+	"\U0001f1fa\U0001f1ec",
+	"\U0001f1fa\U0001f1f2",
+	"\U0001f1fa\U0001f1f3",
+	"\U0001f1fa\U0001f1f8",
+	"\U0001f1fa\U0001f1fe",
+	"\U0001f1fa\U0001f1ff",
+	"\U0001f1fb\U0001f1e6",
+	"\U0001f1fb\U0001f1e8",
+	"\U0001f1fb\U0001f1ea",
+	"\U0001f1fb\U0001f1ec",
+	"\U0001f1fb\U0001f1ee",
+	"\U0001f1fb\U0001f1f3",
+	"\U0001f1fb\U0001f1fa",
+	"\U0001f1fc\U0001f1eb",
+	"\U0001f1fc\U0001f1f8",
+	"\U0001f1fd\U0001f1f0",
+	"\U0001f1fe\U0001f1ea",
+	"\U0001f1fe\U0001f1f9",
+	"\U0001f1ff\U0001f1e6",
+	"\U0001f1ff\U0001f1f2",
+	"\U0001f1ff\U0001f1fc",
+	"\U0001f300",
+	"\U0001f301",
+	"\U0001f302",
+	"\U0001f303",
+	"\U0001f304",
+	"\U0001f305",
+	"\U0001f306",
+	"\U0001f307",
+	"\U0001f308",
+	"\U0001f309",
+	"\U0001f30a",
+	"\U0001f30b",
+	"\U0001f30c",
+	"\U0001f30d",
+	"\U0001f30e",
+	"\U0001f30f",
+	"\U0001f310",
+	"\U0001f311",
+	"\U0001f312",
+	"\U0001f313",
+	"\U0001f314",
+	"\U0001f315",
+	"\U0001f316",
+	"\U0001f317",
+	"\U0001f318",
+	"\U0001f319",
+	"\U0001f31a",
+	"\U0001f31b",
+	"\U0001f31c",
+	"\U0001f31d",
+	"\U0001f31e",
+	"\U0001f31f",
+	"\U0001f320",
+	"\U0001f321",
+	"\U0001f324",
+	"\U0001f325",
+	"\U0001f326",
+	"\U0001f327",
+	"\U0001f328",
+	"\U0001f329",
+	"\U0001f32a",
+	"\U0001f32b",
+	"\U0001f32c",
+	"\U0001f32d",
+	"\U0001f32e",
+	"\U0001f32f",
+	"\U0001f330",
+	"\U0001f331",
+	"\U0001f332",
+	"\U0001f333",
+	"\U0001f334",
+	"\U0001f335",
+	"\U0001f336",
+	"\U0001f337",
+	"\U0001f338",
+	"\U0001f339",
+	"\U0001f33a",
+	"\U0001f33b",
+	"\U0001f33c",
+	"\U0001f33d",
+	"\U0001f33e",
+	"\U0001f33f",
+	"\U0001f340",
+	"\U0001f341",
+	"\U0001f342",
+	"\U0001f343",
+	"\U0001f344",
+	"\U0001f344\u200d\U0001f7eb",
+	"\U0001f345",
+	"\U0001f346",
+	"\U0001f347",
+	"\U0001f348",
+	"\U0001f349",
+	"\U0001f34a",
+	"\U0001f34b",
+	"\U0001f34b\u200d\U0001f7e9",
+	"\U0001f34c",
+	"\U0001f34d",
+	"\U0001f34e",
+	"\U0001f34f",
+	"\U0001f350",
+	"\U0001f351",
+	"\U0001f352",
+	"\U0001f353",
+	"\U0001f354",
+	"\U0001f355",
+	"\U0001f356",
+	"\U0001f357",
+	"\U0001f358",
+	"\U0001f359",
+	"\U0001f35a",
+	"\U0001f35b",
+	"\U0001f35c",
+	"\U0001f35d",
+	"\U0001f35e",
+	"\U0001f35f",
+	"\U0001f360",
+	"\U0001f361",
+	"\U0001f362",
+	"\U0001f363",
+	"\U0001f364",
+	"\U0001f365",
+	"\U0001f366",
+	"\U0001f367",
+	"\U0001f368",
+	"\U0001f369",
+	"\U0001f36a",
+	"\U0001f36b",
+	"\U0001f36c",
+	"\U0001f36d",
+	"\U0001f36e",
+	"\U0001f36f",
+	"\U0001f370",
+	"\U0001f371",
+	"\U0001f372",
+	"\U0001f373",
+	"\U0001f374",
+	"\U0001f375",
+	"\U0001f376",
+	"\U0001f377",
+	"\U0001f378",
+	"\U0001f379",
+	"\U0001f37a",
+	"\U0001f37b",
+	"\U0001f37c",
+	"\U0001f37d",
+	"\U0001f37e",
+	"\U0001f37f",
+	"\U0001f380",
+	"\U0001f381",
+	"\U0001f382",
+	"\U0001f383",
+	"\U0001f384",
+	"\U0001f385",
+	"\U0001f385\U0001f3fb",
+	"\U0001f385\U0001f3fc",
+	"\U0001f385\U0001f3fd",
+	"\U0001f385\U0001f3fe",
+	"\U0001f385\U0001f3ff",
+	"\U0001f386",
+	"\U0001f387",
+	"\U0001f388",
+	"\U0001f389",
+	"\U0001f38a",
+	"\U0001f38b",
+	"\U0001f38c",
+	"\U0001f38d",
+	"\U0001f38e",
+	"\U0001f38f",
+	"\U0001f390",
+	"\U0001f391",
+	"\U0001f392",
+	"\U0001f393",
+	"\U0001f396",
+	"\U0001f397",
+	"\U0001f399",
+	"\U0001f39a",
+	"\U0001f39b",
+	"\U0001f39e",
+	"\U0001f39f",
+	"\U0001f3a0",
+	"\U0001f3a1",
+	"\U0001f3a2",
+	"\U0001f3a3",
+	"\U0001f3a4",
+	"\U0001f3a5",
+	"\U0001f3a6",
+	"\U0001f3a7",
+	"\U0001f3a8",
+	"\U0001f3a9",
+	"\U0001f3aa",
+	"\U0001f3ab",
+	"\U0001f3ac",
+	"\U0001f3ad",
+	"\U0001f3ae",
+	"\U0001f3af",
+	"\U0001f3b0",
+	"\U0001f3b1",
+	"\U0001f3b2",
+	"\U0001f3b3",
+	"\U0001f3b4",
+	"\U0001f3b5",
+	"\U0001f3b6",
+	"\U0001f3b7",
+	"\U0001f3b8",
+	"\U0001f3b9",
+	"\U0001f3ba",
+	"\U0001f3bb",
+	"\U0001f3bc",
+	"\U0001f3bd",
+	"\U0001f3be",
+	"\U0001f3bf",
+	"\U0001f3c0",
+	"\U0001f3c1",
+	"\U0001f3c2",
+	"\U0001f3c2\U0001f3fb",
+	"\U0001f3c2\U0001f3fc",
+	"\U0001f3c2\U0001f3fd",
+	"\U0001f3c2\U0001f3fe",
+	"\U0001f3c2\U0001f3ff",
+	"\U0001f3c3",
+	"\U0001f3c3\u200d\u2640",
+	"\U0001f3c3\u200d\u2640\u200d\u27a1",
+	"\U0001f3c3\u200d\u2642",
+	"\U0001f3c3\u200d\u2642\u200d\u27a1",
+	"\U0001f3c3\u200d\u27a1",
+	"\U0001f3c3\U0001f3fb",
+	"\U0001f3c3\U0001f3fb\u200d\u2640",
+	"\U0001f3c3\U0001f3fb\u200d\u2640\u200d\u27a1",
+	"\U0001f3c3\U0001f3fb\u200d\u2642",
+	"\U0001f3c3\U0001f3fb\u200d\u2642\u200d\u27a1",
+	"\U0001f3c3\U0001f3fb\u200d\u27a1",
+	"\U0001f3c3\U0001f3fc",
+	"\U0001f3c3\U0001f3fc\u200d\u2640",
+	"\U0001f3c3\U0001f3fc\u200d\u2640\u200d\u27a1",
+	"\U0001f3c3\U0001f3fc\u200d\u2642",
+	"\U0001f3c3\U0001f3fc\u200d\u2642\u200d\u27a1",
+	"\U0001f3c3\U0001f3fc\u200d\u27a1",
+	"\U0001f3c3\U0001f3fd",
+	"\U0001f3c3\U0001f3fd\u200d\u2640",
+	"\U0001f3c3\U0001f3fd\u200d\u2640\u200d\u27a1",
+	"\U0001f3c3\U0001f3fd\u200d\u2642",
+	"\U0001f3c3\U0001f3fd\u200d\u2642\u200d\u27a1",
+	"\U0001f3c3\U0001f3fd\u200d\u27a1",
+	"\U0001f3c3\U0001f3fe",
+	"\U0001f3c3\U0001f3fe\u200d\u2640",
+	"\U0001f3c3\U0001f3fe\u200d\u2640\u200d\u27a1",
+	"\U0001f3c3\U0001f3fe\u200d\u2642",
+	"\U0001f3c3\U0001f3fe\u200d\u2642\u200d\u27a1",
+	"\U0001f3c3\U0001f3fe\u200d\u27a1",
+	"\U0001f3c3\U0001f3ff",
+	"\U0001f3c3\U0001f3ff\u200d\u2640",
+	"\U0001f3c3\U0001f3ff\u200d\u2640\u200d\u27a1",
+	"\U0001f3c3\U0001f3ff\u200d\u2642",
+	"\U0001f3c3\U0001f3ff\u200d\u2642\u200d\u27a1",
+	"\U0001f3c3\U0001f3ff\u200d\u27a1",
+	"\U0001f3c4",
+	"\U0001f3c4\u200d\u2640",
+	"\U0001f3c4\u200d\u2642",
+	"\U0001f3c4\U0001f3fb",
+	"\U0001f3c4\U0001f3fb\u200d\u2640",
+	"\U0001f3c4\U0001f3fb\u200d\u2642",
+	"\U0001f3c4\U0001f3fc",
+	"\U0001f3c4\U0001f3fc\u200d\u2640",
+	"\U0001f3c4\U0001f3fc\u200d\u2642",
+	"\U0001f3c4\U0001f3fd",
+	"\U0001f3c4\U0001f3fd\u200d\u2640",
+	"\U0001f3c4\U0001f3fd\u200d\u2642",
+	"\U0001f3c4\U0001f3fe",
+	"\U0001f3c4\U0001f3fe\u200d\u2640",
+	"\U0001f3c4\U0001f3fe\u200d\u2642",
+	"\U0001f3c4\U0001f3ff",
+	"\U0001f3c4\U0001f3ff\u200d\u2640",
+	"\U0001f3c4\U0001f3ff\u200d\u2642",
+	"\U0001f3c5",
+	"\U0001f3c6",
+	"\U0001f3c7",
+	"\U0001f3c7\U0001f3fb",
+	"\U0001f3c7\U0001f3fc",
+	"\U0001f3c7\U0001f3fd",
+	"\U0001f3c7\U0001f3fe",
+	"\U0001f3c7\U0001f3ff",
+	"\U0001f3c8",
+	"\U0001f3c9",
+	"\U0001f3ca",
+	"\U0001f3ca\u200d\u2640",
+	"\U0001f3ca\u200d\u2642",
+	"\U0001f3ca\U0001f3fb",
+	"\U0001f3ca\U0001f3fb\u200d\u2640",
+	"\U0001f3ca\U0001f3fb\u200d\u2642",
+	"\U0001f3ca\U0001f3fc",
+	"\U0001f3ca\U0001f3fc\u200d\u2640",
+	"\U0001f3ca\U0001f3fc\u200d\u2642",
+	"\U0001f3ca\U0001f3fd",
+	"\U0001f3ca\U0001f3fd\u200d\u2640",
+	"\U0001f3ca\U0001f3fd\u200d\u2642",
+	"\U0001f3ca\U0001f3fe",
+	"\U0001f3ca\U0001f3fe\u200d\u2640",
+	"\U0001f3ca\U0001f3fe\u200d\u2642",
+	"\U0001f3ca\U0001f3ff",
+	"\U0001f3ca\U0001f3ff\u200d\u2640",
+	"\U0001f3ca\U0001f3ff\u200d\u2642",
+	"\U0001f3cb",
+	"\U0001f3cb\u200d\u2640",
+	"\U0001f3cb\u200d\u2642",
+	"\U0001f3cb\U0001f3fb",
+	"\U0001f3cb\U0001f3fb\u200d\u2640",
+	"\U0001f3cb\U0001f3fb\u200d\u2642",
+	"\U0001f3cb\U0001f3fc",
+	"\U0001f3cb\U0001f3fc\u200d\u2640",
+	"\U0001f3cb\U0001f3fc\u200d\u2642",
+	"\U0001f3cb\U0001f3fd",
+	"\U0001f3cb\U0001f3fd\u200d\u2640",
+	"\U0001f3cb\U0001f3fd\u200d\u2642",
+	"\U0001f3cb\U0001f3fe",
+	"\U0001f3cb\U0001f3fe\u200d\u2640",
+	"\U0001f3cb\U0001f3fe\u200d\u2642",
+	"\U0001f3cb\U0001f3ff",
+	"\U0001f3cb\U0001f3ff\u200d\u2640",
+	"\U0001f3cb\U0001f3ff\u200d\u2642",
+	"\U0001f3cc",
+	"\U0001f3cc\u200d\u2640",
+	"\U0001f3cc\u200d\u2642",
+	"\U0001f3cc\U0001f3fb",
+	"\U0001f3cc\U0001f3fb\u200d\u2640",
+	"\U0001f3cc\U0001f3fb\u200d\u2642",
+	"\U0001f3cc\U0001f3fc",
+	"\U0001f3cc\U0001f3fc\u200d\u2640",
+	"\U0001f3cc\U0001f3fc\u200d\u2642",
+	"\U0001f3cc\U0001f3fd",
+	"\U0001f3cc\U0001f3fd\u200d\u2640",
+	"\U0001f3cc\U0001f3fd\u200d\u2642",
+	"\U0001f3cc\U0001f3fe",
+	"\U0001f3cc\U0001f3fe\u200d\u2640",
+	"\U0001f3cc\U0001f3fe\u200d\u2642",
+	"\U0001f3cc\U0001f3ff",
+	"\U0001f3cc\U0001f3ff\u200d\u2640",
+	"\U0001f3cc\U0001f3ff\u200d\u2642",
+	"\U0001f3cd",
+	"\U0001f3ce",
+	"\U0001f3cf",
+	"\U0001f3d0",
+	"\U0001f3d1",
+	"\U0001f3d2",
+	"\U0001f3d3",
+	"\U0001f3d4",
+	"\U0001f3d5",
+	"\U0001f3d6",
+	"\U0001f3d7",
+	"\U0001f3d8",
+	"\U0001f3d9",
+	"\U0001f3da",
+	"\U0001f3db",
+	"\U0001f3dc",
+	"\U0001f3dd",
+	"\U0001f3de",
+	"\U0001f3df",
+	"\U0001f3e0",
+	"\U0001f3e1",
+	"\U0001f3e2",
+	"\U0001f3e3",
+	"\U0001f3e4",
+	"\U0001f3e5",
+	"\U0001f3e6",
+	"\U0001f3e7",
+	"\U0001f3e8",
+	"\U0001f3e9",
+	"\U0001f3ea",
+	"\U0001f3eb",
+	"\U0001f3ec",
+	"\U0001f3ed",
+	"\U0001f3ee",
+	"\U0001f3ef",
+	"\U0001f3f0",
+	"\U0001f3f3",
+	"\U0001f3f3\u200d\u26a7",
+	"\U0001f3f3\u200d\U0001f308",
+	"\U0001f3f4",
+	"\U0001f3f4\u200d\u2620",
+	"\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f",
+	"\U0001f3f4\U000e0067\U000e0062\U000e0073\U000e0063\U000e0074\U000e007f",
+	"\U0001f3f4\U000e0067\U000e0062\U000e0077\U000e006c\U000e0073\U000e007f",
+	"\U0001f3f5",
+	"\U0001f3f7",
+	"\U0001f3f8",
+	"\U0001f3f9",
+	"\U0001f3fa",
+	"\U0001f400",
+	"\U0001f401",
+	"\U0001f402",
+	"\U0001f403",
+	"\U0001f404",
+	"\U0001f405",
+	"\U0001f406",
+	"\U0001f407",
+	"\U0001f408",
+	"\U0001f408\u200d\u2b1b",
+	"\U0001f409",
+	"\U0001f40a",
+	"\U0001f40b",
+	"\U0001f40c",
+	"\U0001f40d",
+	"\U0001f40e",
+	"\U0001f40f",
+	"\U0001f410",
+	"\U0001f411",
+	"\U0001f412",
+	"\U0001f413",
+	"\U0001f414",
+	"\U0001f415",
+	"\U0001f415\u200d\U0001f9ba",
+	"\U0001f416",
+	"\U0001f417",
+	"\U0001f418",
+	"\U0001f419",
+	"\U0001f41a",
+	"\U0001f41b",
+	"\U0001f41c",
+	"\U0001f41d",
+	"\U0001f41e",
+	"\U0001f41f",
+	"\U0001f420",
+	"\U0001f421",
+	"\U0001f422",
+	"\U0001f423",
+	"\U0001f424",
+	"\U0001f425",
+	"\U0001f426",
+	"\U0001f426\u200d\u2b1b",
+	"\U0001f426\u200d\U0001f525",
+	"\U0001f427",
+	"\U0001f428",
+	"\U0001f429",
+	"\U0001f42a",
+	"\U0001f42b",
+	"\U0001f42c",
+	"\U0001f42d",
+	"\U0001f42e",
+	"\U0001f42f",
+	"\U0001f430",
+	"\U0001f431",
+	"\U0001f432",
+	"\U0001f433",
+	"\U0001f434",
+	"\U0001f435",
+	"\U0001f436",
+	"\U0001f437",
+	"\U0001f438",
+	"\U0001f439",
+	"\U0001f43a",
+	"\U0001f43b",
+	"\U0001f43b\u200d\u2744",
+	"\U0001f43c",
+	"\U0001f43d",
+	"\U0001f43e",
+	"\U0001f43f",
+	"\U0001f440",
+	"\U0001f441",
+	"\U0001f441\u200d\U0001f5e8",
+	"\U0001f442",
+	"\U0001f442\U0001f3fb",
+	"\U0001f442\U0001f3fc",
+	"\U0001f442\U0001f3fd",
+	"\U0001f442\U0001f3fe",
+	"\U0001f442\U0001f3ff",
+	"\U0001f443",
+	"\U0001f443\U0001f3fb",
+	"\U0001f443\U0001f3fc",
+	"\U0001f443\U0001f3fd",
+	"\U0001f443\U0001f3fe",
+	"\U0001f443\U0001f3ff",
+	"\U0001f444",
+	"\U0001f445",
+	"\U0001f446",
+	"\U0001f446\U0001f3fb",
+	"\U0001f446\U0001f3fc",
+	"\U0001f446\U0001f3fd",
+	"\U0001f446\U0001f3fe",
+	"\U0001f446\U0001f3ff",
+	"\U0001f447",
+	"\U0001f447\U0001f3fb",
+	"\U0001f447\U0001f3fc",
+	"\U0001f447\U0001f3fd",
+	"\U0001f447\U0001f3fe",
+	"\U0001f447\U0001f3ff",
+	"\U0001f448",
+	"\U0001f448\U0001f3fb",
+	"\U0001f448\U0001f3fc",
+	"\U0001f448\U0001f3fd",
+	"\U0001f448\U0001f3fe",
+	"\U0001f448\U0001f3ff",
+	"\U0001f449",
+	"\U0001f449\U0001f3fb",
+	"\U0001f449\U0001f3fc",
+	"\U0001f449\U0001f3fd",
+	"\U0001f449\U0001f3fe",
+	"\U0001f449\U0001f3ff",
+	"\U0001f44a",
+	"\U0001f44a\U0001f3fb",
+	"\U0001f44a\U0001f3fc",
+	"\U0001f44a\U0001f3fd",
+	"\U0001f44a\U0001f3fe",
+	"\U0001f44a\U0001f3ff",
+	"\U0001f44b",
+	"\U0001f44b\U0001f3fb",
+	"\U0001f44b\U0001f3fc",
+	"\U0001f44b\U0001f3fd",
+	"\U0001f44b\U0001f3fe",
+	"\U0001f44b\U0001f3ff",
+	"\U0001f44c",
+	"\U0001f44c\U0001f3fb",
+	"\U0001f44c\U0001f3fc",
+	"\U0001f44c\U0001f3fd",
+	"\U0001f44c\U0001f3fe",
+	"\U0001f44c\U0001f3ff",
+	"\U0001f44d",
+	"\U0001f44d\U0001f3fb",
+	"\U0001f44d\U0001f3fc",
+	"\U0001f44d\U0001f3fd",
+	"\U0001f44d\U0001f3fe",
+	"\U0001f44d\U0001f3ff",
+	"\U0001f44e",
+	"\U0001f44e\U0001f3fb",
+	"\U0001f44e\U0001f3fc",
+	"\U0001f44e\U0001f3fd",
+	"\U0001f44e\U0001f3fe",
+	"\U0001f44e\U0001f3ff",
+	"\U0001f44f",
+	"\U0001f44f\U0001f3fb",
+	"\U0001f44f\U0001f3fc",
+	"\U0001f44f\U0001f3fd",
+	"\U0001f44f\U0001f3fe",
+	"\U0001f44f\U0001f3ff",
+	"\U0001f450",
+	"\U0001f450\U0001f3fb",
+	"\U0001f450\U0001f3fc",
+	"\U0001f450\U0001f3fd",
+	"\U0001f450\U0001f3fe",
+	"\U0001f450\U0001f3ff",
+	"\U0001f451",
+	"\U0001f452",
+	"\U0001f453",
+	"\U0001f454",
+	"\U0001f455",
+	"\U0001f456",
+	"\U0001f457",
+	"\U0001f458",
+	"\U0001f459",
+	"\U0001f45a",
+	"\U0001f45b",
+	"\U0001f45c",
+	"\U0001f45d",
+	"\U0001f45e",
+	"\U0001f45f",
+	"\U0001f460",
+	"\U0001f461",
+	"\U0001f462",
+	"\U0001f463",
+	"\U0001f464",
+	"\U0001f465",
+	"\U0001f466",
+	"\U0001f466\U0001f3fb",
+	"\U0001f466\U0001f3fc",
+	"\U0001f466\U0001f3fd",
+	"\U0001f466\U0001f3fe",
+	"\U0001f466\U0001f3ff",
+	"\U0001f467",
+	"\U0001f467\U0001f3fb",
+	"\U0001f467\U0001f3fc",
+	"\U0001f467\U0001f3fd",
+	"\U0001f467\U0001f3fe",
+	"\U0001f467\U0001f3ff",
+	"\U0001f468",
+	"\U0001f468\u200d\u2695",
+	"\U0001f468\u200d\u2696",
+	"\U0001f468\u200d\u2708",
+	"\U0001f468\u200d\u2764\u200d\U0001f468",
+	"\U0001f468\u200d\u2764\u200d\U0001f48b\u200d\U0001f468",
+	"\U0001f468\u200d\U0001f33e",
+	"\U0001f468\u200d\U0001f373",
+	"\U0001f468\u200d\U0001f37c",
+	"\U0001f468\u200d\U0001f393",
+	"\U0001f468\u200d\U0001f3a4",
+	"\U0001f468\u200d\U0001f3a8",
+	"\U0001f468\u200d\U0001f3eb",
+	"\U0001f468\u200d\U0001f3ed",
+	"\U0001f468\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f466\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f467",
+	"\U0001f468\u200d\U0001f467\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f467\u200d\U0001f467",
+	"\U0001f468\u200d\U0001f468\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f468\u200d\U0001f466\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467",
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f468\u200d\U0001f467\u200d\U0001f467",
+	"\U0001f468\u200d\U0001f469\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467",
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466",
+	"\U0001f468\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467",
+	"\U0001f468\u200d\U0001f4bb",
+	"\U0001f468\u200d\U0001f4bc",
+	"\U0001f468\u200d\U0001f527",
+	"\U0001f468\u200d\U0001f52c",
+	"\U0001f468\u200d\U0001f680",
+	"\U0001f468\u200d\U0001f692",
+	"\U0001f468\u200d\U0001f9af",
+	"\U0001f468\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f468\u200d\U0001f9b0",
+	"\U0001f468\u200d\U0001f9b1",
+	"\U0001f468\u200d\U0001f9b2",
+	"\U0001f468\u200d\U0001f9b3",
+	"\U0001f468\u200d\U0001f9bc",
+	"\U0001f468\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f468\u200d\U0001f9bd",
+	"\U0001f468\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fb\u200d\u2695",
+	"\U0001f468\U0001f3fb\u200d\u2696",
+	"\U0001f468\U0001f3fb\u200d\u2708",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fb\u200d\U0001f33e",
+	"\U0001f468\U0001f3fb\u200d\U0001f373",
+	"\U0001f468\U0001f3fb\u200d\U0001f37c",
+	"\U0001f468\U0001f3fb\u200d\U0001f393",
+	"\U0001f468\U0001f3fb\u200d\U0001f3a4",
+	"\U0001f468\U0001f3fb\u200d\U0001f3a8",
+	"\U0001f468\U0001f3fb\u200d\U0001f3eb",
+	"\U0001f468\U0001f3fb\u200d\U0001f3ed",
+	"\U0001f468\U0001f3fb\u200d\U0001f4bb",
+	"\U0001f468\U0001f3fb\u200d\U0001f4bc",
+	"\U0001f468\U0001f3fb\u200d\U0001f527",
+	"\U0001f468\U0001f3fb\u200d\U0001f52c",
+	"\U0001f468\U0001f3fb\u200d\U0001f680",
+	"\U0001f468\U0001f3fb\u200d\U0001f692",
+	"\U0001f468\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fb\u200d\U0001f9af",
+	"\U0001f468\U0001f3fb\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f468\U0001f3fb\u200d\U0001f9b0",
+	"\U0001f468\U0001f3fb\u200d\U0001f9b1",
+	"\U0001f468\U0001f3fb\u200d\U0001f9b2",
+	"\U0001f468\U0001f3fb\u200d\U0001f9b3",
+	"\U0001f468\U0001f3fb\u200d\U0001f9bc",
+	"\U0001f468\U0001f3fb\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f468\U0001f3fb\u200d\U0001f9bd",
+	"\U0001f468\U0001f3fb\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fc\u200d\u2695",
+	"\U0001f468\U0001f3fc\u200d\u2696",
+	"\U0001f468\U0001f3fc\u200d\u2708",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fc\u200d\U0001f33e",
+	"\U0001f468\U0001f3fc\u200d\U0001f373",
+	"\U0001f468\U0001f3fc\u200d\U0001f37c",
+	"\U0001f468\U0001f3fc\u200d\U0001f393",
+	"\U0001f468\U0001f3fc\u200d\U0001f3a4",
+	"\U0001f468\U0001f3fc\u200d\U0001f3a8",
+	"\U0001f468\U0001f3fc\u200d\U0001f3eb",
+	"\U0001f468\U0001f3fc\u200d\U0001f3ed",
+	"\U0001f468\U0001f3fc\u200d\U0001f4bb",
+	"\U0001f468\U0001f3fc\u200d\U0001f4bc",
+	"\U0001f468\U0001f3fc\u200d\U0001f527",
+	"\U0001f468\U0001f3fc\u200d\U0001f52c",
+	"\U0001f468\U0001f3fc\u200d\U0001f680",
+	"\U0001f468\U0001f3fc\u200d\U0001f692",
+	"\U0001f468\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fc\u200d\U0001f9af",
+	"\U0001f468\U0001f3fc\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f468\U0001f3fc\u200d\U0001f9b0",
+	"\U0001f468\U0001f3fc\u200d\U0001f9b1",
+	"\U0001f468\U0001f3fc\u200d\U0001f9b2",
+	"\U0001f468\U0001f3fc\u200d\U0001f9b3",
+	"\U0001f468\U0001f3fc\u200d\U0001f9bc",
+	"\U0001f468\U0001f3fc\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f468\U0001f3fc\u200d\U0001f9bd",
+	"\U0001f468\U0001f3fc\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fd\u200d\u2695",
+	"\U0001f468\U0001f3fd\u200d\u2696",
+	"\U0001f468\U0001f3fd\u200d\u2708",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fd\u200d\U0001f33e",
+	"\U0001f468\U0001f3fd\u200d\U0001f373",
+	"\U0001f468\U0001f3fd\u200d\U0001f37c",
+	"\U0001f468\U0001f3fd\u200d\U0001f393",
+	"\U0001f468\U0001f3fd\u200d\U0001f3a4",
+	"\U0001f468\U0001f3fd\u200d\U0001f3a8",
+	"\U0001f468\U0001f3fd\u200d\U0001f3eb",
+	"\U0001f468\U0001f3fd\u200d\U0001f3ed",
+	"\U0001f468\U0001f3fd\u200d\U0001f4bb",
+	"\U0001f468\U0001f3fd\u200d\U0001f4bc",
+	"\U0001f468\U0001f3fd\u200d\U0001f527",
+	"\U0001f468\U0001f3fd\u200d\U0001f52c",
+	"\U0001f468\U0001f3fd\u200d\U0001f680",
+	"\U0001f468\U0001f3fd\u200d\U0001f692",
+	"\U0001f468\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fd\u200d\U0001f9af",
+	"\U0001f468\U0001f3fd\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f468\U0001f3fd\u200d\U0001f9b0",
+	"\U0001f468\U0001f3fd\u200d\U0001f9b1",
+	"\U0001f468\U0001f3fd\u200d\U0001f9b2",
+	"\U0001f468\U0001f3fd\u200d\U0001f9b3",
+	"\U0001f468\U0001f3fd\u200d\U0001f9bc",
+	"\U0001f468\U0001f3fd\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f468\U0001f3fd\u200d\U0001f9bd",
+	"\U0001f468\U0001f3fd\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fe\u200d\u2695",
+	"\U0001f468\U0001f3fe\u200d\u2696",
+	"\U0001f468\U0001f3fe\u200d\u2708",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fe\u200d\U0001f33e",
+	"\U0001f468\U0001f3fe\u200d\U0001f373",
+	"\U0001f468\U0001f3fe\u200d\U0001f37c",
+	"\U0001f468\U0001f3fe\u200d\U0001f393",
+	"\U0001f468\U0001f3fe\u200d\U0001f3a4",
+	"\U0001f468\U0001f3fe\u200d\U0001f3a8",
+	"\U0001f468\U0001f3fe\u200d\U0001f3eb",
+	"\U0001f468\U0001f3fe\u200d\U0001f3ed",
+	"\U0001f468\U0001f3fe\u200d\U0001f4bb",
+	"\U0001f468\U0001f3fe\u200d\U0001f4bc",
+	"\U0001f468\U0001f3fe\u200d\U0001f527",
+	"\U0001f468\U0001f3fe\u200d\U0001f52c",
+	"\U0001f468\U0001f3fe\u200d\U0001f680",
+	"\U0001f468\U0001f3fe\u200d\U0001f692",
+	"\U0001f468\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3fe\u200d\U0001f9af",
+	"\U0001f468\U0001f3fe\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f468\U0001f3fe\u200d\U0001f9b0",
+	"\U0001f468\U0001f3fe\u200d\U0001f9b1",
+	"\U0001f468\U0001f3fe\u200d\U0001f9b2",
+	"\U0001f468\U0001f3fe\u200d\U0001f9b3",
+	"\U0001f468\U0001f3fe\u200d\U0001f9bc",
+	"\U0001f468\U0001f3fe\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f468\U0001f3fe\u200d\U0001f9bd",
+	"\U0001f468\U0001f3fe\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3ff\u200d\u2695",
+	"\U0001f468\U0001f3ff\u200d\u2696",
+	"\U0001f468\U0001f3ff\u200d\u2708",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f468\U0001f3ff\u200d\U0001f33e",
+	"\U0001f468\U0001f3ff\u200d\U0001f373",
+	"\U0001f468\U0001f3ff\u200d\U0001f37c",
+	"\U0001f468\U0001f3ff\u200d\U0001f393",
+	"\U0001f468\U0001f3ff\u200d\U0001f3a4",
+	"\U0001f468\U0001f3ff\u200d\U0001f3a8",
+	"\U0001f468\U0001f3ff\u200d\U0001f3eb",
+	"\U0001f468\U0001f3ff\u200d\U0001f3ed",
+	"\U0001f468\U0001f3ff\u200d\U0001f4bb",
+	"\U0001f468\U0001f3ff\u200d\U0001f4bc",
+	"\U0001f468\U0001f3ff\u200d\U0001f527",
+	"\U0001f468\U0001f3ff\u200d\U0001f52c",
+	"\U0001f468\U0001f3ff\u200d\U0001f680",
+	"\U0001f468\U0001f3ff\u200d\U0001f692",
+	"\U0001f468\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fb",
+	"\U0001f468\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fc",
+	"\U0001f468\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fd",
+	"\U0001f468\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fe",
+	"\U0001f468\U0001f3ff\u200d\U0001f9af",
+	"\U0001f468\U0001f3ff\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f468\U0001f3ff\u200d\U0001f9b0",
+	"\U0001f468\U0001f3ff\u200d\U0001f9b1",
+	"\U0001f468\U0001f3ff\u200d\U0001f9b2",
+	"\U0001f468\U0001f3ff\u200d\U0001f9b3",
+	"\U0001f468\U0001f3ff\u200d\U0001f9bc",
+	"\U0001f468\U0001f3ff\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f468\U0001f3ff\u200d\U0001f9bd",
+	"\U0001f468\U0001f3ff\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f469",
+	"\U0001f469\u200d\u2695",
+	"\U0001f469\u200d\u2696",
+	"\U0001f469\u200d\u2708",
+	"\U0001f469\u200d\u2764\u200d\U0001f468",
+	"\U0001f469\u200d\u2764\u200d\U0001f469",
+	"\U0001f469\u200d\u2764\u200d\U0001f48b\u200d\U0001f468",
+	"\U0001f469\u200d\u2764\u200d\U0001f48b\u200d\U0001f469",
+	"\U0001f469\u200d\U0001f33e",
+	"\U0001f469\u200d\U0001f373",
+	"\U0001f469\u200d\U0001f37c",
+	"\U0001f469\u200d\U0001f393",
+	"\U0001f469\u200d\U0001f3a4",
+	"\U0001f469\u200d\U0001f3a8",
+	"\U0001f469\u200d\U0001f3eb",
+	"\U0001f469\u200d\U0001f3ed",
+	"\U0001f469\u200d\U0001f466",
+	"\U0001f469\u200d\U0001f466\u200d\U0001f466",
+	"\U0001f469\u200d\U0001f467",
+	"\U0001f469\u200d\U0001f467\u200d\U0001f466",
+	"\U0001f469\u200d\U0001f467\u200d\U0001f467",
+	"\U0001f469\u200d\U0001f469\u200d\U0001f466",
+	"\U0001f469\u200d\U0001f469\u200d\U0001f466\u200d\U0001f466",
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467",
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f466",
+	"\U0001f469\u200d\U0001f469\u200d\U0001f467\u200d\U0001f467",
+	"\U0001f469\u200d\U0001f4bb",
+	"\U0001f469\u200d\U0001f4bc",
+	"\U0001f469\u200d\U0001f527",
+	"\U0001f469\u200d\U0001f52c",
+	"\U0001f469\u200d\U0001f680",
+	"\U0001f469\u200d\U0001f692",
+	"\U0001f469\u200d\U0001f9af",
+	"\U0001f469\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f469\u200d\U0001f9b0",
+	"\U0001f469\u200d\U0001f9b1",
+	"\U0001f469\u200d\U0001f9b2",
+	"\U0001f469\u200d\U0001f9b3",
+	"\U0001f469\u200d\U0001f9bc",
+	"\U0001f469\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f469\u200d\U0001f9bd",
+	"\U0001f469\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fb\u200d\u2695",
+	"\U0001f469\U0001f3fb\u200d\u2696",
+	"\U0001f469\U0001f3fb\u200d\u2708",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fb\u200d\U0001f33e",
+	"\U0001f469\U0001f3fb\u200d\U0001f373",
+	"\U0001f469\U0001f3fb\u200d\U0001f37c",
+	"\U0001f469\U0001f3fb\u200d\U0001f393",
+	"\U0001f469\U0001f3fb\u200d\U0001f3a4",
+	"\U0001f469\U0001f3fb\u200d\U0001f3a8",
+	"\U0001f469\U0001f3fb\u200d\U0001f3eb",
+	"\U0001f469\U0001f3fb\u200d\U0001f3ed",
+	"\U0001f469\U0001f3fb\u200d\U0001f4bb",
+	"\U0001f469\U0001f3fb\u200d\U0001f4bc",
+	"\U0001f469\U0001f3fb\u200d\U0001f527",
+	"\U0001f469\U0001f3fb\u200d\U0001f52c",
+	"\U0001f469\U0001f3fb\u200d\U0001f680",
+	"\U0001f469\U0001f3fb\u200d\U0001f692",
+	"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fb\u200d\U0001f91d\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fb\u200d\U0001f9af",
+	"\U0001f469\U0001f3fb\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f469\U0001f3fb\u200d\U0001f9b0",
+	"\U0001f469\U0001f3fb\u200d\U0001f9b1",
+	"\U0001f469\U0001f3fb\u200d\U0001f9b2",
+	"\U0001f469\U0001f3fb\u200d\U0001f9b3",
+	"\U0001f469\U0001f3fb\u200d\U0001f9bc",
+	"\U0001f469\U0001f3fb\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f469\U0001f3fb\u200d\U0001f9bd",
+	"\U0001f469\U0001f3fb\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fc\u200d\u2695",
+	"\U0001f469\U0001f3fc\u200d\u2696",
+	"\U0001f469\U0001f3fc\u200d\u2708",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fc\u200d\U0001f33e",
+	"\U0001f469\U0001f3fc\u200d\U0001f373",
+	"\U0001f469\U0001f3fc\u200d\U0001f37c",
+	"\U0001f469\U0001f3fc\u200d\U0001f393",
+	"\U0001f469\U0001f3fc\u200d\U0001f3a4",
+	"\U0001f469\U0001f3fc\u200d\U0001f3a8",
+	"\U0001f469\U0001f3fc\u200d\U0001f3eb",
+	"\U0001f469\U0001f3fc\u200d\U0001f3ed",
+	"\U0001f469\U0001f3fc\u200d\U0001f4bb",
+	"\U0001f469\U0001f3fc\u200d\U0001f4bc",
+	"\U0001f469\U0001f3fc\u200d\U0001f527",
+	"\U0001f469\U0001f3fc\u200d\U0001f52c",
+	"\U0001f469\U0001f3fc\u200d\U0001f680",
+	"\U0001f469\U0001f3fc\u200d\U0001f692",
+	"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fc\u200d\U0001f91d\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fc\u200d\U0001f9af",
+	"\U0001f469\U0001f3fc\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f469\U0001f3fc\u200d\U0001f9b0",
+	"\U0001f469\U0001f3fc\u200d\U0001f9b1",
+	"\U0001f469\U0001f3fc\u200d\U0001f9b2",
+	"\U0001f469\U0001f3fc\u200d\U0001f9b3",
+	"\U0001f469\U0001f3fc\u200d\U0001f9bc",
+	"\U0001f469\U0001f3fc\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f469\U0001f3fc\u200d\U0001f9bd",
+	"\U0001f469\U0001f3fc\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fd\u200d\u2695",
+	"\U0001f469\U0001f3fd\u200d\u2696",
+	"\U0001f469\U0001f3fd\u200d\u2708",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fd\u200d\U0001f33e",
+	"\U0001f469\U0001f3fd\u200d\U0001f373",
+	"\U0001f469\U0001f3fd\u200d\U0001f37c",
+	"\U0001f469\U0001f3fd\u200d\U0001f393",
+	"\U0001f469\U0001f3fd\u200d\U0001f3a4",
+	"\U0001f469\U0001f3fd\u200d\U0001f3a8",
+	"\U0001f469\U0001f3fd\u200d\U0001f3eb",
+	"\U0001f469\U0001f3fd\u200d\U0001f3ed",
+	"\U0001f469\U0001f3fd\u200d\U0001f4bb",
+	"\U0001f469\U0001f3fd\u200d\U0001f4bc",
+	"\U0001f469\U0001f3fd\u200d\U0001f527",
+	"\U0001f469\U0001f3fd\u200d\U0001f52c",
+	"\U0001f469\U0001f3fd\u200d\U0001f680",
+	"\U0001f469\U0001f3fd\u200d\U0001f692",
+	"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fd\u200d\U0001f91d\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fd\u200d\U0001f9af",
+	"\U0001f469\U0001f3fd\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f469\U0001f3fd\u200d\U0001f9b0",
+	"\U0001f469\U0001f3fd\u200d\U0001f9b1",
+	"\U0001f469\U0001f3fd\u200d\U0001f9b2",
+	"\U0001f469\U0001f3fd\u200d\U0001f9b3",
+	"\U0001f469\U0001f3fd\u200d\U0001f9bc",
+	"\U0001f469\U0001f3fd\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f469\U0001f3fd\u200d\U0001f9bd",
+	"\U0001f469\U0001f3fd\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fe\u200d\u2695",
+	"\U0001f469\U0001f3fe\u200d\u2696",
+	"\U0001f469\U0001f3fe\u200d\u2708",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fe\u200d\U0001f33e",
+	"\U0001f469\U0001f3fe\u200d\U0001f373",
+	"\U0001f469\U0001f3fe\u200d\U0001f37c",
+	"\U0001f469\U0001f3fe\u200d\U0001f393",
+	"\U0001f469\U0001f3fe\u200d\U0001f3a4",
+	"\U0001f469\U0001f3fe\u200d\U0001f3a8",
+	"\U0001f469\U0001f3fe\u200d\U0001f3eb",
+	"\U0001f469\U0001f3fe\u200d\U0001f3ed",
+	"\U0001f469\U0001f3fe\u200d\U0001f4bb",
+	"\U0001f469\U0001f3fe\u200d\U0001f4bc",
+	"\U0001f469\U0001f3fe\u200d\U0001f527",
+	"\U0001f469\U0001f3fe\u200d\U0001f52c",
+	"\U0001f469\U0001f3fe\u200d\U0001f680",
+	"\U0001f469\U0001f3fe\u200d\U0001f692",
+	"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3fe\u200d\U0001f91d\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3fe\u200d\U0001f9af",
+	"\U0001f469\U0001f3fe\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f469\U0001f3fe\u200d\U0001f9b0",
+	"\U0001f469\U0001f3fe\u200d\U0001f9b1",
+	"\U0001f469\U0001f3fe\u200d\U0001f9b2",
+	"\U0001f469\U0001f3fe\u200d\U0001f9b3",
+	"\U0001f469\U0001f3fe\u200d\U0001f9bc",
+	"\U0001f469\U0001f3fe\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f469\U0001f3fe\u200d\U0001f9bd",
+	"\U0001f469\U0001f3fe\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3ff\u200d\u2695",
+	"\U0001f469\U0001f3ff\u200d\u2696",
+	"\U0001f469\U0001f3ff\u200d\u2708",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f468\U0001f3ff",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f469\U0001f3ff",
+	"\U0001f469\U0001f3ff\u200d\U0001f33e",
+	"\U0001f469\U0001f3ff\u200d\U0001f373",
+	"\U0001f469\U0001f3ff\u200d\U0001f37c",
+	"\U0001f469\U0001f3ff\u200d\U0001f393",
+	"\U0001f469\U0001f3ff\u200d\U0001f3a4",
+	"\U0001f469\U0001f3ff\u200d\U0001f3a8",
+	"\U0001f469\U0001f3ff\u200d\U0001f3eb",
+	"\U0001f469\U0001f3ff\u200d\U0001f3ed",
+	"\U0001f469\U0001f3ff\u200d\U0001f4bb",
+	"\U0001f469\U0001f3ff\u200d\U0001f4bc",
+	"\U0001f469\U0001f3ff\u200d\U0001f527",
+	"\U0001f469\U0001f3ff\u200d\U0001f52c",
+	"\U0001f469\U0001f3ff\u200d\U0001f680",
+	"\U0001f469\U0001f3ff\u200d\U0001f692",
+	"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fb",
+	"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fc",
+	"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fd",
+	"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f468\U0001f3fe",
+	"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f469\U0001f3fb",
+	"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f469\U0001f3fc",
+	"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f469\U0001f3fd",
+	"\U0001f469\U0001f3ff\u200d\U0001f91d\u200d\U0001f469\U0001f3fe",
+	"\U0001f469\U0001f3ff\u200d\U0001f9af",
+	"\U0001f469\U0001f3ff\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f469\U0001f3ff\u200d\U0001f9b0",
+	"\U0001f469\U0001f3ff\u200d\U0001f9b1",
+	"\U0001f469\U0001f3ff\u200d\U0001f9b2",
+	"\U0001f469\U0001f3ff\u200d\U0001f9b3",
+	"\U0001f469\U0001f3ff\u200d\U0001f9bc",
+	"\U0001f469\U0001f3ff\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f469\U0001f3ff\u200d\U0001f9bd",
+	"\U0001f469\U0001f3ff\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f46a",
+	"\U0001f46b",
+	"\U0001f46b\U0001f3fb",
+	"\U0001f46b\U0001f3fc",
+	"\U0001f46b\U0001f3fd",
+	"\U0001f46b\U0001f3fe",
+	"\U0001f46b\U0001f3ff",
+	"\U0001f46c",
+	"\U0001f46c\U0001f3fb",
+	"\U0001f46c\U0001f3fc",
+	"\U0001f46c\U0001f3fd",
+	"\U0001f46c\U0001f3fe",
+	"\U0001f46c\U0001f3ff",
+	"\U0001f46d",
+	"\U0001f46d\U0001f3fb",
+	"\U0001f46d\U0001f3fc",
+	"\U0001f46d\U0001f3fd",
+	"\U0001f46d\U0001f3fe",
+	"\U0001f46d\U0001f3ff",
+	"\U0001f46e",
+	"\U0001f46e\u200d\u2640",
+	"\U0001f46e\u200d\u2642",
+	"\U0001f46e\U0001f3fb",
+	"\U0001f46e\U0001f3fb\u200d\u2640",
+	"\U0001f46e\U0001f3fb\u200d\u2642",
+	"\U0001f46e\U0001f3fc",
+	"\U0001f46e\U0001f3fc\u200d\u2640",
+	"\U0001f46e\U0001f3fc\u200d\u2642",
+	"\U0001f46e\U0001f3fd",
+	"\U0001f46e\U0001f3fd\u200d\u2640",
+	"\U0001f46e\U0001f3fd\u200d\u2642",
+	"\U0001f46e\U0001f3fe",
+	"\U0001f46e\U0001f3fe\u200d\u2640",
+	"\U0001f46e\U0001f3fe\u200d\u2642",
+	"\U0001f46e\U0001f3ff",
+	"\U0001f46e\U0001f3ff\u200d\u2640",
+	"\U0001f46e\U0001f3ff\u200d\u2642",
+	"\U0001f46f",
+	"\U0001f46f\u200d\u2640",
+	"\U0001f46f\u200d\u2642",
+	"\U0001f470",
+	"\U0001f470\u200d\u2640",
+	"\U0001f470\u200d\u2642",
+	"\U0001f470\U0001f3fb",
+	"\U0001f470\U0001f3fb\u200d\u2640",
+	"\U0001f470\U0001f3fb\u200d\u2642",
+	"\U0001f470\U0001f3fc",
+	"\U0001f470\U0001f3fc\u200d\u2640",
+	"\U0001f470\U0001f3fc\u200d\u2642",
+	"\U0001f470\U0001f3fd",
+	"\U0001f470\U0001f3fd\u200d\u2640",
+	"\U0001f470\U0001f3fd\u200d\u2642",
+	"\U0001f470\U0001f3fe",
+	"\U0001f470\U0001f3fe\u200d\u2640",
+	"\U0001f470\U0001f3fe\u200d\u2642",
+	"\U0001f470\U0001f3ff",
+	"\U0001f470\U0001f3ff\u200d\u2640",
+	"\U0001f470\U0001f3ff\u200d\u2642",
+	"\U0001f471",
+	"\U0001f471\u200d\u2640",
+	"\U0001f471\u200d\u2642",
+	"\U0001f471\U0001f3fb",
+	"\U0001f471\U0001f3fb\u200d\u2640",
+	"\U0001f471\U0001f3fb\u200d\u2642",
+	"\U0001f471\U0001f3fc",
+	"\U0001f471\U0001f3fc\u200d\u2640",
+	"\U0001f471\U0001f3fc\u200d\u2642",
+	"\U0001f471\U0001f3fd",
+	"\U0001f471\U0001f3fd\u200d\u2640",
+	"\U0001f471\U0001f3fd\u200d\u2642",
+	"\U0001f471\U0001f3fe",
+	"\U0001f471\U0001f3fe\u200d\u2640",
+	"\U0001f471\U0001f3fe\u200d\u2642",
+	"\U0001f471\U0001f3ff",
+	"\U0001f471\U0001f3ff\u200d\u2640",
+	"\U0001f471\U0001f3ff\u200d\u2642",
+	"\U0001f472",
+	"\U0001f472\U0001f3fb",
+	"\U0001f472\U0001f3fc",
+	"\U0001f472\U0001f3fd",
+	"\U0001f472\U0001f3fe",
+	"\U0001f472\U0001f3ff",
+	"\U0001f473",
+	"\U0001f473\u200d\u2640",
+	"\U0001f473\u200d\u2642",
+	"\U0001f473\U0001f3fb",
+	"\U0001f473\U0001f3fb\u200d\u2640",
+	"\U0001f473\U0001f3fb\u200d\u2642",
+	"\U0001f473\U0001f3fc",
+	"\U0001f473\U0001f3fc\u200d\u2640",
+	"\U0001f473\U0001f3fc\u200d\u2642",
+	"\U0001f473\U0001f3fd",
+	"\U0001f473\U0001f3fd\u200d\u2640",
+	"\U0001f473\U0001f3fd\u200d\u2642",
+	"\U0001f473\U0001f3fe",
+	"\U0001f473\U0001f3fe\u200d\u2640",
+	"\U0001f473\U0001f3fe\u200d\u2642",
+	"\U0001f473\U0001f3ff",
+	"\U0001f473\U0001f3ff\u200d\u2640",
+	"\U0001f473\U0001f3ff\u200d\u2642",
+	"\U0001f474",
+	"\U0001f474\U0001f3fb",
+	"\U0001f474\U0001f3fc",
+	"\U0001f474\U0001f3fd",
+	"\U0001f474\U0001f3fe",
+	"\U0001f474\U0001f3ff",
+	"\U0001f475",
+	"\U0001f475\U0001f3fb",
+	"\U0001f475\U0001f3fc",
+	"\U0001f475\U0001f3fd",
+	"\U0001f475\U0001f3fe",
+	"\U0001f475\U0001f3ff",
+	"\U0001f476",
+	"\U0001f476\U0001f3fb",
+	"\U0001f476\U0001f3fc",
+	"\U0001f476\U0001f3fd",
+	"\U0001f476\U0001f3fe",
+	"\U0001f476\U0001f3ff",
+	"\U0001f477",
+	"\U0001f477\u200d\u2640",
+	"\U0001f477\u200d\u2642",
+	"\U0001f477\U0001f3fb",
+	"\U0001f477\U0001f3fb\u200d\u2640",
+	"\U0001f477\U0001f3fb\u200d\u2642",
+	"\U0001f477\U0001f3fc",
+	"\U0001f477\U0001f3fc\u200d\u2640",
+	"\U0001f477\U0001f3fc\u200d\u2642",
+	"\U0001f477\U0001f3fd",
+	"\U0001f477\U0001f3fd\u200d\u2640",
+	"\U0001f477\U0001f3fd\u200d\u2642",
+	"\U0001f477\U0001f3fe",
+	"\U0001f477\U0001f3fe\u200d\u2640",
+	"\U0001f477\U0001f3fe\u200d\u2642",
+	"\U0001f477\U0001f3ff",
+	"\U0001f477\U0001f3ff\u200d\u2640",
+	"\U0001f477\U0001f3ff\u200d\u2642",
+	"\U0001f478",
+	"\U0001f478\U0001f3fb",
+	"\U0001f478\U0001f3fc",
+	"\U0001f478\U0001f3fd",
+	"\U0001f478\U0001f3fe",
+	"\U0001f478\U0001f3ff",
+	"\U0001f479",
+	"\U0001f47a",
+	"\U0001f47b",
+	"\U0001f47c",
+	"\U0001f47c\U0001f3fb",
+	"\U0001f47c\U0001f3fc",
+	"\U0001f47c\U0001f3fd",
+	"\U0001f47c\U0001f3fe",
+	"\U0001f47c\U0001f3ff",
+	"\U0001f47d",
+	"\U0001f47e",
+	"\U0001f47f",
+	"\U0001f480",
+	"\U0001f481",
+	"\U0001f481\u200d\u2640",
+	"\U0001f481\u200d\u2642",
+	"\U0001f481\U0001f3fb",
+	"\U0001f481\U0001f3fb\u200d\u2640",
+	"\U0001f481\U0001f3fb\u200d\u2642",
+	"\U0001f481\U0001f3fc",
+	"\U0001f481\U0001f3fc\u200d\u2640",
+	"\U0001f481\U0001f3fc\u200d\u2642",
+	"\U0001f481\U0001f3fd",
+	"\U0001f481\U0001f3fd\u200d\u2640",
+	"\U0001f481\U0001f3fd\u200d\u2642",
+	"\U0001f481\U0001f3fe",
+	"\U0001f481\U0001f3fe\u200d\u2640",
+	"\U0001f481\U0001f3fe\u200d\u2642",
+	"\U0001f481\U0001f3ff",
+	"\U0001f481\U0001f3ff\u200d\u2640",
+	"\U0001f481\U0001f3ff\u200d\u2642",
+	"\U0001f482",
+	"\U0001f482\u200d\u2640",
+	"\U0001f482\u200d\u2642",
+	"\U0001f482\U0001f3fb",
+	"\U0001f482\U0001f3fb\u200d\u2640",
+	"\U0001f482\U0001f3fb\u200d\u2642",
+	"\U0001f482\U0001f3fc",
+	"\U0001f482\U0001f3fc\u200d\u2640",
+	"\U0001f482\U0001f3fc\u200d\u2642",
+	"\U0001f482\U0001f3fd",
+	"\U0001f482\U0001f3fd\u200d\u2640",
+	"\U0001f482\U0001f3fd\u200d\u2642",
+	"\U0001f482\U0001f3fe",
+	"\U0001f482\U0001f3fe\u200d\u2640",
+	"\U0001f482\U0001f3fe\u200d\u2642",
+	"\U0001f482\U0001f3ff",
+	"\U0001f482\U0001f3ff\u200d\u2640",
+	"\U0001f482\U0001f3ff\u200d\u2642",
+	"\U0001f483",
+	"\U0001f483\U0001f3fb",
+	"\U0001f483\U0001f3fc",
+	"\U0001f483\U0001f3fd",
+	"\U0001f483\U0001f3fe",
+	"\U0001f483\U0001f3ff",
+	"\U0001f484",
+	"\U0001f485",
+	"\U0001f485\U0001f3fb",
+	"\U0001f485\U0001f3fc",
+	"\U0001f485\U0001f3fd",
+	"\U0001f485\U0001f3fe",
+	"\U0001f485\U0001f3ff",
+	"\U0001f486",
+	"\U0001f486\u200d\u2640",
+	"\U0001f486\u200d\u2642",
+	"\U0001f486\U0001f3fb",
+	"\U0001f486\U0001f3fb\u200d\u2640",
+	"\U0001f486\U0001f3fb\u200d\u2642",
+	"\U0001f486\U0001f3fc",
+	"\U0001f486\U0001f3fc\u200d\u2640",
+	"\U0001f486\U0001f3fc\u200d\u2642",
+	"\U0001f486\U0001f3fd",
+	"\U0001f486\U0001f3fd\u200d\u2640",
+	"\U0001f486\U0001f3fd\u200d\u2642",
+	"\U0001f486\U0001f3fe",
+	"\U0001f486\U0001f3fe\u200d\u2640",
+	"\U0001f486\U0001f3fe\u200d\u2642",
+	"\U0001f486\U0001f3ff",
+	"\U0001f486\U0001f3ff\u200d\u2640",
+	"\U0001f486\U0001f3ff\u200d\u2642",
+	"\U0001f487",
+	"\U0001f487\u200d\u2640",
+	"\U0001f487\u200d\u2642",
+	"\U0001f487\U0001f3fb",
+	"\U0001f487\U0001f3fb\u200d\u2640",
+	"\U0001f487\U0001f3fb\u200d\u2642",
+	"\U0001f487\U0001f3fc",
+	"\U0001f487\U0001f3fc\u200d\u2640",
+	"\U0001f487\U0001f3fc\u200d\u2642",
+	"\U0001f487\U0001f3fd",
+	"\U0001f487\U0001f3fd\u200d\u2640",
+	"\U0001f487\U0001f3fd\u200d\u2642",
+	"\U0001f487\U0001f3fe",
+	"\U0001f487\U0001f3fe\u200d\u2640",
+	"\U0001f487\U0001f3fe\u200d\u2642",
+	"\U0001f487\U0001f3ff",
+	"\U0001f487\U0001f3ff\u200d\u2640",
+	"\U0001f487\U0001f3ff\u200d\u2642",
+	"\U0001f488",
+	"\U0001f489",
+	"\U0001f48a",
+	"\U0001f48b",
+	"\U0001f48c",
+	"\U0001f48d",
+	"\U0001f48e",
+	"\U0001f48f",
+	"\U0001f48f\U0001f3fb",
+	"\U0001f48f\U0001f3fc",
+	"\U0001f48f\U0001f3fd",
+	"\U0001f48f\U0001f3fe",
+	"\U0001f48f\U0001f3ff",
+	"\U0001f490",
+	"\U0001f491",
+	"\U0001f491\U0001f3fb",
+	"\U0001f491\U0001f3fc",
+	"\U0001f491\U0001f3fd",
+	"\U0001f491\U0001f3fe",
+	"\U0001f491\U0001f3ff",
+	"\U0001f492",
+	"\U0001f493",
+	"\U0001f494",
+	"\U0001f495",
+	"\U0001f496",
+	"\U0001f497",
+	"\U0001f498",
+	"\U0001f499",
+	"\U0001f49a",
+	"\U0001f49b",
+	"\U0001f49c",
+	"\U0001f49d",
+	"\U0001f49e",
+	"\U0001f49f",
+	"\U0001f4a0",
+	"\U0001f4a1",
+	"\U0001f4a2",
+	"\U0001f4a3",
+	"\U0001f4a4",
+	"\U0001f4a5",
+	"\U0001f4a6",
+	"\U0001f4a7",
+	"\U0001f4a8",
+	"\U0001f4a9",
+	"\U0001f4aa",
+	"\U0001f4aa\U0001f3fb",
+	"\U0001f4aa\U0001f3fc",
+	"\U0001f4aa\U0001f3fd",
+	"\U0001f4aa\U0001f3fe",
+	"\U0001f4aa\U0001f3ff",
+	"\U0001f4ab",
+	"\U0001f4ac",
+	"\U0001f4ad",
+	"\U0001f4ae",
+	"\U0001f4af",
+	"\U0001f4b0",
+	"\U0001f4b1",
+	"\U0001f4b2",
+	"\U0001f4b3",
+	"\U0001f4b4",
+	"\U0001f4b5",
+	"\U0001f4b6",
+	"\U0001f4b7",
+	"\U0001f4b8",
+	"\U0001f4b9",
+	"\U0001f4ba",
+	"\U0001f4bb",
+	"\U0001f4bc",
+	"\U0001f4bd",
+	"\U0001f4be",
+	"\U0001f4bf",
+	"\U0001f4c0",
+	"\U0001f4c1",
+	"\U0001f4c2",
+	"\U0001f4c3",
+	"\U0001f4c4",
+	"\U0001f4c5",
+	"\U0001f4c6",
+	"\U0001f4c7",
+	"\U0001f4c8",
+	"\U0001f4c9",
+	"\U0001f4ca",
+	"\U0001f4cb",
+	"\U0001f4cc",
+	"\U0001f4cd",
+	"\U0001f4ce",
+	"\U0001f4cf",
+	"\U0001f4d0",
+	"\U0001f4d1",
+	"\U0001f4d2",
+	"\U0001f4d3",
+	"\U0001f4d4",
+	"\U0001f4d5",
+	"\U0001f4d6",
+	"\U0001f4d7",
+	"\U0001f4d8",
+	"\U0001f4d9",
+	"\U0001f4da",
+	"\U0001f4db",
+	"\U0001f4dc",
+	"\U0001f4dd",
+	"\U0001f4de",
+	"\U0001f4df",
+	"\U0001f4e0",
+	"\U0001f4e1",
+	"\U0001f4e2",
+	"\U0001f4e3",
+	"\U0001f4e4",
+	"\U0001f4e5",
+	"\U0001f4e6",
+	"\U0001f4e7",
+	"\U0001f4e8",
+	"\U0001f4e9",
+	"\U0001f4ea",
+	"\U0001f4eb",
+	"\U0001f4ec",
+	"\U0001f4ed",
+	"\U0001f4ee",
+	"\U0001f4ef",
+	"\U0001f4f0",
+	"\U0001f4f1",
+	"\U0001f4f2",
+	"\U0001f4f3",
+	"\U0001f4f4",
+	"\U0001f4f5",
+	"\U0001f4f6",
+	"\U0001f4f7",
+	"\U0001f4f8",
+	"\U0001f4f9",
+	"\U0001f4fa",
+	"\U0001f4fb",
+	"\U0001f4fc",
+	"\U0001f4fd",
+	"\U0001f4ff",
+	"\U0001f500",
+	"\U0001f501",
+	"\U0001f502",
+	"\U0001f503",
+	"\U0001f504",
+	"\U0001f505",
+	"\U0001f506",
+	"\U0001f507",
+	"\U0001f508",
+	"\U0001f509",
+	"\U0001f50a",
+	"\U0001f50b",
+	"\U0001f50c",
+	"\U0001f50d",
+	"\U0001f50e",
+	"\U0001f50f",
+	"\U0001f510",
+	"\U0001f511",
+	"\U0001f512",
+	"\U0001f513",
+	"\U0001f514",
+	"\U0001f515",
+	"\U0001f516",
+	"\U0001f517",
+	"\U0001f518",
+	"\U0001f519",
+	"\U0001f51a",
+	"\U0001f51b",
+	"\U0001f51c",
+	"\U0001f51d",
+	"\U0001f51e",
+	"\U0001f51f",
+	"\U0001f520",
+	"\U0001f521",
+	"\U0001f522",
+	"\U0001f523",
+	"\U0001f524",
+	"\U0001f525",
+	"\U0001f526",
+	"\U0001f527",
+	"\U0001f528",
+	"\U0001f529",
+	"\U0001f52a",
+	"\U0001f52b",
+	"\U0001f52c",
+	"\U0001f52d",
+	"\U0001f52e",
+	"\U0001f52f",
+	"\U0001f530",
+	"\U0001f531",
+	"\U0001f532",
+	"\U0001f533",
+	"\U0001f534",
+	"\U0001f535",
+	"\U0001f536",
+	"\U0001f537",
+	"\U0001f538",
+	"\U0001f539",
+	"\U0001f53a",
+	"\U0001f53b",
+	"\U0001f53c",
+	"\U0001f53d",
+	"\U0001f549",
+	"\U0001f54a",
+	"\U0001f54b",
+	"\U0001f54c",
+	"\U0001f54d",
+	"\U0001f54e",
+	"\U0001f550",
+	"\U0001f551",
+	"\U0001f552",
+	"\U0001f553",
+	"\U0001f554",
+	"\U0001f555",
+	"\U0001f556",
+	"\U0001f557",
+	"\U0001f558",
+	"\U0001f559",
+	"\U0001f55a",
+	"\U0001f55b",
+	"\U0001f55c",
+	"\U0001f55d",
+	"\U0001f55e",
+	"\U0001f55f",
+	"\U0001f560",
+	"\U0001f561",
+	"\U0001f562",
+	"\U0001f563",
+	"\U0001f564",
+	"\U0001f565",
+	"\U0001f566",
+	"\U0001f567",
+	"\U0001f56f",
+	"\U0001f570",
+	"\U0001f573",
+	"\U0001f574",
+	"\U0001f574\U0001f3fb",
+	"\U0001f574\U0001f3fc",
+	"\U0001f574\U0001f3fd",
+	"\U0001f574\U0001f3fe",
+	"\U0001f574\U0001f3ff",
+	"\U0001f575",
+	"\U0001f575\u200d\u2640",
+	"\U0001f575\u200d\u2642",
+	"\U0001f575\U0001f3fb",
+	"\U0001f575\U0001f3fb\u200d\u2640",
+	"\U0001f575\U0001f3fb\u200d\u2642",
+	"\U0001f575\U0001f3fc",
+	"\U0001f575\U0001f3fc\u200d\u2640",
+	"\U0001f575\U0001f3fc\u200d\u2642",
+	"\U0001f575\U0001f3fd",
+	"\U0001f575\U0001f3fd\u200d\u2640",
+	"\U0001f575\U0001f3fd\u200d\u2642",
+	"\U0001f575\U0001f3fe",
+	"\U0001f575\U0001f3fe\u200d\u2640",
+	"\U0001f575\U0001f3fe\u200d\u2642",
+	"\U0001f575\U0001f3ff",
+	"\U0001f575\U0001f3ff\u200d\u2640",
+	"\U0001f575\U0001f3ff\u200d\u2642",
+	"\U0001f576",
+	"\U0001f577",
+	"\U0001f578",
+	"\U0001f579",
+	"\U0001f57a",
+	"\U0001f57a\U0001f3fb",
+	"\U0001f57a\U0001f3fc",
+	"\U0001f57a\U0001f3fd",
+	"\U0001f57a\U0001f3fe",
+	"\U0001f57a\U0001f3ff",
+	"\U0001f587",
+	"\U0001f58a",
+	"\U0001f58b",
+	"\U0001f58c",
+	"\U0001f58d",
+	"\U0001f590",
+	"\U0001f590\U0001f3fb",
+	"\U0001f590\U0001f3fc",
+	"\U0001f590\U0001f3fd",
+	"\U0001f590\U0001f3fe",
+	"\U0001f590\U0001f3ff",
+	"\U0001f595",
+	"\U0001f595\U0001f3fb",
+	"\U0001f595\U0001f3fc",
+	"\U0001f595\U0001f3fd",
+	"\U0001f595\U0001f3fe",
+	"\U0001f595\U0001f3ff",
+	"\U0001f596",
+	"\U0001f596\U0001f3fb",
+	"\U0001f596\U0001f3fc",
+	"\U0001f596\U0001f3fd",
+	"\U0001f596\U0001f3fe",
+	"\U0001f596\U0001f3ff",
+	"\U0001f5a4",
+	"\U0001f5a5",
+	"\U0001f5a8",
+	"\U0001f5b1",
+	"\U0001f5b2",
+	"\U0001f5bc",
+	"\U0001f5c2",
+	"\U0001f5c3",
+	"\U0001f5c4",
+	"\U0001f5d1",
+	"\U0001f5d2",
+	"\U0001f5d3",
+	"\U0001f5dc",
+	"\U0001f5dd",
+	"\U0001f5de",
+	"\U0001f5e1",
+	"\U0001f5e3",
+	"\U0001f5e8",
+	"\U0001f5ef",
+	"\U0001f5f3",
+	"\U0001f5fa",
+	"\U0001f5fb",
+	"\U0001f5fc",
+	"\U0001f5fd",
+	"\U0001f5fe",
+	"\U0001f5ff",
+	"\U0001f600",
+	"\U0001f601",
+	"\U0001f602",
+	"\U0001f603",
+	"\U0001f604",
+	"\U0001f605",
+	"\U0001f606",
+	"\U0001f607",
+	"\U0001f608",
+	"\U0001f609",
+	"\U0001f60a",
+	"\U0001f60b",
+	"\U0001f60c",
+	"\U0001f60d",
+	"\U0001f60e",
+	"\U0001f60f",
+	"\U0001f610",
+	"\U0001f611",
+	"\U0001f612",
+	"\U0001f613",
+	"\U0001f614",
+	"\U0001f615",
+	"\U0001f616",
+	"\U0001f617",
+	"\U0001f618",
+	"\U0001f619",
+	"\U0001f61a",
+	"\U0001f61b",
+	"\U0001f61c",
+	"\U0001f61d",
+	"\U0001f61e",
+	"\U0001f61f",
+	"\U0001f620",
+	"\U0001f621",
+	"\U0001f622",
+	"\U0001f623",
+	"\U0001f624",
+	"\U0001f625",
+	"\U0001f626",
+	"\U0001f627",
+	"\U0001f628",
+	"\U0001f629",
+	"\U0001f62a",
+	"\U0001f62b",
+	"\U0001f62c",
+	"\U0001f62d",
+	"\U0001f62e",
+	"\U0001f62e\u200d\U0001f4a8",
+	"\U0001f62f",
+	"\U0001f630",
+	"\U0001f631",
+	"\U0001f632",
+	"\U0001f633",
+	"\U0001f634",
+	"\U0001f635",
+	"\U0001f635\u200d\U0001f4ab",
+	"\U0001f636",
+	"\U0001f636\u200d\U0001f32b",
+	"\U0001f637",
+	"\U0001f638",
+	"\U0001f639",
+	"\U0001f63a",
+	"\U0001f63b",
+	"\U0001f63c",
+	"\U0001f63d",
+	"\U0001f63e",
+	"\U0001f63f",
+	"\U0001f640",
+	"\U0001f641",
+	"\U0001f642",
+	"\U0001f642\u200d\u2194",
+	"\U0001f642\u200d\u2195",
+	"\U0001f643",
+	"\U0001f644",
+	"\U0001f645",
+	"\U0001f645\u200d\u2640",
+	"\U0001f645\u200d\u2642",
+	"\U0001f645\U0001f3fb",
+	"\U0001f645\U0001f3fb\u200d\u2640",
+	"\U0001f645\U0001f3fb\u200d\u2642",
+	"\U0001f645\U0001f3fc",
+	"\U0001f645\U0001f3fc\u200d\u2640",
+	"\U0001f645\U0001f3fc\u200d\u2642",
+	"\U0001f645\U0001f3fd",
+	"\U0001f645\U0001f3fd\u200d\u2640",
+	"\U0001f645\U0001f3fd\u200d\u2642",
+	"\U0001f645\U0001f3fe",
+	"\U0001f645\U0001f3fe\u200d\u2640",
+	"\U0001f645\U0001f3fe\u200d\u2642",
+	"\U0001f645\U0001f3ff",
+	"\U0001f645\U0001f3ff\u200d\u2640",
+	"\U0001f645\U0001f3ff\u200d\u2642",
+	"\U0001f646",
+	"\U0001f646\u200d\u2640",
+	"\U0001f646\u200d\u2642",
+	"\U0001f646\U0001f3fb",
+	"\U0001f646\U0001f3fb\u200d\u2640",
+	"\U0001f646\U0001f3fb\u200d\u2642",
+	"\U0001f646\U0001f3fc",
+	"\U0001f646\U0001f3fc\u200d\u2640",
+	"\U0001f646\U0001f3fc\u200d\u2642",
+	"\U0001f646\U0001f3fd",
+	"\U0001f646\U0001f3fd\u200d\u2640",
+	"\U0001f646\U0001f3fd\u200d\u2642",
+	"\U0001f646\U0001f3fe",
+	"\U0001f646\U0001f3fe\u200d\u2640",
+	"\U0001f646\U0001f3fe\u200d\u2642",
+	"\U0001f646\U0001f3ff",
+	"\U0001f646\U0001f3ff\u200d\u2640",
+	"\U0001f646\U0001f3ff\u200d\u2642",
+	"\U0001f647",
+	"\U0001f647\u200d\u2640",
+	"\U0001f647\u200d\u2642",
+	"\U0001f647\U0001f3fb",
+	"\U0001f647\U0001f3fb\u200d\u2640",
+	"\U0001f647\U0001f3fb\u200d\u2642",
+	"\U0001f647\U0001f3fc",
+	"\U0001f647\U0001f3fc\u200d\u2640",
+	"\U0001f647\U0001f3fc\u200d\u2642",
+	"\U0001f647\U0001f3fd",
+	"\U0001f647\U0001f3fd\u200d\u2640",
+	"\U0001f647\U0001f3fd\u200d\u2642",
+	"\U0001f647\U0001f3fe",
+	"\U0001f647\U0001f3fe\u200d\u2640",
+	"\U0001f647\U0001f3fe\u200d\u2642",
+	"\U0001f647\U0001f3ff",
+	"\U0001f647\U0001f3ff\u200d\u2640",
+	"\U0001f647\U0001f3ff\u200d\u2642",
+	"\U0001f648",
+	"\U0001f649",
+	"\U0001f64a",
+	"\U0001f64b",
+	"\U0001f64b\u200d\u2640",
+	"\U0001f64b\u200d\u2642",
+	"\U0001f64b\U0001f3fb",
+	"\U0001f64b\U0001f3fb\u200d\u2640",
+	"\U0001f64b\U0001f3fb\u200d\u2642",
+	"\U0001f64b\U0001f3fc",
+	"\U0001f64b\U0001f3fc\u200d\u2640",
+	"\U0001f64b\U0001f3fc\u200d\u2642",
+	"\U0001f64b\U0001f3fd",
+	"\U0001f64b\U0001f3fd\u200d\u2640",
+	"\U0001f64b\U0001f3fd\u200d\u2642",
+	"\U0001f64b\U0001f3fe",
+	"\U0001f64b\U0001f3fe\u200d\u2640",
+	"\U0001f64b\U0001f3fe\u200d\u2642",
+	"\U0001f64b\U0001f3ff",
+	"\U0001f64b\U0001f3ff\u200d\u2640",
+	"\U0001f64b\U0001f3ff\u200d\u2642",
+	"\U0001f64c",
+	"\U0001f64c\U0001f3fb",
+	"\U0001f64c\U0001f3fc",
+	"\U0001f64c\U0001f3fd",
+	"\U0001f64c\U0001f3fe",
+	"\U0001f64c\U0001f3ff",
+	"\U0001f64d",
+	"\U0001f64d\u200d\u2640",
+	"\U0001f64d\u200d\u2642",
+	"\U0001f64d\U0001f3fb",
+	"\U0001f64d\U0001f3fb\u200d\u2640",
+	"\U0001f64d\U0001f3fb\u200d\u2642",
+	"\U0001f64d\U0001f3fc",
+	"\U0001f64d\U0001f3fc\u200d\u2640",
+	"\U0001f64d\U0001f3fc\u200d\u2642",
+	"\U0001f64d\U0001f3fd",
+	"\U0001f64d\U0001f3fd\u200d\u2640",
+	"\U0001f64d\U0001f3fd\u200d\u2642",
+	"\U0001f64d\U0001f3fe",
+	"\U0001f64d\U0001f3fe\u200d\u2640",
+	"\U0001f64d\U0001f3fe\u200d\u2642",
+	"\U0001f64d\U0001f3ff",
+	"\U0001f64d\U0001f3ff\u200d\u2640",
+	"\U0001f64d\U0001f3ff\u200d\u2642",
+	"\U0001f64e",
+	"\U0001f64e\u200d\u2640",
+	"\U0001f64e\u200d\u2642",
+	"\U0001f64e\U0001f3fb",
+	"\U0001f64e\U0001f3fb\u200d\u2640",
+	"\U0001f64e\U0001f3fb\u200d\u2642",
+	"\U0001f64e\U0001f3fc",
+	"\U0001f64e\U0001f3fc\u200d\u2640",
+	"\U0001f64e\U0001f3fc\u200d\u2642",
+	"\U0001f64e\U0001f3fd",
+	"\U0001f64e\U0001f3fd\u200d\u2640",
+	"\U0001f64e\U0001f3fd\u200d\u2642",
+	"\U0001f64e\U0001f3fe",
+	"\U0001f64e\U0001f3fe\u200d\u2640",
+	"\U0001f64e\U0001f3fe\u200d\u2642",
+	"\U0001f64e\U0001f3ff",
+	"\U0001f64e\U0001f3ff\u200d\u2640",
+	"\U0001f64e\U0001f3ff\u200d\u2642",
+	"\U0001f64f",
+	"\U0001f64f\U0001f3fb",
+	"\U0001f64f\U0001f3fc",
+	"\U0001f64f\U0001f3fd",
+	"\U0001f64f\U0001f3fe",
+	"\U0001f64f\U0001f3ff",
+	"\U0001f680",
+	"\U0001f681",
+	"\U0001f682",
+	"\U0001f683",
+	"\U0001f684",
+	"\U0001f685",
+	"\U0001f686",
+	"\U0001f687",
+	"\U0001f688",
+	"\U0001f689",
+	"\U0001f68a",
+	"\U0001f68b",
+	"\U0001f68c",
+	"\U0001f68d",
+	"\U0001f68e",
+	"\U0001f68f",
+	"\U0001f690",
+	"\U0001f691",
+	"\U0001f692",
+	"\U0001f693",
+	"\U0001f694",
+	"\U0001f695",
+	"\U0001f696",
+	"\U0001f697",
+	"\U0001f698",
+	"\U0001f699",
+	"\U0001f69a",
+	"\U0001f69b",
+	"\U0001f69c",
+	"\U0001f69d",
+	"\U0001f69e",
+	"\U0001f69f",
+	"\U0001f6a0",
+	"\U0001f6a1",
+	"\U0001f6a2",
+	"\U0001f6a3",
+	"\U0001f6a3\u200d\u2640",
+	"\U0001f6a3\u200d\u2642",
+	"\U0001f6a3\U0001f3fb",
+	"\U0001f6a3\U0001f3fb\u200d\u2640",
+	"\U0001f6a3\U0001f3fb\u200d\u2642",
+	"\U0001f6a3\U0001f3fc",
+	"\U0001f6a3\U0001f3fc\u200d\u2640",
+	"\U0001f6a3\U0001f3fc\u200d\u2642",
+	"\U0001f6a3\U0001f3fd",
+	"\U0001f6a3\U0001f3fd\u200d\u2640",
+	"\U0001f6a3\U0001f3fd\u200d\u2642",
+	"\U0001f6a3\U0001f3fe",
+	"\U0001f6a3\U0001f3fe\u200d\u2640",
+	"\U0001f6a3\U0001f3fe\u200d\u2642",
+	"\U0001f6a3\U0001f3ff",
+	"\U0001f6a3\U0001f3ff\u200d\u2640",
+	"\U0001f6a3\U0001f3ff\u200d\u2642",
+	"\U0001f6a4",
+	"\U0001f6a5",
+	"\U0001f6a6",
+	"\U0001f6a7",
+	"\U0001f6a8",
+	"\U0001f6a9",
+	"\U0001f6aa",
+	"\U0001f6ab",
+	"\U0001f6ac",
+	"\U0001f6ad",
+	"\U0001f6ae",
+	"\U0001f6af",
+	"\U0001f6b0",
+	"\U0001f6b1",
+	"\U0001f6b2",
+	"\U0001f6b3",
+	"\U0001f6b4",
+	"\U0001f6b4\u200d\u2640",
+	"\U0001f6b4\u200d\u2642",
+	"\U0001f6b4\U0001f3fb",
+	"\U0001f6b4\U0001f3fb\u200d\u2640",
+	"\U0001f6b4\U0001f3fb\u200d\u2642",
+	"\U0001f6b4\U0001f3fc",
+	"\U0001f6b4\U0001f3fc\u200d\u2640",
+	"\U0001f6b4\U0001f3fc\u200d\u2642",
+	"\U0001f6b4\U0001f3fd",
+	"\U0001f6b4\U0001f3fd\u200d\u2640",
+	"\U0001f6b4\U0001f3fd\u200d\u2642",
+	"\U0001f6b4\U0001f3fe",
+	"\U0001f6b4\U0001f3fe\u200d\u2640",
+	"\U0001f6b4\U0001f3fe\u200d\u2642",
+	"\U0001f6b4\U0001f3ff",
+	"\U0001f6b4\U0001f3ff\u200d\u2640",
+	"\U0001f6b4\U0001f3ff\u200d\u2642",
+	"\U0001f6b5",
+	"\U0001f6b5\u200d\u2640",
+	"\U0001f6b5\u200d\u2642",
+	"\U0001f6b5\U0001f3fb",
+	"\U0001f6b5\U0001f3fb\u200d\u2640",
+	"\U0001f6b5\U0001f3fb\u200d\u2642",
+	"\U0001f6b5\U0001f3fc",
+	"\U0001f6b5\U0001f3fc\u200d\u2640",
+	"\U0001f6b5\U0001f3fc\u200d\u2642",
+	"\U0001f6b5\U0001f3fd",
+	"\U0001f6b5\U0001f3fd\u200d\u2640",
+	"\U0001f6b5\U0001f3fd\u200d\u2642",
+	"\U0001f6b5\U0001f3fe",
+	"\U0001f6b5\U0001f3fe\u200d\u2640",
+	"\U0001f6b5\U0001f3fe\u200d\u2642",
+	"\U0001f6b5\U0001f3ff",
+	"\U0001f6b5\U0001f3ff\u200d\u2640",
+	"\U0001f6b5\U0001f3ff\u200d\u2642",
+	"\U0001f6b6",
+	"\U0001f6b6\u200d\u2640",
+	"\U0001f6b6\u200d\u2640\u200d\u27a1",
+	"\U0001f6b6\u200d\u2642",
+	"\U0001f6b6\u200d\u2642\u200d\u27a1",
+	"\U0001f6b6\u200d\u27a1",
+	"\U0001f6b6\U0001f3fb",
+	"\U0001f6b6\U0001f3fb\u200d\u2640",
+	"\U0001f6b6\U0001f3fb\u200d\u2640\u200d\u27a1",
+	"\U0001f6b6\U0001f3fb\u200d\u2642",
+	"\U0001f6b6\U0001f3fb\u200d\u2642\u200d\u27a1",
+	"\U0001f6b6\U0001f3fb\u200d\u27a1",
+	"\U0001f6b6\U0001f3fc",
+	"\U0001f6b6\U0001f3fc\u200d\u2640",
+	"\U0001f6b6\U0001f3fc\u200d\u2640\u200d\u27a1",
+	"\U0001f6b6\U0001f3fc\u200d\u2642",
+	"\U0001f6b6\U0001f3fc\u200d\u2642\u200d\u27a1",
+	"\U0001f6b6\U0001f3fc\u200d\u27a1",
+	"\U0001f6b6\U0001f3fd",
+	"\U0001f6b6\U0001f3fd\u200d\u2640",
+	"\U0001f6b6\U0001f3fd\u200d\u2640\u200d\u27a1",
+	"\U0001f6b6\U0001f3fd\u200d\u2642",
+	"\U0001f6b6\U0001f3fd\u200d\u2642\u200d\u27a1",
+	"\U0001f6b6\U0001f3fd\u200d\u27a1",
+	"\U0001f6b6\U0001f3fe",
+	"\U0001f6b6\U0001f3fe\u200d\u2640",
+	"\U0001f6b6\U0001f3fe\u200d\u2640\u200d\u27a1",
+	"\U0001f6b6\U0001f3fe\u200d\u2642",
+	"\U0001f6b6\U0001f3fe\u200d\u2642\u200d\u27a1",
+	"\U0001f6b6\U0001f3fe\u200d\u27a1",
+	"\U0001f6b6\U0001f3ff",
+	"\U0001f6b6\U0001f3ff\u200d\u2640",
+	"\U0001f6b6\U0001f3ff\u200d\u2640\u200d\u27a1",
+	"\U0001f6b6\U0001f3ff\u200d\u2642",
+	"\U0001f6b6\U0001f3ff\u200d\u2642\u200d\u27a1",
+	"\U0001f6b6\U0001f3ff\u200d\u27a1",
+	"\U0001f6b7",
+	"\U0001f6b8",
+	"\U0001f6b9",
+	"\U0001f6ba",
+	"\U0001f6bb",
+	"\U0001f6bc",
+	"\U0001f6bd",
+	"\U0001f6be",
+	"\U0001f6bf",
+	"\U0001f6c0",
+	"\U0001f6c0\U0001f3fb",
+	"\U0001f6c0\U0001f3fc",
+	"\U0001f6c0\U0001f3fd",
+	"\U0001f6c0\U0001f3fe",
+	"\U0001f6c0\U0001f3ff",
+	"\U0001f6c1",
+	"\U0001f6c2",
+	"\U0001f6c3",
+	"\U0001f6c4",
+	"\U0001f6c5",
+	"\U0001f6cb",
+	"\U0001f6cc",
+	"\U0001f6cc\U0001f3fb",
+	"\U0001f6cc\U0001f3fc",
+	"\U0001f6cc\U0001f3fd",
+	"\U0001f6cc\U0001f3fe",
+	"\U0001f6cc\U0001f3ff",
+	"\U0001f6cd",
+	"\U0001f6ce",
+	"\U0001f6cf",
+	"\U0001f6d0",
+	"\U0001f6d1",
+	"\U0001f6d2",
+	"\U0001f6d5",
+	"\U0001f6d6",
+	"\U0001f6d7",
+	"\U0001f6dc",
+	"\U0001f6dd",
+	"\U0001f6de",
+	"\U0001f6df",
+	"\U0001f6e0",
+	"\U0001f6e1",
+	"\U0001f6e2",
+	"\U0001f6e3",
+	"\U0001f6e4",
+	"\U0001f6e5",
+	"\U0001f6e9",
+	"\U0001f6eb",
+	"\U0001f6ec",
+	"\U0001f6f0",
+	"\U0001f6f3",
+	"\U0001f6f4",
+	"\U0001f6f5",
+	"\U0001f6f6",
+	"\U0001f6f7",
+	"\U0001f6f8",
+	"\U0001f6f9",
+	"\U0001f6fa",
+	"\U0001f6fb",
+	"\U0001f6fc",
+	"\U0001f7e0",
+	"\U0001f7e1",
+	"\U0001f7e2",
+	"\U0001f7e3",
+	"\U0001f7e4",
+	"\U0001f7e5",
+	"\U0001f7e6",
+	"\U0001f7e7",
+	"\U0001f7e8",
+	"\U0001f7e9",
+	"\U0001f7ea",
+	"\U0001f7eb",
+	"\U0001f7f0",
+	"\U0001f90c",
+	"\U0001f90c\U0001f3fb",
+	"\U0001f90c\U0001f3fc",
+	"\U0001f90c\U0001f3fd",
+	"\U0001f90c\U0001f3fe",
+	"\U0001f90c\U0001f3ff",
+	"\U0001f90d",
+	"\U0001f90e",
+	"\U0001f90f",
+	"\U0001f90f\U0001f3fb",
+	"\U0001f90f\U0001f3fc",
+	"\U0001f90f\U0001f3fd",
+	"\U0001f90f\U0001f3fe",
+	"\U0001f90f\U0001f3ff",
+	"\U0001f910",
+	"\U0001f911",
+	"\U0001f912",
+	"\U0001f913",
+	"\U0001f914",
+	"\U0001f915",
+	"\U0001f916",
+	"\U0001f917",
+	"\U0001f918",
+	"\U0001f918\U0001f3fb",
+	"\U0001f918\U0001f3fc",
+	"\U0001f918\U0001f3fd",
+	"\U0001f918\U0001f3fe",
+	"\U0001f918\U0001f3ff",
+	"\U0001f919",
+	"\U0001f919\U0001f3fb",
+	"\U0001f919\U0001f3fc",
+	"\U0001f919\U0001f3fd",
+	"\U0001f919\U0001f3fe",
+	"\U0001f919\U0001f3ff",
+	"\U0001f91a",
+	"\U0001f91a\U0001f3fb",
+	"\U0001f91a\U0001f3fc",
+	"\U0001f91a\U0001f3fd",
+	"\U0001f91a\U0001f3fe",
+	"\U0001f91a\U0001f3ff",
+	"\U0001f91b",
+	"\U0001f91b\U0001f3fb",
+	"\U0001f91b\U0001f3fc",
+	"\U0001f91b\U0001f3fd",
+	"\U0001f91b\U0001f3fe",
+	"\U0001f91b\U0001f3ff",
+	"\U0001f91c",
+	"\U0001f91c\U0001f3fb",
+	"\U0001f91c\U0001f3fc",
+	"\U0001f91c\U0001f3fd",
+	"\U0001f91c\U0001f3fe",
+	"\U0001f91c\U0001f3ff",
+	"\U0001f91d",
+	"\U0001f91d\U0001f3fb",
+	"\U0001f91d\U0001f3fc",
+	"\U0001f91d\U0001f3fd",
+	"\U0001f91d\U0001f3fe",
+	"\U0001f91d\U0001f3ff",
+	"\U0001f91e",
+	"\U0001f91e\U0001f3fb",
+	"\U0001f91e\U0001f3fc",
+	"\U0001f91e\U0001f3fd",
+	"\U0001f91e\U0001f3fe",
+	"\U0001f91e\U0001f3ff",
+	"\U0001f91f",
+	"\U0001f91f\U0001f3fb",
+	"\U0001f91f\U0001f3fc",
+	"\U0001f91f\U0001f3fd",
+	"\U0001f91f\U0001f3fe",
+	"\U0001f91f\U0001f3ff",
+	"\U0001f920",
+	"\U0001f921",
+	"\U0001f922",
+	"\U0001f923",
+	"\U0001f924",
+	"\U0001f925",
+	"\U0001f926",
+	"\U0001f926\u200d\u2640",
+	"\U0001f926\u200d\u2642",
+	"\U0001f926\U0001f3fb",
+	"\U0001f926\U0001f3fb\u200d\u2640",
+	"\U0001f926\U0001f3fb\u200d\u2642",
+	"\U0001f926\U0001f3fc",
+	"\U0001f926\U0001f3fc\u200d\u2640",
+	"\U0001f926\U0001f3fc\u200d\u2642",
+	"\U0001f926\U0001f3fd",
+	"\U0001f926\U0001f3fd\u200d\u2640",
+	"\U0001f926\U0001f3fd\u200d\u2642",
+	"\U0001f926\U0001f3fe",
+	"\U0001f926\U0001f3fe\u200d\u2640",
+	"\U0001f926\U0001f3fe\u200d\u2642",
+	"\U0001f926\U0001f3ff",
+	"\U0001f926\U0001f3ff\u200d\u2640",
+	"\U0001f926\U0001f3ff\u200d\u2642",
+	"\U0001f927",
+	"\U0001f928",
+	"\U0001f929",
+	"\U0001f92a",
+	"\U0001f92b",
+	"\U0001f92c",
+	"\U0001f92d",
+	"\U0001f92e",
+	"\U0001f92f",
+	"\U0001f930",
+	"\U0001f930\U0001f3fb",
+	"\U0001f930\U0001f3fc",
+	"\U0001f930\U0001f3fd",
+	"\U0001f930\U0001f3fe",
+	"\U0001f930\U0001f3ff",
+	"\U0001f931",
+	"\U0001f931\U0001f3fb",
+	"\U0001f931\U0001f3fc",
+	"\U0001f931\U0001f3fd",
+	"\U0001f931\U0001f3fe",
+	"\U0001f931\U0001f3ff",
+	"\U0001f932",
+	"\U0001f932\U0001f3fb",
+	"\U0001f932\U0001f3fc",
+	"\U0001f932\U0001f3fd",
+	"\U0001f932\U0001f3fe",
+	"\U0001f932\U0001f3ff",
+	"\U0001f933",
+	"\U0001f933\U0001f3fb",
+	"\U0001f933\U0001f3fc",
+	"\U0001f933\U0001f3fd",
+	"\U0001f933\U0001f3fe",
+	"\U0001f933\U0001f3ff",
+	"\U0001f934",
+	"\U0001f934\U0001f3fb",
+	"\U0001f934\U0001f3fc",
+	"\U0001f934\U0001f3fd",
+	"\U0001f934\U0001f3fe",
+	"\U0001f934\U0001f3ff",
+	"\U0001f935",
+	"\U0001f935\u200d\u2640",
+	"\U0001f935\u200d\u2642",
+	"\U0001f935\U0001f3fb",
+	"\U0001f935\U0001f3fb\u200d\u2640",
+	"\U0001f935\U0001f3fb\u200d\u2642",
+	"\U0001f935\U0001f3fc",
+	"\U0001f935\U0001f3fc\u200d\u2640",
+	"\U0001f935\U0001f3fc\u200d\u2642",
+	"\U0001f935\U0001f3fd",
+	"\U0001f935\U0001f3fd\u200d\u2640",
+	"\U0001f935\U0001f3fd\u200d\u2642",
+	"\U0001f935\U0001f3fe",
+	"\U0001f935\U0001f3fe\u200d\u2640",
+	"\U0001f935\U0001f3fe\u200d\u2642",
+	"\U0001f935\U0001f3ff",
+	"\U0001f935\U0001f3ff\u200d\u2640",
+	"\U0001f935\U0001f3ff\u200d\u2642",
+	"\U0001f936",
+	"\U0001f936\U0001f3fb",
+	"\U0001f936\U0001f3fc",
+	"\U0001f936\U0001f3fd",
+	"\U0001f936\U0001f3fe",
+	"\U0001f936\U0001f3ff",
+	"\U0001f937",
+	"\U0001f937\u200d\u2640",
+	"\U0001f937\u200d\u2642",
+	"\U0001f937\U0001f3fb",
+	"\U0001f937\U0001f3fb\u200d\u2640",
+	"\U0001f937\U0001f3fb\u200d\u2642",
+	"\U0001f937\U0001f3fc",
+	"\U0001f937\U0001f3fc\u200d\u2640",
+	"\U0001f937\U0001f3fc\u200d\u2642",
+	"\U0001f937\U0001f3fd",
+	"\U0001f937\U0001f3fd\u200d\u2640",
+	"\U0001f937\U0001f3fd\u200d\u2642",
+	"\U0001f937\U0001f3fe",
+	"\U0001f937\U0001f3fe\u200d\u2640",
+	"\U0001f937\U0001f3fe\u200d\u2642",
+	"\U0001f937\U0001f3ff",
+	"\U0001f937\U0001f3ff\u200d\u2640",
+	"\U0001f937\U0001f3ff\u200d\u2642",
+	"\U0001f938",
+	"\U0001f938\u200d\u2640",
+	"\U0001f938\u200d\u2642",
+	"\U0001f938\U0001f3fb",
+	"\U0001f938\U0001f3fb\u200d\u2640",
+	"\U0001f938\U0001f3fb\u200d\u2642",
+	"\U0001f938\U0001f3fc",
+	"\U0001f938\U0001f3fc\u200d\u2640",
+	"\U0001f938\U0001f3fc\u200d\u2642",
+	"\U0001f938\U0001f3fd",
+	"\U0001f938\U0001f3fd\u200d\u2640",
+	"\U0001f938\U0001f3fd\u200d\u2642",
+	"\U0001f938\U0001f3fe",
+	"\U0001f938\U0001f3fe\u200d\u2640",
+	"\U0001f938\U0001f3fe\u200d\u2642",
+	"\U0001f938\U0001f3ff",
+	"\U0001f938\U0001f3ff\u200d\u2640",
+	"\U0001f938\U0001f3ff\u200d\u2642",
+	"\U0001f939",
+	"\U0001f939\u200d\u2640",
+	"\U0001f939\u200d\u2642",
+	"\U0001f939\U0001f3fb",
+	"\U0001f939\U0001f3fb\u200d\u2640",
+	"\U0001f939\U0001f3fb\u200d\u2642",
+	"\U0001f939\U0001f3fc",
+	"\U0001f939\U0001f3fc\u200d\u2640",
+	"\U0001f939\U0001f3fc\u200d\u2642",
+	"\U0001f939\U0001f3fd",
+	"\U0001f939\U0001f3fd\u200d\u2640",
+	"\U0001f939\U0001f3fd\u200d\u2642",
+	"\U0001f939\U0001f3fe",
+	"\U0001f939\U0001f3fe\u200d\u2640",
+	"\U0001f939\U0001f3fe\u200d\u2642",
+	"\U0001f939\U0001f3ff",
+	"\U0001f939\U0001f3ff\u200d\u2640",
+	"\U0001f939\U0001f3ff\u200d\u2642",
+	"\U0001f93a",
+	"\U0001f93c",
+	"\U0001f93c\u200d\u2640",
+	"\U0001f93c\u200d\u2642",
+	"\U0001f93d",
+	"\U0001f93d\u200d\u2640",
+	"\U0001f93d\u200d\u2642",
+	"\U0001f93d\U0001f3fb",
+	"\U0001f93d\U0001f3fb\u200d\u2640",
+	"\U0001f93d\U0001f3fb\u200d\u2642",
+	"\U0001f93d\U0001f3fc",
+	"\U0001f93d\U0001f3fc\u200d\u2640",
+	"\U0001f93d\U0001f3fc\u200d\u2642",
+	"\U0001f93d\U0001f3fd",
+	"\U0001f93d\U0001f3fd\u200d\u2640",
+	"\U0001f93d\U0001f3fd\u200d\u2642",
+	"\U0001f93d\U0001f3fe",
+	"\U0001f93d\U0001f3fe\u200d\u2640",
+	"\U0001f93d\U0001f3fe\u200d\u2642",
+	"\U0001f93d\U0001f3ff",
+	"\U0001f93d\U0001f3ff\u200d\u2640",
+	"\U0001f93d\U0001f3ff\u200d\u2642",
+	"\U0001f93e",
+	"\U0001f93e\u200d\u2640",
+	"\U0001f93e\u200d\u2642",
+	"\U0001f93e\U0001f3fb",
+	"\U0001f93e\U0001f3fb\u200d\u2640",
+	"\U0001f93e\U0001f3fb\u200d\u2642",
+	"\U0001f93e\U0001f3fc",
+	"\U0001f93e\U0001f3fc\u200d\u2640",
+	"\U0001f93e\U0001f3fc\u200d\u2642",
+	"\U0001f93e\U0001f3fd",
+	"\U0001f93e\U0001f3fd\u200d\u2640",
+	"\U0001f93e\U0001f3fd\u200d\u2642",
+	"\U0001f93e\U0001f3fe",
+	"\U0001f93e\U0001f3fe\u200d\u2640",
+	"\U0001f93e\U0001f3fe\u200d\u2642",
+	"\U0001f93e\U0001f3ff",
+	"\U0001f93e\U0001f3ff\u200d\u2640",
+	"\U0001f93e\U0001f3ff\u200d\u2642",
+	"\U0001f93f",
+	"\U0001f940",
+	"\U0001f941",
+	"\U0001f942",
+	"\U0001f943",
+	"\U0001f944",
+	"\U0001f945",
+	"\U0001f947",
+	"\U0001f948",
+	"\U0001f949",
+	"\U0001f94a",
+	"\U0001f94b",
+	"\U0001f94c",
+	"\U0001f94d",
+	"\U0001f94e",
+	"\U0001f94f",
+	"\U0001f950",
+	"\U0001f951",
+	"\U0001f952",
+	"\U0001f953",
+	"\U0001f954",
+	"\U0001f955",
+	"\U0001f956",
+	"\U0001f957",
+	"\U0001f958",
+	"\U0001f959",
+	"\U0001f95a",
+	"\U0001f95b",
+	"\U0001f95c",
+	"\U0001f95d",
+	"\U0001f95e",
+	"\U0001f95f",
+	"\U0001f960",
+	"\U0001f961",
+	"\U0001f962",
+	"\U0001f963",
+	"\U0001f964",
+	"\U0001f965",
+	"\U0001f966",
+	"\U0001f967",
+	"\U0001f968",
+	"\U0001f969",
+	"\U0001f96a",
+	"\U0001f96b",
+	"\U0001f96c",
+	"\U0001f96d",
+	"\U0001f96e",
+	"\U0001f96f",
+	"\U0001f970",
+	"\U0001f971",
+	"\U0001f972",
+	"\U0001f973",
+	"\U0001f974",
+	"\U0001f975",
+	"\U0001f976",
+	"\U0001f977",
+	"\U0001f977\U0001f3fb",
+	"\U0001f977\U0001f3fc",
+	"\U0001f977\U0001f3fd",
+	"\U0001f977\U0001f3fe",
+	"\U0001f977\U0001f3ff",
+	"\U0001f978",
+	"\U0001f979",
+	"\U0001f97a",
+	"\U0001f97b",
+	"\U0001f97c",
+	"\U0001f97d",
+	"\U0001f97e",
+	"\U0001f97f",
+	"\U0001f980",
+	"\U0001f981",
+	"\U0001f982",
+	"\U0001f983",
+	"\U0001f984",
+	"\U0001f985",
+	"\U0001f986",
+	"\U0001f987",
+	"\U0001f988",
+	"\U0001f989",
+	"\U0001f98a",
+	"\U0001f98b",
+	"\U0001f98c",
+	"\U0001f98d",
+	"\U0001f98e",
+	"\U0001f98f",
+	"\U0001f990",
+	"\U0001f991",
+	"\U0001f992",
+	"\U0001f993",
+	"\U0001f994",
+	"\U0001f995",
+	"\U0001f996",
+	"\U0001f997",
+	"\U0001f998",
+	"\U0001f999",
+	"\U0001f99a",
+	"\U0001f99b",
+	"\U0001f99c",
+	"\U0001f99d",
+	"\U0001f99e",
+	"\U0001f99f",
+	"\U0001f9a0",
+	"\U0001f9a1",
+	"\U0001f9a2",
+	"\U0001f9a3",
+	"\U0001f9a4",
+	"\U0001f9a5",
+	"\U0001f9a6",
+	"\U0001f9a7",
+	"\U0001f9a8",
+	"\U0001f9a9",
+	"\U0001f9aa",
+	"\U0001f9ab",
+	"\U0001f9ac",
+	"\U0001f9ad",
+	"\U0001f9ae",
+	"\U0001f9af",
+	"\U0001f9b4",
+	"\U0001f9b5",
+	"\U0001f9b5\U0001f3fb",
+	"\U0001f9b5\U0001f3fc",
+	"\U0001f9b5\U0001f3fd",
+	"\U0001f9b5\U0001f3fe",
+	"\U0001f9b5\U0001f3ff",
+	"\U0001f9b6",
+	"\U0001f9b6\U0001f3fb",
+	"\U0001f9b6\U0001f3fc",
+	"\U0001f9b6\U0001f3fd",
+	"\U0001f9b6\U0001f3fe",
+	"\U0001f9b6\U0001f3ff",
+	"\U0001f9b7",
+	"\U0001f9b8",
+	"\U0001f9b8\u200d\u2640",
+	"\U0001f9b8\u200d\u2642",
+	"\U0001f9b8\U0001f3fb",
+	"\U0001f9b8\U0001f3fb\u200d\u2640",
+	"\U0001f9b8\U0001f3fb\u200d\u2642",
+	"\U0001f9b8\U0001f3fc",
+	"\U0001f9b8\U0001f3fc\u200d\u2640",
+	"\U0001f9b8\U0001f3fc\u200d\u2642",
+	"\U0001f9b8\U0001f3fd",
+	"\U0001f9b8\U0001f3fd\u200d\u2640",
+	"\U0001f9b8\U0001f3fd\u200d\u2642",
+	"\U0001f9b8\U0001f3fe",
+	"\U0001f9b8\U0001f3fe\u200d\u2640",
+	"\U0001f9b8\U0001f3fe\u200d\u2642",
+	"\U0001f9b8\U0001f3ff",
+	"\U0001f9b8\U0001f3ff\u200d\u2640",
+	"\U0001f9b8\U0001f3ff\u200d\u2642",
+	"\U0001f9b9",
+	"\U0001f9b9\u200d\u2640",
+	"\U0001f9b9\u200d\u2642",
+	"\U0001f9b9\U0001f3fb",
+	"\U0001f9b9\U0001f3fb\u200d\u2640",
+	"\U0001f9b9\U0001f3fb\u200d\u2642",
+	"\U0001f9b9\U0001f3fc",
+	"\U0001f9b9\U0001f3fc\u200d\u2640",
+	"\U0001f9b9\U0001f3fc\u200d\u2642",
+	"\U0001f9b9\U0001f3fd",
+	"\U0001f9b9\U0001f3fd\u200d\u2640",
+	"\U0001f9b9\U0001f3fd\u200d\u2642",
+	"\U0001f9b9\U0001f3fe",
+	"\U0001f9b9\U0001f3fe\u200d\u2640",
+	"\U0001f9b9\U0001f3fe\u200d\u2642",
+	"\U0001f9b9\U0001f3ff",
+	"\U0001f9b9\U0001f3ff\u200d\u2640",
+	"\U0001f9b9\U0001f3ff\u200d\u2642",
+	"\U0001f9ba",
+	"\U0001f9bb",
+	"\U0001f9bb\U0001f3fb",
+	"\U0001f9bb\U0001f3fc",
+	"\U0001f9bb\U0001f3fd",
+	"\U0001f9bb\U0001f3fe",
+	"\U0001f9bb\U0001f3ff",
+	"\U0001f9bc",
+	"\U0001f9bd",
+	"\U0001f9be",
+	"\U0001f9bf",
+	"\U0001f9c0",
+	"\U0001f9c1",
+	"\U0001f9c2",
+	"\U0001f9c3",
+	"\U0001f9c4",
+	"\U0001f9c5",
+	"\U0001f9c6",
+	"\U0001f9c7",
+	"\U0001f9c8",
+	"\U0001f9c9",
+	"\U0001f9ca",
+	"\U0001f9cb",
+	"\U0001f9cc",
+	"\U0001f9cd",
+	"\U0001f9cd\u200d\u2640",
+	"\U0001f9cd\u200d\u2642",
+	"\U0001f9cd\U0001f3fb",
+	"\U0001f9cd\U0001f3fb\u200d\u2640",
+	"\U0001f9cd\U0001f3fb\u200d\u2642",
+	"\U0001f9cd\U0001f3fc",
+	"\U0001f9cd\U0001f3fc\u200d\u2640",
+	"\U0001f9cd\U0001f3fc\u200d\u2642",
+	"\U0001f9cd\U0001f3fd",
+	"\U0001f9cd\U0001f3fd\u200d\u2640",
+	"\U0001f9cd\U0001f3fd\u200d\u2642",
+	"\U0001f9cd\U0001f3fe",
+	"\U0001f9cd\U0001f3fe\u200d\u2640",
+	"\U0001f9cd\U0001f3fe\u200d\u2642",
+	"\U0001f9cd\U0001f3ff",
+	"\U0001f9cd\U0001f3ff\u200d\u2640",
+	"\U0001f9cd\U0001f3ff\u200d\u2642",
+	"\U0001f9ce",
+	"\U0001f9ce\u200d\u2640",
+	"\U0001f9ce\u200d\u2640\u200d\u27a1",
+	"\U0001f9ce\u200d\u2642",
+	"\U0001f9ce\u200d\u2642\u200d\u27a1",
+	"\U0001f9ce\u200d\u27a1",
+	"\U0001f9ce\U0001f3fb",
+	"\U0001f9ce\U0001f3fb\u200d\u2640",
+	"\U0001f9ce\U0001f3fb\u200d\u2640\u200d\u27a1",
+	"\U0001f9ce\U0001f3fb\u200d\u2642",
+	"\U0001f9ce\U0001f3fb\u200d\u2642\u200d\u27a1",
+	"\U0001f9ce\U0001f3fb\u200d\u27a1",
+	"\U0001f9ce\U0001f3fc",
+	"\U0001f9ce\U0001f3fc\u200d\u2640",
+	"\U0001f9ce\U0001f3fc\u200d\u2640\u200d\u27a1",
+	"\U0001f9ce\U0001f3fc\u200d\u2642",
+	"\U0001f9ce\U0001f3fc\u200d\u2642\u200d\u27a1",
+	"\U0001f9ce\U0001f3fc\u200d\u27a1",
+	"\U0001f9ce\U0001f3fd",
+	"\U0001f9ce\U0001f3fd\u200d\u2640",
+	"\U0001f9ce\U0001f3fd\u200d\u2640\u200d\u27a1",
+	"\U0001f9ce\U0001f3fd\u200d\u2642",
+	"\U0001f9ce\U0001f3fd\u200d\u2642\u200d\u27a1",
+	"\U0001f9ce\U0001f3fd\u200d\u27a1",
+	"\U0001f9ce\U0001f3fe",
+	"\U0001f9ce\U0001f3fe\u200d\u2640",
+	"\U0001f9ce\U0001f3fe\u200d\u2640\u200d\u27a1",
+	"\U0001f9ce\U0001f3fe\u200d\u2642",
+	"\U0001f9ce\U0001f3fe\u200d\u2642\u200d\u27a1",
+	"\U0001f9ce\U0001f3fe\u200d\u27a1",
+	"\U0001f9ce\U0001f3ff",
+	"\U0001f9ce\U0001f3ff\u200d\u2640",
+	"\U0001f9ce\U0001f3ff\u200d\u2640\u200d\u27a1",
+	"\U0001f9ce\U0001f3ff\u200d\u2642",
+	"\U0001f9ce\U0001f3ff\u200d\u2642\u200d\u27a1",
+	"\U0001f9ce\U0001f3ff\u200d\u27a1",
+	"\U0001f9cf",
+	"\U0001f9cf\u200d\u2640",
+	"\U0001f9cf\u200d\u2642",
+	"\U0001f9cf\U0001f3fb",
+	"\U0001f9cf\U0001f3fb\u200d\u2640",
+	"\U0001f9cf\U0001f3fb\u200d\u2642",
+	"\U0001f9cf\U0001f3fc",
+	"\U0001f9cf\U0001f3fc\u200d\u2640",
+	"\U0001f9cf\U0001f3fc\u200d\u2642",
+	"\U0001f9cf\U0001f3fd",
+	"\U0001f9cf\U0001f3fd\u200d\u2640",
+	"\U0001f9cf\U0001f3fd\u200d\u2642",
+	"\U0001f9cf\U0001f3fe",
+	"\U0001f9cf\U0001f3fe\u200d\u2640",
+	"\U0001f9cf\U0001f3fe\u200d\u2642",
+	"\U0001f9cf\U0001f3ff",
+	"\U0001f9cf\U0001f3ff\u200d\u2640",
+	"\U0001f9cf\U0001f3ff\u200d\u2642",
+	"\U0001f9d0",
+	"\U0001f9d1",
+	"\U0001f9d1\u200d\u2695",
+	"\U0001f9d1\u200d\u2696",
+	"\U0001f9d1\u200d\u2708",
+	"\U0001f9d1\u200d\U0001f33e",
+	"\U0001f9d1\u200d\U0001f373",
+	"\U0001f9d1\u200d\U0001f37c",
+	"\U0001f9d1\u200d\U0001f384",
+	"\U0001f9d1\u200d\U0001f393",
+	"\U0001f9d1\u200d\U0001f3a4",
+	"\U0001f9d1\u200d\U0001f3a8",
+	"\U0001f9d1\u200d\U0001f3eb",
+	"\U0001f9d1\u200d\U0001f3ed",
+	"\U0001f9d1\u200d\U0001f4bb",
+	"\U0001f9d1\u200d\U0001f4bc",
+	"\U0001f9d1\u200d\U0001f527",
+	"\U0001f9d1\u200d\U0001f52c",
+	"\U0001f9d1\u200d\U0001f680",
+	"\U0001f9d1\u200d\U0001f692",
+	"\U0001f9d1\u200d\U0001f91d\u200d\U0001f9d1",
+	"\U0001f9d1\u200d\U0001f9af",
+	"\U0001f9d1\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f9d1\u200d\U0001f9b0",
+	"\U0001f9d1\u200d\U0001f9b1",
+	"\U0001f9d1\u200d\U0001f9b2",
+	"\U0001f9d1\u200d\U0001f9b3",
+	"\U0001f9d1\u200d\U0001f9bc",
+	"\U0001f9d1\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f9d1\u200d\U0001f9bd",
+	"\U0001f9d1\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f9d1\u200d\U0001f9d1\u200d\U0001f9d2",
+	"\U0001f9d1\u200d\U0001f9d1\u200d\U0001f9d2\u200d\U0001f9d2",
+	"\U0001f9d1\u200d\U0001f9d2",
+	"\U0001f9d1\u200d\U0001f9d2\u200d\U0001f9d2",
+	"\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fb\u200d\u2695",
+	"\U0001f9d1\U0001f3fb\u200d\u2696",
+	"\U0001f9d1\U0001f3fb\u200d\u2708",
+	"\U0001f9d1\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fb\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fb\u200d\u2764\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fb\u200d\u2764\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fb\u200d\u2764\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fb\u200d\u2764\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f33e",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f373",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f37c",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f384",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f393",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f3a4",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f3a8",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f3eb",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f3ed",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f4bb",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f4bc",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f527",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f52c",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f680",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f692",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9af",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9b0",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9b1",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9b2",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9b3",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9bc",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9bd",
+	"\U0001f9d1\U0001f3fb\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fc\u200d\u2695",
+	"\U0001f9d1\U0001f3fc\u200d\u2696",
+	"\U0001f9d1\U0001f3fc\u200d\u2708",
+	"\U0001f9d1\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fc\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fc\u200d\u2764\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fc\u200d\u2764\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fc\u200d\u2764\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fc\u200d\u2764\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f33e",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f373",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f37c",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f384",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f393",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f3a4",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f3a8",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f3eb",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f3ed",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f4bb",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f4bc",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f527",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f52c",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f680",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f692",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9af",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9b0",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9b1",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9b2",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9b3",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9bc",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9bd",
+	"\U0001f9d1\U0001f3fc\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fd\u200d\u2695",
+	"\U0001f9d1\U0001f3fd\u200d\u2696",
+	"\U0001f9d1\U0001f3fd\u200d\u2708",
+	"\U0001f9d1\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fd\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fd\u200d\u2764\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fd\u200d\u2764\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fd\u200d\u2764\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fd\u200d\u2764\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f33e",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f373",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f37c",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f384",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f393",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f3a4",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f3a8",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f3eb",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f3ed",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f4bb",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f4bc",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f527",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f52c",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f680",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f692",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9af",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9b0",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9b1",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9b2",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9b3",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9bc",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9bd",
+	"\U0001f9d1\U0001f3fd\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fe\u200d\u2695",
+	"\U0001f9d1\U0001f3fe\u200d\u2696",
+	"\U0001f9d1\U0001f3fe\u200d\u2708",
+	"\U0001f9d1\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fe\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fe\u200d\u2764\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fe\u200d\u2764\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fe\u200d\u2764\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fe\u200d\u2764\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f33e",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f373",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f37c",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f384",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f393",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f3a4",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f3a8",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f3eb",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f3ed",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f4bb",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f4bc",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f527",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f52c",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f680",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f692",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9af",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9b0",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9b1",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9b2",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9b3",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9bc",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9bd",
+	"\U0001f9d1\U0001f3fe\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3ff\u200d\u2695",
+	"\U0001f9d1\U0001f3ff\u200d\u2696",
+	"\U0001f9d1\U0001f3ff\u200d\u2708",
+	"\U0001f9d1\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3ff\u200d\u2764\u200d\U0001f48b\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3ff\u200d\u2764\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3ff\u200d\u2764\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3ff\u200d\u2764\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3ff\u200d\u2764\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f33e",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f373",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f37c",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f384",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f393",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f3a4",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f3a8",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f3eb",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f3ed",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f4bb",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f4bc",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f527",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f52c",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f680",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f692",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fb",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fc",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fd",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3fe",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f91d\u200d\U0001f9d1\U0001f3ff",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9af",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9af\u200d\u27a1",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9b0",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9b1",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9b2",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9b3",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9bc",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9bc\u200d\u27a1",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9bd",
+	"\U0001f9d1\U0001f3ff\u200d\U0001f9bd\u200d\u27a1",
+	"\U0001f9d2",
+	"\U0001f9d2\U0001f3fb",
+	"\U0001f9d2\U0001f3fc",
+	"\U0001f9d2\U0001f3fd",
+	"\U0001f9d2\U0001f3fe",
+	"\U0001f9d2\U0001f3ff",
+	"\U0001f9d3",
+	"\U0001f9d3\U0001f3fb",
+	"\U0001f9d3\U0001f3fc",
+	"\U0001f9d3\U0001f3fd",
+	"\U0001f9d3\U0001f3fe",
+	"\U0001f9d3\U0001f3ff",
+	"\U0001f9d4",
+	"\U0001f9d4\u200d\u2640",
+	"\U0001f9d4\u200d\u2642",
+	"\U0001f9d4\U0001f3fb",
+	"\U0001f9d4\U0001f3fb\u200d\u2640",
+	"\U0001f9d4\U0001f3fb\u200d\u2642",
+	"\U0001f9d4\U0001f3fc",
+	"\U0001f9d4\U0001f3fc\u200d\u2640",
+	"\U0001f9d4\U0001f3fc\u200d\u2642",
+	"\U0001f9d4\U0001f3fd",
+	"\U0001f9d4\U0001f3fd\u200d\u2640",
+	"\U0001f9d4\U0001f3fd\u200d\u2642",
+	"\U0001f9d4\U0001f3fe",
+	"\U0001f9d4\U0001f3fe\u200d\u2640",
+	"\U0001f9d4\U0001f3fe\u200d\u2642",
+	"\U0001f9d4\U0001f3ff",
+	"\U0001f9d4\U0001f3ff\u200d\u2640",
+	"\U0001f9d4\U0001f3ff\u200d\u2642",
+	"\U0001f9d5",
+	"\U0001f9d5\U0001f3fb",
+	"\U0001f9d5\U0001f3fc",
+	"\U0001f9d5\U0001f3fd",
+	"\U0001f9d5\U0001f3fe",
+	"\U0001f9d5\U0001f3ff",
+	"\U0001f9d6",
+	"\U0001f9d6\u200d\u2640",
+	"\U0001f9d6\u200d\u2642",
+	"\U0001f9d6\U0001f3fb",
+	"\U0001f9d6\U0001f3fb\u200d\u2640",
+	"\U0001f9d6\U0001f3fb\u200d\u2642",
+	"\U0001f9d6\U0001f3fc",
+	"\U0001f9d6\U0001f3fc\u200d\u2640",
+	"\U0001f9d6\U0001f3fc\u200d\u2642",
+	"\U0001f9d6\U0001f3fd",
+	"\U0001f9d6\U0001f3fd\u200d\u2640",
+	"\U0001f9d6\U0001f3fd\u200d\u2642",
+	"\U0001f9d6\U0001f3fe",
+	"\U0001f9d6\U0001f3fe\u200d\u2640",
+	"\U0001f9d6\U0001f3fe\u200d\u2642",
+	"\U0001f9d6\U0001f3ff",
+	"\U0001f9d6\U0001f3ff\u200d\u2640",
+	"\U0001f9d6\U0001f3ff\u200d\u2642",
+	"\U0001f9d7",
+	"\U0001f9d7\u200d\u2640",
+	"\U0001f9d7\u200d\u2642",
+	"\U0001f9d7\U0001f3fb",
+	"\U0001f9d7\U0001f3fb\u200d\u2640",
+	"\U0001f9d7\U0001f3fb\u200d\u2642",
+	"\U0001f9d7\U0001f3fc",
+	"\U0001f9d7\U0001f3fc\u200d\u2640",
+	"\U0001f9d7\U0001f3fc\u200d\u2642",
+	"\U0001f9d7\U0001f3fd",
+	"\U0001f9d7\U0001f3fd\u200d\u2640",
+	"\U0001f9d7\U0001f3fd\u200d\u2642",
+	"\U0001f9d7\U0001f3fe",
+	"\U0001f9d7\U0001f3fe\u200d\u2640",
+	"\U0001f9d7\U0001f3fe\u200d\u2642",
+	"\U0001f9d7\U0001f3ff",
+	"\U0001f9d7\U0001f3ff\u200d\u2640",
+	"\U0001f9d7\U0001f3ff\u200d\u2642",
+	"\U0001f9d8",
+	"\U0001f9d8\u200d\u2640",
+	"\U0001f9d8\u200d\u2642",
+	"\U0001f9d8\U0001f3fb",
+	"\U0001f9d8\U0001f3fb\u200d\u2640",
+	"\U0001f9d8\U0001f3fb\u200d\u2642",
+	"\U0001f9d8\U0001f3fc",
+	"\U0001f9d8\U0001f3fc\u200d\u2640",
+	"\U0001f9d8\U0001f3fc\u200d\u2642",
+	"\U0001f9d8\U0001f3fd",
+	"\U0001f9d8\U0001f3fd\u200d\u2640",
+	"\U0001f9d8\U0001f3fd\u200d\u2642",
+	"\U0001f9d8\U0001f3fe",
+	"\U0001f9d8\U0001f3fe\u200d\u2640",
+	"\U0001f9d8\U0001f3fe\u200d\u2642",
+	"\U0001f9d8\U0001f3ff",
+	"\U0001f9d8\U0001f3ff\u200d\u2640",
+	"\U0001f9d8\U0001f3ff\u200d\u2642",
+	"\U0001f9d9",
+	"\U0001f9d9\u200d\u2640",
+	"\U0001f9d9\u200d\u2642",
+	"\U0001f9d9\U0001f3fb",
+	"\U0001f9d9\U0001f3fb\u200d\u2640",
+	"\U0001f9d9\U0001f3fb\u200d\u2642",
+	"\U0001f9d9\U0001f3fc",
+	"\U0001f9d9\U0001f3fc\u200d\u2640",
+	"\U0001f9d9\U0001f3fc\u200d\u2642",
+	"\U0001f9d9\U0001f3fd",
+	"\U0001f9d9\U0001f3fd\u200d\u2640",
+	"\U0001f9d9\U0001f3fd\u200d\u2642",
+	"\U0001f9d9\U0001f3fe",
+	"\U0001f9d9\U0001f3fe\u200d\u2640",
+	"\U0001f9d9\U0001f3fe\u200d\u2642",
+	"\U0001f9d9\U0001f3ff",
+	"\U0001f9d9\U0001f3ff\u200d\u2640",
+	"\U0001f9d9\U0001f3ff\u200d\u2642",
+	"\U0001f9da",
+	"\U0001f9da\u200d\u2640",
+	"\U0001f9da\u200d\u2642",
+	"\U0001f9da\U0001f3fb",
+	"\U0001f9da\U0001f3fb\u200d\u2640",
+	"\U0001f9da\U0001f3fb\u200d\u2642",
+	"\U0001f9da\U0001f3fc",
+	"\U0001f9da\U0001f3fc\u200d\u2640",
+	"\U0001f9da\U0001f3fc\u200d\u2642",
+	"\U0001f9da\U0001f3fd",
+	"\U0001f9da\U0001f3fd\u200d\u2640",
+	"\U0001f9da\U0001f3fd\u200d\u2642",
+	"\U0001f9da\U0001f3fe",
+	"\U0001f9da\U0001f3fe\u200d\u2640",
+	"\U0001f9da\U0001f3fe\u200d\u2642",
+	"\U0001f9da\U0001f3ff",
+	"\U0001f9da\U0001f3ff\u200d\u2640",
+	"\U0001f9da\U0001f3ff\u200d\u2642",
+	"\U0001f9db",
+	"\U0001f9db\u200d\u2640",
+	"\U0001f9db\u200d\u2642",
+	"\U0001f9db\U0001f3fb",
+	"\U0001f9db\U0001f3fb\u200d\u2640",
+	"\U0001f9db\U0001f3fb\u200d\u2642",
+	"\U0001f9db\U0001f3fc",
+	"\U0001f9db\U0001f3fc\u200d\u2640",
+	"\U0001f9db\U0001f3fc\u200d\u2642",
+	"\U0001f9db\U0001f3fd",
+	"\U0001f9db\U0001f3fd\u200d\u2640",
+	"\U0001f9db\U0001f3fd\u200d\u2642",
+	"\U0001f9db\U0001f3fe",
+	"\U0001f9db\U0001f3fe\u200d\u2640",
+	"\U0001f9db\U0001f3fe\u200d\u2642",
+	"\U0001f9db\U0001f3ff",
+	"\U0001f9db\U0001f3ff\u200d\u2640",
+	"\U0001f9db\U0001f3ff\u200d\u2642",
+	"\U0001f9dc",
+	"\U0001f9dc\u200d\u2640",
+	"\U0001f9dc\u200d\u2642",
+	"\U0001f9dc\U0001f3fb",
+	"\U0001f9dc\U0001f3fb\u200d\u2640",
+	"\U0001f9dc\U0001f3fb\u200d\u2642",
+	"\U0001f9dc\U0001f3fc",
+	"\U0001f9dc\U0001f3fc\u200d\u2640",
+	"\U0001f9dc\U0001f3fc\u200d\u2642",
+	"\U0001f9dc\U0001f3fd",
+	"\U0001f9dc\U0001f3fd\u200d\u2640",
+	"\U0001f9dc\U0001f3fd\u200d\u2642",
+	"\U0001f9dc\U0001f3fe",
+	"\U0001f9dc\U0001f3fe\u200d\u2640",
+	"\U0001f9dc\U0001f3fe\u200d\u2642",
+	"\U0001f9dc\U0001f3ff",
+	"\U0001f9dc\U0001f3ff\u200d\u2640",
+	"\U0001f9dc\U0001f3ff\u200d\u2642",
+	"\U0001f9dd",
+	"\U0001f9dd\u200d\u2640",
+	"\U0001f9dd\u200d\u2642",
+	"\U0001f9dd\U0001f3fb",
+	"\U0001f9dd\U0001f3fb\u200d\u2640",
+	"\U0001f9dd\U0001f3fb\u200d\u2642",
+	"\U0001f9dd\U0001f3fc",
+	"\U0001f9dd\U0001f3fc\u200d\u2640",
+	"\U0001f9dd\U0001f3fc\u200d\u2642",
+	"\U0001f9dd\U0001f3fd",
+	"\U0001f9dd\U0001f3fd\u200d\u2640",
+	"\U0001f9dd\U0001f3fd\u200d\u2642",
+	"\U0001f9dd\U0001f3fe",
+	"\U0001f9dd\U0001f3fe\u200d\u2640",
+	"\U0001f9dd\U0001f3fe\u200d\u2642",
+	"\U0001f9dd\U0001f3ff",
+	"\U0001f9dd\U0001f3ff\u200d\u2640",
+	"\U0001f9dd\U0001f3ff\u200d\u2642",
+	"\U0001f9de",
+	"\U0001f9de\u200d\u2640",
+	"\U0001f9de\u200d\u2642",
+	"\U0001f9df",
+	"\U0001f9df\u200d\u2640",
+	"\U0001f9df\u200d\u2642",
+	"\U0001f9e0",
+	"\U0001f9e1",
+	"\U0001f9e2",
+	"\U0001f9e3",
+	"\U0001f9e4",
+	"\U0001f9e5",
+	"\U0001f9e6",
+	"\U0001f9e7",
+	"\U0001f9e8",
+	"\U0001f9e9",
+	"\U0001f9ea",
+	"\U0001f9eb",
+	"\U0001f9ec",
+	"\U0001f9ed",
+	"\U0001f9ee",
+	"\U0001f9ef",
+	"\U0001f9f0",
+	"\U0001f9f1",
+	"\U0001f9f2",
+	"\U0001f9f3",
+	"\U0001f9f4",
+	"\U0001f9f5",
+	"\U0001f9f6",
+	"\U0001f9f7",
+	"\U0001f9f8",
+	"\U0001f9f9",
+	"\U0001f9fa",
+	"\U0001f9fb",
+	"\U0001f9fc",
+	"\U0001f9fd",
+	"\U0001f9fe",
+	"\U0001f9ff",
+	"\U0001fa70",
+	"\U0001fa71",
+	"\U0001fa72",
+	"\U0001fa73",
+	"\U0001fa74",
+	"\U0001fa75",
+	"\U0001fa76",
+	"\U0001fa77",
+	"\U0001fa78",
+	"\U0001fa79",
+	"\U0001fa7a",
+	"\U0001fa7b",
+	"\U0001fa7c",
+	"\U0001fa80",
+	"\U0001fa81",
+	"\U0001fa82",
+	"\U0001fa83",
+	"\U0001fa84",
+	"\U0001fa85",
+	"\U0001fa86",
+	"\U0001fa87",
+	"\U0001fa88",
+	"\U0001fa90",
+	"\U0001fa91",
+	"\U0001fa92",
+	"\U0001fa93",
+	"\U0001fa94",
+	"\U0001fa95",
+	"\U0001fa96",
+	"\U0001fa97",
+	"\U0001fa98",
+	"\U0001fa99",
+	"\U0001fa9a",
+	"\U0001fa9b",
+	"\U0001fa9c",
+	"\U0001fa9d",
+	"\U0001fa9e",
+	"\U0001fa9f",
+	"\U0001faa0",
+	"\U0001faa1",
+	"\U0001faa2",
+	"\U0001faa3",
+	"\U0001faa4",
+	"\U0001faa5",
+	"\U0001faa6",
+	"\U0001faa7",
+	"\U0001faa8",
+	"\U0001faa9",
+	"\U0001faaa",
+	"\U0001faab",
+	"\U0001faac",
+	"\U0001faad",
+	"\U0001faae",
+	"\U0001faaf",
+	"\U0001fab0",
+	"\U0001fab1",
+	"\U0001fab2",
+	"\U0001fab3",
+	"\U0001fab4",
+	"\U0001fab5",
+	"\U0001fab6",
+	"\U0001fab7",
+	"\U0001fab8",
+	"\U0001fab9",
+	"\U0001faba",
+	"\U0001fabb",
+	"\U0001fabc",
+	"\U0001fabd",
+	"\U0001fabf",
+	"\U0001fac0",
+	"\U0001fac1",
+	"\U0001fac2",
+	"\U0001fac3",
+	"\U0001fac3\U0001f3fb",
+	"\U0001fac3\U0001f3fc",
+	"\U0001fac3\U0001f3fd",
+	"\U0001fac3\U0001f3fe",
+	"\U0001fac3\U0001f3ff",
+	"\U0001fac4",
+	"\U0001fac4\U0001f3fb",
+	"\U0001fac4\U0001f3fc",
+	"\U0001fac4\U0001f3fd",
+	"\U0001fac4\U0001f3fe",
+	"\U0001fac4\U0001f3ff",
+	"\U0001fac5",
+	"\U0001fac5\U0001f3fb",
+	"\U0001fac5\U0001f3fc",
+	"\U0001fac5\U0001f3fd",
+	"\U0001fac5\U0001f3fe",
+	"\U0001fac5\U0001f3ff",
+	"\U0001face",
+	"\U0001facf",
+	"\U0001fad0",
+	"\U0001fad1",
+	"\U0001fad2",
+	"\U0001fad3",
+	"\U0001fad4",
+	"\U0001fad5",
+	"\U0001fad6",
+	"\U0001fad7",
+	"\U0001fad8",
+	"\U0001fad9",
+	"\U0001fada",
+	"\U0001fadb",
+	"\U0001fae0",
+	"\U0001fae1",
+	"\U0001fae2",
+	"\U0001fae3",
+	"\U0001fae4",
+	"\U0001fae5",
+	"\U0001fae6",
+	"\U0001fae7",
+	"\U0001fae8",
+	"\U0001faf0",
+	"\U0001faf0\U0001f3fb",
+	"\U0001faf0\U0001f3fc",
+	"\U0001faf0\U0001f3fd",
+	"\U0001faf0\U0001f3fe",
+	"\U0001faf0\U0001f3ff",
+	"\U0001faf1",
+	"\U0001faf1\U0001f3fb",
+	"\U0001faf1\U0001f3fb\u200d\U0001faf2\U0001f3fc",
+	"\U0001faf1\U0001f3fb\u200d\U0001faf2\U0001f3fd",
+	"\U0001faf1\U0001f3fb\u200d\U0001faf2\U0001f3fe",
+	"\U0001faf1\U0001f3fb\u200d\U0001faf2\U0001f3ff",
+	"\U0001faf1\U0001f3fc",
+	"\U0001faf1\U0001f3fc\u200d\U0001faf2\U0001f3fb",
+	"\U0001faf1\U0001f3fc\u200d\U0001faf2\U0001f3fd",
+	"\U0001faf1\U0001f3fc\u200d\U0001faf2\U0001f3fe",
+	"\U0001faf1\U0001f3fc\u200d\U0001faf2\U0001f3ff",
+	"\U0001faf1\U0001f3fd",
+	"\U0001faf1\U0001f3fd\u200d\U0001faf2\U0001f3fb",
+	"\U0001faf1\U0001f3fd\u200d\U0001faf2\U0001f3fc",
+	"\U0001faf1\U0001f3fd\u200d\U0001faf2\U0001f3fe",
+	"\U0001faf1\U0001f3fd\u200d\U0001faf2\U0001f3ff",
+	"\U0001faf1\U0001f3fe",
+	"\U0001faf1\U0001f3fe\u200d\U0001faf2\U0001f3fb",
+	"\U0001faf1\U0001f3fe\u200d\U0001faf2\U0001f3fc",
+	"\U0001faf1\U0001f3fe\u200d\U0001faf2\U0001f3fd",
+	"\U0001faf1\U0001f3fe\u200d\U0001faf2\U0001f3ff",
+	"\U0001faf1\U0001f3ff",
+	"\U0001faf1\U0001f3ff\u200d\U0001faf2\U0001f3fb",
+	"\U0001faf1\U0001f3ff\u200d\U0001faf2\U0001f3fc",
+	"\U0001faf1\U0001f3ff\u200d\U0001faf2\U0001f3fd",
+	"\U0001faf1\U0001f3ff\u200d\U0001faf2\U0001f3fe",
+	"\U0001faf2",
+	"\U0001faf2\U0001f3fb",
+	"\U0001faf2\U0001f3fc",
+	"\U0001faf2\U0001f3fd",
+	"\U0001faf2\U0001f3fe",
+	"\U0001faf2\U0001f3ff",
+	"\U0001faf3",
+	"\U0001faf3\U0001f3fb",
+	"\U0001faf3\U0001f3fc",
+	"\U0001faf3\U0001f3fd",
+	"\U0001faf3\U0001f3fe",
+	"\U0001faf3\U0001f3ff",
+	"\U0001faf4",
+	"\U0001faf4\U0001f3fb",
+	"\U0001faf4\U0001f3fc",
+	"\U0001faf4\U0001f3fd",
+	"\U0001faf4\U0001f3fe",
+	"\U0001faf4\U0001f3ff",
+	"\U0001faf5",
+	"\U0001faf5\U0001f3fb",
+	"\U0001faf5\U0001f3fc",
+	"\U0001faf5\U0001f3fd",
+	"\U0001faf5\U0001f3fe",
+	"\U0001faf5\U0001f3ff",
+	"\U0001faf6",
+	"\U0001faf6\U0001f3fb",
+	"\U0001faf6\U0001f3fc",
+	"\U0001faf6\U0001f3fd",
+	"\U0001faf6\U0001f3fe",
+	"\U0001faf6\U0001f3ff",
+	"\U0001faf7",
+	"\U0001faf7\U0001f3fb",
+	"\U0001faf7\U0001f3fc",
+	"\U0001faf7\U0001f3fd",
+	"\U0001faf7\U0001f3fe",
+	"\U0001faf7\U0001f3ff",
+	"\U0001faf8",
+	"\U0001faf8\U0001f3fb",
+	"\U0001faf8\U0001f3fc",
+	"\U0001faf8\U0001f3fd",
+	"\U0001faf8\U0001f3fe",
+	"\U0001faf8\U0001f3ff",
+}
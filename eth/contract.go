@@ -8,7 +8,6 @@ import (
 
 	"github.com/chenzhijie/go-web3/rpc"
 	"github.com/chenzhijie/go-web3/types"
-	"github.com/chenzhijie/go-web3/utils"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -18,6 +17,7 @@ type Contract struct {
 	abi      abi.ABI
 	addr     common.Address
 	provider *rpc.Client
+	e        *Eth
 }
 
 func (c *Contract) AllMethods() []string {
@@ -33,11 +33,29 @@ func (c *Contract) Methods(methodName string) abi.Method {
 	return m
 }
 
+func (c *Contract) AllEvents() []string {
+	eventNames := make([]string, 0)
+	for eventName := range c.abi.Events {
+		eventNames = append(eventNames, eventName)
+	}
+	return eventNames
+}
+
+func (c *Contract) Event(eventName string) abi.Event {
+	e, _ := c.abi.Events[eventName]
+	return e
+}
+
 func (c *Contract) Address() common.Address {
 	return c.addr
 }
 
 func (c *Contract) Call(methodName string, args ...interface{}) (interface{}, error) {
+	return c.CallAt(types.BlockNumberOrHashWithTag(types.LatestBlock), methodName, args...)
+}
+
+// CallAt calls the method at a block selected by number, tag or hash
+func (c *Contract) CallAt(block types.BlockNumberOrHash, methodName string, args ...interface{}) (interface{}, error) {
 
 	data, err := c.EncodeABI(methodName, args...)
 
@@ -51,8 +69,8 @@ func (c *Contract) Call(methodName string, args ...interface{}) (interface{}, er
 	}
 
 	var out string
-	if err := c.provider.Call("eth_call", &out, msg, "latest"); err != nil {
-		return nil, err
+	if err := c.provider.Call("eth_call", &out, msg, block); err != nil {
+		return nil, c.wrapRevert(err)
 	}
 
 	outputBytes, err := hexutil.Decode(out)
@@ -75,6 +93,12 @@ func (c *Contract) CallWithMultiReturns(methodName string, args ...interface{})
 }
 
 func (c *Contract) CallAtWithMultiReturns(blockNumber *big.Int, methodName string, args ...interface{}) ([]interface{}, error) {
+	return c.CallWithMultiReturnsAt(types.BlockNumberOrHashWithNumber(blockNumber), methodName, args...)
+}
+
+// CallWithMultiReturnsAt calls the method at a block selected by number, tag or hash
+// and returns all outputs
+func (c *Contract) CallWithMultiReturnsAt(block types.BlockNumberOrHash, methodName string, args ...interface{}) ([]interface{}, error) {
 
 	data, err := c.EncodeABI(methodName, args...)
 
@@ -89,8 +113,8 @@ func (c *Contract) CallAtWithMultiReturns(blockNumber *big.Int, methodName strin
 	}
 
 	var out string
-	if err := c.provider.Call("eth_call", &out, msg, utils.ToBlockNumArg(blockNumber)); err != nil {
-		return nil, err
+	if err := c.provider.Call("eth_call", &out, msg, block); err != nil {
+		return nil, c.wrapRevert(err)
 	}
 
 	outputBytes, err := hexutil.Decode(out)
@@ -131,7 +155,7 @@ func (c *Contract) CallWithFromAndValue(
 
 	var out string
 	if err := c.provider.Call("eth_call", &out, msg, "latest"); err != nil {
-		return nil, err
+		return nil, c.wrapRevert(err)
 	}
 
 	outputBytes, err := hexutil.Decode(out)
@@ -149,6 +173,56 @@ func (c *Contract) CallWithFromAndValue(
 	return response, nil
 }
 
+// CallOpts are the options of Contract.CallWithOpts.
+// Block selects the block by number, tag or hash and takes precedence over BlockNumber.
+type CallOpts struct {
+	From           common.Address
+	Value          *big.Int
+	BlockNumber    *big.Int
+	Block          *types.BlockNumberOrHash
+	StateOverride  types.StateOverride
+	BlockOverrides *types.BlockOverrides
+}
+
+// CallWithOpts calls the method with from, value, block and state overrides of opts
+func (c *Contract) CallWithOpts(opts *CallOpts, methodName string, args ...interface{}) ([]interface{}, error) {
+	if opts == nil {
+		opts = &CallOpts{}
+	}
+
+	data, err := c.EncodeABI(methodName, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &types.CallMsg{
+		From: opts.From,
+		To:   c.addr,
+		Data: data,
+		Gas:  types.NewCallMsgBigInt(big.NewInt(types.MAX_GAS_LIMIT)),
+	}
+	if opts.Value != nil {
+		msg.Value = types.NewCallMsgBigInt(opts.Value)
+	}
+
+	block := types.BlockNumberOrHashWithNumber(opts.BlockNumber)
+	if opts.Block != nil {
+		block = *opts.Block
+	}
+
+	var out string
+	if err := c.provider.Call("eth_call", &out, callParams(msg, block, opts.StateOverride, opts.BlockOverrides)...); err != nil {
+		return nil, c.wrapRevert(err)
+	}
+
+	outputBytes, err := hexutil.Decode(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.abi.Unpack(methodName, outputBytes)
+}
+
 func (c *Contract) EncodeABI(methodName string, args ...interface{}) ([]byte, error) {
 	m := c.Methods(methodName)
 	if len(m.ID) == 0 {
@@ -189,6 +263,7 @@ func (e *Eth) NewContract(abiString string, contractAddr ...string) (*Contract,
 		return nil, err
 	}
 	c.provider = e.c
+	c.e = e
 
 	return c, nil
 }
@@ -1,13 +1,16 @@
 package eth
 
 import (
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/chenzhijie/go-web3/rpc"
 	"github.com/chenzhijie/go-web3/types"
 	"github.com/chenzhijie/go-web3/utils"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 func TestContractCall(t *testing.T) {
@@ -89,3 +92,58 @@ func TestCallWithMethodSignature(t *testing.T) {
 	addr := common.HexToAddress(result)
 	fmt.Printf("addr %v, type %T\n", addr, result)
 }
+
+func TestContractCallWithOverrides(t *testing.T) {
+	holder := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	token := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	m := newMockServer(t)
+	var params []json.RawMessage
+	m.handle("eth_call", func(p []json.RawMessage) (interface{}, error) {
+		params = p
+		return "0x" + fmt.Sprintf("%064x", 1000), nil
+	})
+	e := m.eth(t)
+
+	contr, err := e.NewContract(`[{"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`, token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	override := types.StateOverride{}
+	override.SetStateDiff(token, types.MappingSlot(holder.Bytes(), 0), common.BigToHash(big.NewInt(1000)))
+	ret, err := contr.CallWithOpts(&CallOpts{
+		BlockNumber:    big.NewInt(100),
+		StateOverride:  override,
+		BlockOverrides: &types.BlockOverrides{Coinbase: &holder},
+	}, "balanceOf", holder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret[0].(*big.Int).Int64() != 1000 {
+		t.Fatalf("unexpected result %v", ret)
+	}
+	if len(params) != 4 || string(params[1]) != `"0x64"` {
+		t.Fatalf("unexpected params %s", params)
+	}
+	var sent types.StateOverride
+	if err := json.Unmarshal(params[2], &sent); err != nil {
+		t.Fatal(err)
+	}
+	if len(sent[token].StateDiff) != 1 {
+		t.Fatalf("unexpected state override %s", params[2])
+	}
+
+	// block overrides without state override still send an empty state override
+	if _, err := e.CallWithOverrides(&types.CallMsg{To: token}, nil, nil, &types.BlockOverrides{Number: (*hexutil.Big)(big.NewInt(1))}); err != nil {
+		t.Fatal(err)
+	}
+	if len(params) != 4 || string(params[2]) != "{}" {
+		t.Fatalf("unexpected params %s", params)
+	}
+	if _, err := e.CallWithOverrides(&types.CallMsg{To: token}, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(params) != 2 {
+		t.Fatalf("unexpected params %s", params)
+	}
+}
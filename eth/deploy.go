@@ -0,0 +1,233 @@
+package eth
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/chenzhijie/go-web3/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Create2FactoryAddress is the deterministic deployment proxy deployed at the same address
+// on most chains, it creates salt ++ init code with CREATE2
+var Create2FactoryAddress = common.HexToAddress("0x4e59b44847b379578588920cA78FbF26c0B4956C")
+
+// TxOpts are the options of the txs sent by DeployContract and Contract.Transact, zero
+// values are filled from the node: the pending nonce, the estimated gas and the gas oracle
+// fees. A legacy tx is sent when GasPrice is set or the oracle suggests legacy fees, in
+// which case setting only one of GasTipCap and GasFeeCap fails with ErrLegacyFeeMode.
+type TxOpts struct {
+	// From selects the sending account of the wallet, the default account if zero
+	From      common.Address
+	Value     *big.Int
+	Nonce     *uint64
+	GasLimit  uint64
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+	// Confirmations is the number of blocks waited for, including the tx block
+	Confirmations uint64
+}
+
+// DeployOpts are the options of DeployContract. With a Salt the contract is created with
+// CREATE2 through the Factory, Create2FactoryAddress if zero.
+type DeployOpts struct {
+	TxOpts
+	Salt    *common.Hash
+	Factory common.Address
+}
+
+// ComputeCreateAddress returns the address of the contract created by deployer with nonce
+func ComputeCreateAddress(deployer common.Address, nonce uint64) common.Address {
+	return crypto.CreateAddress(deployer, nonce)
+}
+
+// ComputeCreate2Address returns the address of the contract created by deployer with CREATE2
+func ComputeCreate2Address(deployer common.Address, salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(deployer, salt, crypto.Keccak256(initCode))
+}
+
+// DeployContract deploys bytecode with the encoded constructor args, waits for the receipt
+// and returns the contract bound to its address. With opts.Salt the contract is created
+// through the CREATE2 factory; if it is already deployed at the computed address, the
+// bound contract is returned with a nil receipt and no tx is sent.
+func (e *Eth) DeployContract(
+	abiString string,
+	bytecode []byte,
+	opts *DeployOpts,
+	constructorArgs ...interface{},
+) (*Contract, *eTypes.Receipt, error) {
+	if opts == nil {
+		opts = &DeployOpts{}
+	}
+	contr, err := e.NewContract(abiString)
+	if err != nil {
+		return nil, nil, err
+	}
+	args, err := contr.abi.Pack("", constructorArgs...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode constructor: %w", err)
+	}
+	initCode := append(common.CopyBytes(bytecode), args...)
+
+	if opts.Salt == nil {
+		tx, err := e.sendTx(&opts.TxOpts, nil, initCode)
+		if err != nil {
+			return nil, nil, err
+		}
+		receipt, err := e.waitTx(tx.Hash(), opts.Confirmations, contr)
+		if err != nil {
+			return nil, receipt, err
+		}
+		contr.addr = receipt.ContractAddress
+		return contr, receipt, nil
+	}
+
+	factory := opts.Factory
+	if factory == (common.Address{}) {
+		factory = Create2FactoryAddress
+	}
+	contr.addr = ComputeCreate2Address(factory, *opts.Salt, initCode)
+	code, err := e.GetCodeAt(contr.addr, types.BlockNumberOrHashWithTag(types.LatestBlock))
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(code) > 0 {
+		return contr, nil, nil
+	}
+
+	tx, err := e.sendTx(&opts.TxOpts, &factory, append(opts.Salt.Bytes(), initCode...))
+	if err != nil {
+		return nil, nil, err
+	}
+	receipt, err := e.waitTx(tx.Hash(), opts.Confirmations, contr)
+	if err != nil {
+		return nil, receipt, err
+	}
+	// the factory does not revert when the creation fails
+	code, err = e.GetCodeAt(contr.addr, types.BlockNumberOrHashWithNumber(receipt.BlockNumber))
+	if err != nil {
+		return nil, receipt, err
+	}
+	if len(code) == 0 {
+		return nil, receipt, fmt.Errorf("no contract created at %v by tx %v", contr.addr, tx.Hash())
+	}
+	return contr, receipt, nil
+}
+
+// sendTx fills, signs and sends a tx from opts.From, a nil to creates a contract
+func (e *Eth) sendTx(opts *TxOpts, to *common.Address, data []byte) (*eTypes.Transaction, error) {
+	view := e
+	if opts.From != (common.Address{}) {
+		var err error
+		if view, err = e.WithAccount(opts.From); err != nil {
+			return nil, err
+		}
+	}
+	signer := view.Signer()
+	if signer == nil {
+		return nil, errNoSigner
+	}
+	from := signer.Address()
+
+	chainId, err := view.ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce uint64
+	if opts.Nonce != nil {
+		nonce = *opts.Nonce
+	} else if nonce, err = view.GetNonceAt(from, types.BlockNumberOrHashWithTag(types.PendingBlock)); err != nil {
+		return nil, err
+	}
+
+	gasLimit := opts.GasLimit
+	if gasLimit == 0 {
+		msg := &txMsg{From: from, To: to, Value: (*hexutil.Big)(opts.Value), Data: data}
+		var out string
+		if err := view.c.Call("eth_estimateGas", &out, msg); err != nil {
+			return nil, wrapRevert(nil, err)
+		}
+		if gasLimit, err = utils.ParseUint64orHex(out); err != nil {
+			return nil, err
+		}
+	}
+
+	gasPrice, gasTipCap, gasFeeCap := opts.GasPrice, opts.GasTipCap, opts.GasFeeCap
+	if gasPrice == nil && (gasTipCap == nil || gasFeeCap == nil) {
+		fee, err := view.SuggestFee()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case fee.IsLegacy() && (gasTipCap != nil || gasFeeCap != nil):
+			return nil, fmt.Errorf("fee caps set: %w", ErrLegacyFeeMode)
+		case fee.IsLegacy():
+			gasPrice = fee.GasPrice
+		default:
+			if gasTipCap == nil {
+				gasTipCap = fee.MaxPriorityFeePerGas
+			}
+			if gasFeeCap == nil {
+				gasFeeCap = fee.MaxFeePerGas
+			}
+		}
+	}
+
+	var tx *eTypes.Transaction
+	if gasPrice != nil {
+		tx = eTypes.NewTx(&eTypes.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			Gas:      gasLimit,
+			To:       to,
+			Value:    opts.Value,
+			Data:     data,
+		})
+	} else {
+		tx = eTypes.NewTx(&eTypes.DynamicFeeTx{
+			ChainID:   chainId,
+			Nonce:     nonce,
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       gasLimit,
+			To:        to,
+			Value:     opts.Value,
+			Data:      data,
+		})
+	}
+	signedTx, err := signer.SignTx(tx, chainId)
+	if err != nil {
+		return nil, err
+	}
+	txData, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	var hash common.Hash
+	if err := view.c.Call("eth_sendRawTransaction", &hash, hexutil.Encode(txData)); err != nil {
+		return nil, err
+	}
+	return signedTx, nil
+}
+
+// waitTx waits for the receipt of hash, failed txs return the receipt and the revert
+// reason decoded with the errors of contract if not nil
+func (e *Eth) waitTx(hash common.Hash, confirmations uint64, contract *Contract) (*eTypes.Receipt, error) {
+	receipt, err := e.waitMinedWithTimeout(hash, confirmations)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status == eTypes.ReceiptStatusSuccessful {
+		return receipt, nil
+	}
+	if rev, err := e.TransactionRevertReason(hash, contract); err == nil {
+		return receipt, fmt.Errorf("tx %v failed: %w", hash, rev)
+	}
+	return receipt, fmt.Errorf("tx %v failed", hash)
+}
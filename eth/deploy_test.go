@@ -0,0 +1,223 @@
+package eth
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const deployTestABI = `[{"inputs":[{"name":"owner","type":"address"},{"name":"supply","type":"uint256"}],"stateMutability":"nonpayable","type":"constructor"}]`
+
+var deployTestCode = hexutil.MustDecode("0x6080604052348015600f57600080fd5b50")
+
+// mockTxChain mines every sent tx in its own block. Contract creations and CREATE2 factory
+// calls get code, receipt logs come from the logs hook.
+type mockTxChain struct {
+	lock     sync.Mutex
+	head     uint64
+	nonces   map[common.Address]uint64
+	code     map[common.Address][]byte
+	sent     []*eTypes.Transaction
+	receipts map[common.Hash]*eTypes.Receipt
+	logs     func(tx *eTypes.Transaction) []*eTypes.Log
+}
+
+func newMockTxChain(t *testing.T) (*mockTxChain, *mockServer, *Eth) {
+	c := &mockTxChain{
+		head:     100,
+		nonces:   map[common.Address]uint64{},
+		code:     map[common.Address][]byte{},
+		receipts: map[common.Hash]*eTypes.Receipt{},
+	}
+	m := newMockServer(t)
+	m.handle("eth_chainId", func(params []json.RawMessage) (interface{}, error) {
+		return "0x1", nil
+	})
+	m.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		var addr common.Address
+		if err := json.Unmarshal(params[0], &addr); err != nil {
+			return nil, err
+		}
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return hexutil.Uint64(c.nonces[addr]), nil
+	})
+	m.handle("eth_estimateGas", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.Uint64(300000), nil
+	})
+	m.handle("eth_getCode", func(params []json.RawMessage) (interface{}, error) {
+		var addr common.Address
+		if err := json.Unmarshal(params[0], &addr); err != nil {
+			return nil, err
+		}
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return hexutil.Bytes(c.code[addr]), nil
+	})
+	m.handle("eth_sendRawTransaction", func(params []json.RawMessage) (interface{}, error) {
+		var data hexutil.Bytes
+		if err := json.Unmarshal(params[0], &data); err != nil {
+			return nil, err
+		}
+		tx := new(eTypes.Transaction)
+		if err := tx.UnmarshalBinary(data); err != nil {
+			return nil, err
+		}
+		from, err := eTypes.Sender(eTypes.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return nil, err
+		}
+		c.mine(from, tx)
+		return tx.Hash(), nil
+	})
+	m.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		var hash common.Hash
+		if err := json.Unmarshal(params[0], &hash); err != nil {
+			return nil, err
+		}
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return c.receipts[hash], nil
+	})
+	m.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return hexutil.Uint64(c.head), nil
+	})
+	m.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		var number hexutil.Uint64
+		if err := json.Unmarshal(params[0], &number); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"hash": common.BigToHash(new(big.Int).SetUint64(uint64(number)))}, nil
+	})
+
+	e := m.eth(t)
+	if err := e.SetAccount(privateKeyUsedForTest); err != nil {
+		t.Fatal(err)
+	}
+	e.SetTxPollTimeout(5)
+	return c, m, e
+}
+
+func (c *mockTxChain) mine(from common.Address, tx *eTypes.Transaction) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.head++
+	c.sent = append(c.sent, tx)
+	c.nonces[from] = tx.Nonce() + 1
+
+	receipt := &eTypes.Receipt{
+		Type:        tx.Type(),
+		Status:      eTypes.ReceiptStatusSuccessful,
+		TxHash:      tx.Hash(),
+		GasUsed:     21000,
+		BlockNumber: new(big.Int).SetUint64(c.head),
+		BlockHash:   common.BigToHash(new(big.Int).SetUint64(c.head)),
+		Logs:        []*eTypes.Log{},
+	}
+	switch {
+	case tx.To() == nil:
+		receipt.ContractAddress = ComputeCreateAddress(from, tx.Nonce())
+		c.code[receipt.ContractAddress] = tx.Data()
+	case *tx.To() == Create2FactoryAddress:
+		salt := common.BytesToHash(tx.Data()[:32])
+		c.code[ComputeCreate2Address(Create2FactoryAddress, salt, tx.Data()[32:])] = tx.Data()[32:]
+	}
+	if c.logs != nil {
+		receipt.Logs = c.logs(tx)
+		for i, log := range receipt.Logs {
+			log.TxHash, log.BlockNumber, log.BlockHash, log.Index = tx.Hash(), c.head, receipt.BlockHash, uint(i)
+		}
+	}
+	c.receipts[tx.Hash()] = receipt
+}
+
+func TestComputeAddresses(t *testing.T) {
+	// https://eips.ethereum.org/EIPS/eip-1014 example 5
+	deployer := common.HexToAddress("0x00000000000000000000000000000000deadbeef")
+	salt := common.HexToHash("0x00000000000000000000000000000000000000000000000000000000cafebabe")
+	if addr := ComputeCreate2Address(deployer, salt, hexutil.MustDecode("0xdeadbeef")); addr != common.HexToAddress("0x60f3f640a8508fC6a86d45DF051962668E1e8AC7") {
+		t.Fatalf("unexpected create2 address %v", addr)
+	}
+	sender := common.HexToAddress("0x6ac7ea33f8831ea9dcc53393aaa88b25a785dbf0")
+	if addr := ComputeCreateAddress(sender, 0); addr != common.HexToAddress("0xcd234a471b72ba2f1ccf0a70fcaba648a5eecd8d") {
+		t.Fatalf("unexpected create address %v", addr)
+	}
+}
+
+func TestDeployContract(t *testing.T) {
+	chain, _, e := newMockTxChain(t)
+	e.SetGasOracle(NewFixedGasOracle(gwei(30), gwei(2)))
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	chain.nonces[e.Address()] = 7
+
+	contr, receipt, err := e.DeployContract(deployTestABI, deployTestCode, nil, owner, big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if contr.Address() != ComputeCreateAddress(e.Address(), 7) || receipt.ContractAddress != contr.Address() {
+		t.Fatalf("unexpected contract address %v", contr.Address())
+	}
+	tx := chain.sent[0]
+	if tx.To() != nil || tx.Nonce() != 7 || tx.Gas() != 300000 || tx.GasFeeCap().Cmp(gwei(30)) != 0 {
+		t.Fatalf("unexpected tx %+v", tx)
+	}
+	if len(tx.Data()) != len(deployTestCode)+64 || common.BytesToAddress(tx.Data()[len(deployTestCode):len(deployTestCode)+32]) != owner {
+		t.Fatalf("unexpected init code %x", tx.Data())
+	}
+
+	if _, _, err := e.DeployContract(deployTestABI, deployTestCode, nil, owner); err == nil {
+		t.Fatal("expected constructor encoding error")
+	}
+
+	// a fee cap is not dropped for the legacy fees of the oracle
+	e.SetGasOracle(GasOracleFunc(func() (*EstimateFee, error) {
+		return &EstimateFee{Mode: FeeModeLegacy, GasPrice: gwei(10), MaxFeePerGas: gwei(10), MaxPriorityFeePerGas: gwei(10)}, nil
+	}))
+	opts := &DeployOpts{TxOpts: TxOpts{GasFeeCap: gwei(20)}}
+	if _, _, err := e.DeployContract(deployTestABI, deployTestCode, opts, owner, big.NewInt(1000)); !errors.Is(err, ErrLegacyFeeMode) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(chain.sent) != 1 {
+		t.Fatal("tx sent without the fee cap")
+	}
+}
+
+func TestDeployContractCreate2(t *testing.T) {
+	chain, m, e := newMockTxChain(t)
+	salt := common.HexToHash("0x01")
+	owner := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	opts := &DeployOpts{Salt: &salt, TxOpts: TxOpts{GasPrice: gwei(5), GasLimit: 500000}}
+
+	contr, receipt, err := e.DeployContract(deployTestABI, deployTestCode, opts, owner, big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := chain.sent[0]
+	initCode := tx.Data()[32:]
+	if *tx.To() != Create2FactoryAddress || tx.Type() != eTypes.LegacyTxType || common.BytesToHash(tx.Data()[:32]) != salt {
+		t.Fatalf("unexpected tx %+v", tx)
+	}
+	if contr.Address() != ComputeCreate2Address(Create2FactoryAddress, salt, initCode) || receipt == nil {
+		t.Fatalf("unexpected contract address %v", contr.Address())
+	}
+	if m.callCount("eth_estimateGas") != 0 {
+		t.Fatal("gas estimated despite the gas limit")
+	}
+
+	// deploying again finds the contract
+	again, receipt, err := e.DeployContract(deployTestABI, deployTestCode, opts, owner, big.NewInt(1000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Address() != contr.Address() || receipt != nil || len(chain.sent) != 1 {
+		t.Fatalf("contract deployed again at %v", again.Address())
+	}
+}
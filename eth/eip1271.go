@@ -0,0 +1,238 @@
+package eth
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/chenzhijie/go-web3/utils"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const ERC1271_ABI = `[
+	{
+		"inputs": [
+			{"internalType": "bytes32", "name": "hash", "type": "bytes32"},
+			{"internalType": "bytes", "name": "signature", "type": "bytes"}
+		],
+		"name": "isValidSignature",
+		"outputs": [{"internalType": "bytes4", "name": "magicValue", "type": "bytes4"}],
+		"stateMutability": "view",
+		"type": "function"
+	}
+]`
+
+var (
+	// ERC1271MagicValue is bytes4(keccak256("isValidSignature(bytes32,bytes)"))
+	ERC1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+	// ERC6492MagicSuffix is appended to signatures of counterfactual contract wallets
+	ERC6492MagicSuffix = common.FromHex("0x6492649264926492649264926492649264926492649264926492649264926492")
+)
+
+// IsValidSignature checks the hash signature of signer. ECDSA recovery is tried first;
+// if signer is a contract, EIP-1271 isValidSignature is called on it.
+// ERC-6492 wrapped signatures of not yet deployed wallets are validated by simulating the
+// wallet deployment and the isValidSignature call in a single eth_call.
+func (e *Eth) IsValidSignature(signer common.Address, hash []byte, signature []byte) (bool, error) {
+	if len(hash) != common.HashLength {
+		return false, fmt.Errorf("hash is required to be exactly %d bytes (%d)", common.HashLength, len(hash))
+	}
+
+	if IsERC6492Signature(signature) {
+		factory, factoryCalldata, innerSig, err := UnwrapERC6492Signature(signature)
+		if err != nil {
+			return false, err
+		}
+		code, err := e.GetCode(signer, nil)
+		if err != nil {
+			return false, err
+		}
+		if len(code) == 0 {
+			return e.isValidSignatureDeployless(signer, hash, factory, factoryCalldata, innerSig)
+		}
+		signature = innerSig
+	}
+
+	if _, err := VerifyHash(signer, hash, signature); err == nil {
+		return true, nil
+	}
+
+	code, err := e.GetCode(signer, nil)
+	if err != nil {
+		return false, err
+	}
+	if len(code) == 0 {
+		return false, nil
+	}
+	return e.isValidSignatureERC1271(signer, hash, signature)
+}
+
+// IsValidTextSignature checks a SignText signature of signer, see IsValidSignature
+func (e *Eth) IsValidTextSignature(signer common.Address, data []byte, signature []byte) (bool, error) {
+	return e.IsValidSignature(signer, accounts.TextHash(data), signature)
+}
+
+// IsValidTypedDataSignature checks an EIP-712 signature of signer, see IsValidSignature
+func (e *Eth) IsValidTypedDataSignature(signer common.Address, data apitypes.TypedData, signature []byte) (bool, error) {
+	hash, err := typedDataHash(data)
+	if err != nil {
+		return false, err
+	}
+	return e.IsValidSignature(signer, hash, signature)
+}
+
+// IsERC6492Signature reports whether the signature ends with the ERC-6492 magic suffix
+func IsERC6492Signature(signature []byte) bool {
+	return len(signature) >= len(ERC6492MagicSuffix) && bytes.HasSuffix(signature, ERC6492MagicSuffix)
+}
+
+// UnwrapERC6492Signature decodes abi.encode(address factory, bytes factoryCalldata, bytes signature) ++ magicSuffix
+func UnwrapERC6492Signature(signature []byte) (common.Address, []byte, []byte, error) {
+	if !IsERC6492Signature(signature) {
+		return common.Address{}, nil, nil, errors.New("not an ERC-6492 signature")
+	}
+	data := signature[:len(signature)-len(ERC6492MagicSuffix)]
+	values, err := utils.NewUtils().DecodeParameters([]string{"address", "bytes", "bytes"}, data)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return values[0].(common.Address), values[1].([]byte), values[2].([]byte), nil
+}
+
+// WrapERC6492Signature wraps the signature of a counterfactual wallet deployed by
+// calling factory with factoryCalldata
+func WrapERC6492Signature(factory common.Address, factoryCalldata []byte, signature []byte) ([]byte, error) {
+	data, err := utils.NewUtils().EncodeParameters([]string{"address", "bytes", "bytes"}, []interface{}{factory, factoryCalldata, signature})
+	if err != nil {
+		return nil, err
+	}
+	return append(data, ERC6492MagicSuffix...), nil
+}
+
+func (e *Eth) isValidSignatureERC1271(signer common.Address, hash []byte, signature []byte) (bool, error) {
+	contr, err := e.NewContract(ERC1271_ABI, signer.String())
+	if err != nil {
+		return false, err
+	}
+	ret, err := contr.Call("isValidSignature", common.BytesToHash(hash), signature)
+	if err != nil {
+		if isRevert(err) {
+			// reverted or not implemented
+			return false, nil
+		}
+		return false, err
+	}
+	magic, ok := ret.([4]byte)
+	if !ok {
+		return false, fmt.Errorf("invalid result %v, type %T", ret, ret)
+	}
+	return magic == ERC1271MagicValue, nil
+}
+
+func (e *Eth) isValidSignatureDeployless(
+	signer common.Address,
+	hash []byte,
+	factory common.Address,
+	factoryCalldata []byte,
+	signature []byte,
+) (bool, error) {
+	contr, err := NewContract(ERC1271_ABI)
+	if err != nil {
+		return false, err
+	}
+	validCalldata, err := contr.EncodeABI("isValidSignature", common.BytesToHash(hash), signature)
+	if err != nil {
+		return false, err
+	}
+
+	code, err := erc6492ValidatorCode(signer, factory, factoryCalldata, validCalldata)
+	if err != nil {
+		return false, err
+	}
+	msg := map[string]interface{}{
+		"data": hexutil.Bytes(code),
+	}
+	var out hexutil.Bytes
+	if err := e.c.Call("eth_call", &out, msg, "latest"); err != nil {
+		if err = wrapRevert(nil, err); isRevert(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(out) == 32 && out[31] == 1, nil
+}
+
+// erc6492ValidatorCode builds init code executed with eth_call that deploys the signer
+// through the factory if it has no code, then calls isValidSignature on it and returns
+// a 32 bytes word, 1 for valid and 0 for invalid.
+// The factory calldata and isValidSignature calldata are appended after the code, their
+// offsets and sizes are pushed as 4 bytes words.
+func erc6492ValidatorCode(signer, factory common.Address, factoryCalldata, validCalldata []byte) ([]byte, error) {
+	f := len(factoryCalldata)
+	v := len(validCalldata)
+	build := func(codeLen int) []byte {
+		code := make([]byte, 0, codeLen+f+v)
+		push4 := func(n int) {
+			code = append(code, 0x63)
+			code = binary.BigEndian.AppendUint32(code, uint32(n))
+		}
+		push20 := func(addr common.Address) {
+			code = append(code, 0x73)
+			code = append(code, addr[:]...)
+		}
+
+		// memory[0:f+v] = factoryCalldata ++ validCalldata
+		push4(f + v)
+		push4(codeLen)
+		code = append(code, 0x60, 0x00, 0x39) // PUSH1 0 CODECOPY
+
+		// if extcodesize(signer) != 0, skip deployment
+		push20(signer)
+		code = append(code, 0x3b) // EXTCODESIZE
+		skip := len(code)
+		push4(0)
+		code = append(code, 0x57) // JUMPI
+
+		// call(gas, factory, 0, 0, f, 0, 0)
+		code = append(code, 0x60, 0x00, 0x60, 0x00) // retSize, retOffset
+		push4(f)
+		code = append(code, 0x60, 0x00, 0x60, 0x00) // argsOffset, value
+		push20(factory)
+		code = append(code, 0x5a, 0xf1, 0x50) // GAS CALL POP
+		binary.BigEndian.PutUint32(code[skip+1:], uint32(len(code)))
+		code = append(code, 0x5b) // JUMPDEST
+
+		// staticcall(gas, signer, f, v, f+v, 32)
+		code = append(code, 0x60, 0x20)
+		push4(f + v)
+		push4(v)
+		push4(f)
+		push20(signer)
+		code = append(code, 0x5a, 0xfa) // GAS STATICCALL
+
+		// success && returndatasize >= 32 && bytes4(mload(f+v)) == magic
+		code = append(code, 0x3d, 0x60, 0x20, 0x11, 0x15, 0x16) // RETURNDATASIZE PUSH1 32 GT ISZERO AND
+		push4(f + v)
+		code = append(code, 0x51, 0x60, 0xe0, 0x1c) // MLOAD PUSH1 224 SHR
+		code = append(code, 0x63)                   // PUSH4 magic
+		code = append(code, ERC1271MagicValue[:]...)
+		code = append(code, 0x14, 0x16) // EQ AND
+
+		// return the result word
+		code = append(code, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3) // PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+		return code
+	}
+
+	codeLen := len(build(0))
+	if uint64(codeLen)+uint64(f)+uint64(v) > math.MaxUint32 {
+		return nil, fmt.Errorf("ERC-6492 factory calldata (%d bytes) and signature (%d bytes) are too large", f, v)
+	}
+	code := build(codeLen)
+	code = append(code, factoryCalldata...)
+	return append(code, validCalldata...), nil
+}
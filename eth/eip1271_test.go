@@ -0,0 +1,283 @@
+package eth
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var testWallet = common.HexToAddress("0x00000000000000000000000000000000000a11e7")
+
+// mockWallets serves eth_getCode and eth_call for EIP-1271 wallets returning the magic
+// value when the r ++ s ++ v signature recovers their owner
+type mockWallets struct {
+	owners   map[common.Address]common.Address
+	deployed map[common.Address]bool
+	// factoryCalls are the factory calldata deploying the wallets
+	factoryCalls map[common.Address][]byte
+}
+
+func newMockWallets(t *testing.T) (*mockWallets, *mockServer) {
+	w := &mockWallets{
+		owners:       map[common.Address]common.Address{},
+		deployed:     map[common.Address]bool{},
+		factoryCalls: map[common.Address][]byte{},
+	}
+	walletABI, err := abi.JSON(strings.NewReader(ERC1271_ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	isValidSignature := walletABI.Methods["isValidSignature"]
+
+	// check returns the isValidSignature result of wallet, 0xffffffff for other signers
+	check := func(wallet common.Address, calldata []byte) ([]byte, error) {
+		out := common.RightPadBytes([]byte{0xff, 0xff, 0xff, 0xff}, 32)
+		if len(calldata) < 4 || !bytes.Equal(calldata[:4], isValidSignature.ID) {
+			return nil, &codec.ErrorObject{Code: 3, Message: "execution reverted", Data: "0x"}
+		}
+		args, err := isValidSignature.Inputs.Unpack(calldata[4:])
+		if err != nil {
+			return nil, err
+		}
+		hash, sig := args[0].([32]byte), common.CopyBytes(args[1].([]byte))
+		if len(sig) != 65 {
+			return out, nil
+		}
+		sig[64] -= 27
+		pub, err := crypto.SigToPub(hash[:], sig)
+		if err == nil && crypto.PubkeyToAddress(*pub) == w.owners[wallet] {
+			out = common.RightPadBytes(ERC1271MagicValue[:], 32)
+		}
+		return out, nil
+	}
+
+	m := newMockServer(t)
+	m.handle("eth_getCode", func(params []json.RawMessage) (interface{}, error) {
+		var addr common.Address
+		if err := json.Unmarshal(params[0], &addr); err != nil {
+			return nil, err
+		}
+		if addr == Create2FactoryAddress || w.deployed[addr] {
+			return hexutil.Bytes{0x60, 0x00}, nil
+		}
+		return hexutil.Bytes{}, nil
+	})
+	m.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		var msg struct {
+			To   *common.Address `json:"to"`
+			Data hexutil.Bytes   `json:"data"`
+		}
+		if err := json.Unmarshal(params[0], &msg); err != nil {
+			return nil, err
+		}
+		if msg.To != nil {
+			if !w.deployed[*msg.To] {
+				return nil, &codec.ErrorObject{Code: 3, Message: "execution reverted", Data: "0x"}
+			}
+			out, err := check(*msg.To, msg.Data)
+			if err != nil {
+				return nil, err
+			}
+			return hexutil.Bytes(out), nil
+		}
+
+		// the validator code deploys the wallet through the factory and checks the
+		// isValidSignature calldata appended after its factory calldata
+		for wallet, factoryCalldata := range w.factoryCalls {
+			i := bytes.Index(msg.Data, factoryCalldata)
+			if i < 0 || !bytes.Contains(msg.Data[:i], wallet.Bytes()) || !bytes.Contains(msg.Data[:i], Create2FactoryAddress.Bytes()) {
+				continue
+			}
+			out, err := check(wallet, msg.Data[i+len(factoryCalldata):])
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(out[:4], ERC1271MagicValue[:]) {
+				return hexutil.Bytes(common.LeftPadBytes([]byte{1}, 32)), nil
+			}
+		}
+		// staticcall of an undeployed wallet fails
+		return hexutil.Bytes(make([]byte, 32)), nil
+	})
+	return w, m
+}
+
+// counterfactual registers a wallet of owner deployed by the factory with salt
+func (w *mockWallets) counterfactual(owner common.Address, salt common.Hash) (common.Address, []byte) {
+	initCode := append([]byte{0x60, 0x00}, owner.Bytes()...)
+	wallet := ComputeCreate2Address(Create2FactoryAddress, salt, initCode)
+	w.owners[wallet] = owner
+	w.factoryCalls[wallet] = append(salt.Bytes(), initCode...)
+	return wallet, w.factoryCalls[wallet]
+}
+
+// signWallet signs hash with key as an r ++ s ++ v signature checked by the wallet
+func signWallet(t *testing.T, key *ecdsa.PrivateKey, hash []byte) []byte {
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[64] += 27
+	return sig
+}
+
+func TestIsValidSignatureEOA(t *testing.T) {
+	e := NewEth(nil)
+	if err := e.SetAccount(privateKeyUsedForTest); err != nil {
+		t.Fatal(err)
+	}
+	sig, err := e.SignText([]byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err := e.IsValidTextSignature(e.Address(), []byte("hello"), sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Fatal("expected valid EOA signature")
+	}
+}
+
+func TestIsValidSignatureERC1271(t *testing.T) {
+	owner, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := crypto.Keccak256([]byte("hello"))
+	wallets, m := newMockWallets(t)
+	wallet, _ := wallets.counterfactual(crypto.PubkeyToAddress(owner.PublicKey), common.HexToHash("0x01"))
+	wallets.deployed[wallet] = true
+	e := m.eth(t)
+
+	valid, err := e.IsValidSignature(wallet, hash, signWallet(t, owner, hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Fatal("expected valid contract signature")
+	}
+
+	valid, err = e.IsValidSignature(wallet, hash, signWallet(t, other, hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Fatal("expected invalid contract signature")
+	}
+
+	// the factory has no isValidSignature and reverts
+	valid, err = e.IsValidSignature(Create2FactoryAddress, hash, signWallet(t, owner, hash))
+	if err != nil || valid {
+		t.Fatalf("expected invalid signature of a reverting contract, got %v %v", valid, err)
+	}
+
+	// node failures are not invalid signatures
+	m.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		return nil, &codec.ErrorObject{Code: -32005, Message: "daily request count exceeded"}
+	})
+	if _, err := e.IsValidSignature(wallet, hash, signWallet(t, owner, hash)); err == nil {
+		t.Fatal("expected rpc error")
+	}
+}
+
+func TestIsValidSignatureERC6492(t *testing.T) {
+	owner, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := crypto.Keccak256([]byte("hello"))
+	wallets, m := newMockWallets(t)
+	wallet, factoryCalldata := wallets.counterfactual(crypto.PubkeyToAddress(owner.PublicKey), common.HexToHash("0x01"))
+	walletSig := signWallet(t, owner, hash)
+
+	wrapped, err := WrapERC6492Signature(Create2FactoryAddress, factoryCalldata, walletSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	factory, calldata, sig, err := UnwrapERC6492Signature(wrapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if factory != Create2FactoryAddress || !bytes.Equal(calldata, factoryCalldata) || !bytes.Equal(sig, walletSig) {
+		t.Fatal("unwrap mismatch")
+	}
+
+	e := m.eth(t)
+
+	// the counterfactual wallet is deployed by the validator code
+	valid, err := e.IsValidSignature(wallet, hash, wrapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Fatal("expected valid counterfactual signature")
+	}
+
+	badWrapped, err := WrapERC6492Signature(Create2FactoryAddress, factoryCalldata, signWallet(t, other, hash))
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err = e.IsValidSignature(wallet, hash, badWrapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Fatal("expected invalid counterfactual signature")
+	}
+
+	// a wrong factory call leaves the wallet without code
+	wrongWrapped, err := WrapERC6492Signature(Create2FactoryAddress, append(common.HexToHash("0x02").Bytes(), factoryCalldata[32:]...), walletSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid, err = e.IsValidSignature(wallet, hash, wrongWrapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Fatal("expected invalid signature of an undeployed wallet")
+	}
+
+	// once deployed the wrapped signature is checked with the wallet
+	wallets.deployed[wallet] = true
+	valid, err = e.IsValidSignature(wallet, hash, wrapped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Fatal("expected valid signature of the deployed wallet")
+	}
+}
+
+func TestERC6492ValidatorCodeSizes(t *testing.T) {
+	// calldata longer than 64 KiB is copied without truncation
+	factoryCalldata := make([]byte, 70000)
+	code, err := erc6492ValidatorCode(testWallet, Create2FactoryAddress, factoryCalldata, []byte{1, 2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasSuffix(code, append(factoryCalldata, 1, 2, 3)) {
+		t.Fatal("calldata not appended")
+	}
+	if binary.BigEndian.Uint32(code[1:5]) != 70003 {
+		t.Fatalf("unexpected copied size %d", binary.BigEndian.Uint32(code[1:5]))
+	}
+}
@@ -0,0 +1,582 @@
+package eth
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// EIP712Domain is the EIP-712 domain of typed data.
+// Zero fields are left out of the EIP712Domain type.
+type EIP712Domain struct {
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+}
+
+// EIP712Typer may be implemented by message structs to override the EIP-712
+// type name, which defaults to the Go type name
+type EIP712Typer interface {
+	EIP712Type() string
+}
+
+// TypedStruct is EIP-712 typed data derived from a Go struct.
+//
+// Struct fields are mapped to EIP-712 members with the `eip712` tag:
+//
+//	type Mail struct {
+//		From     Person         `eip712:"from"`
+//		To       []Person       `eip712:"to"`
+//		Amount   *big.Int       `eip712:"amount,uint96"`
+//		Contents string         // member "contents"
+//		Internal string         `eip712:"-"`
+//	}
+//
+// The member name defaults to the field name with the first letter lowercased,
+// the member type is derived from the Go type unless given after the name:
+// common.Address is address, *big.Int is uint256, [N]byte is bytesN, []byte is
+// bytes, Go integers keep their size, nested structs are referenced by type name,
+// slices and arrays are T[] and T[N].
+type TypedStruct struct {
+	Domain      EIP712Domain
+	PrimaryType string
+	Types       apitypes.Types
+
+	message         reflect.Value
+	fields          map[string][]int
+	domainSeparator common.Hash
+	structHash      common.Hash
+}
+
+// NewTypedStruct derives EIP-712 types from message, which must be a struct or a pointer to one,
+// and computes the domain separator and struct hash
+func NewTypedStruct(domain EIP712Domain, message interface{}) (*TypedStruct, error) {
+	v := reflect.ValueOf(message)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, fmt.Errorf("typed data message is nil")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("typed data message must be a struct, got %v", v.Type())
+	}
+
+	ts := &TypedStruct{
+		Domain:  domain,
+		Types:   apitypes.Types{"EIP712Domain": domain.types()},
+		message: v,
+		fields:  make(map[string][]int),
+	}
+	primaryType, err := ts.addType(v.Type(), map[string]reflect.Type{})
+	if err != nil {
+		return nil, err
+	}
+	ts.PrimaryType = primaryType
+
+	domainData, err := ts.hashDomain()
+	if err != nil {
+		return nil, err
+	}
+	ts.domainSeparator = common.BytesToHash(domainData)
+
+	structData, err := ts.hashStruct(primaryType, v)
+	if err != nil {
+		return nil, err
+	}
+	ts.structHash = common.BytesToHash(structData)
+	return ts, nil
+}
+
+// DomainSeparator returns hashStruct(eip712Domain)
+func (ts *TypedStruct) DomainSeparator() common.Hash {
+	return ts.domainSeparator
+}
+
+// StructHash returns hashStruct(message)
+func (ts *TypedStruct) StructHash() common.Hash {
+	return ts.structHash
+}
+
+// Digest returns keccak256("\x19\x01" ‖ domainSeparator ‖ hashStruct(message)), the hash to be signed
+func (ts *TypedStruct) Digest() common.Hash {
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, ts.domainSeparator[:], ts.structHash[:])
+}
+
+// EncodeType returns the EIP-712 encodeType of the type name, e.g. "Mail(Person from,Person to,string contents)Person(...)"
+func (ts *TypedStruct) EncodeType(typeName string) string {
+	deps := ts.dependencies(typeName, nil)
+	if len(deps) == 0 {
+		return ""
+	}
+	sort.Strings(deps[1:])
+
+	var buf strings.Builder
+	for _, dep := range deps {
+		buf.WriteString(dep)
+		buf.WriteString("(")
+		for i, field := range ts.Types[dep] {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			buf.WriteString(field.Type)
+			buf.WriteString(" ")
+			buf.WriteString(field.Name)
+		}
+		buf.WriteString(")")
+	}
+	return buf.String()
+}
+
+// TypeHash returns keccak256(encodeType(typeName))
+func (ts *TypedStruct) TypeHash(typeName string) common.Hash {
+	return crypto.Keccak256Hash([]byte(ts.EncodeType(typeName)))
+}
+
+// TypedData converts to apitypes.TypedData, e.g. for eth_signTypedData_v4.
+// Fixed size arrays are emitted as is, which apitypes itself cannot hash.
+func (ts *TypedStruct) TypedData() apitypes.TypedData {
+	domain := apitypes.TypedDataDomain{
+		Name:    ts.Domain.Name,
+		Version: ts.Domain.Version,
+	}
+	if ts.Domain.ChainId != nil {
+		domain.ChainId = (*math.HexOrDecimal256)(new(big.Int).Set(ts.Domain.ChainId))
+	}
+	if ts.Domain.VerifyingContract != (common.Address{}) {
+		domain.VerifyingContract = ts.Domain.VerifyingContract.Hex()
+	}
+	if ts.Domain.Salt != ([32]byte{}) {
+		domain.Salt = hexutil.Encode(ts.Domain.Salt[:])
+	}
+	return apitypes.TypedData{
+		Types:       ts.Types,
+		PrimaryType: ts.PrimaryType,
+		Domain:      domain,
+		Message:     ts.messageMap(ts.PrimaryType, ts.message),
+	}
+}
+
+// SignTypedStruct signs the EIP-712 digest of message built with NewTypedStruct
+func (e *Eth) SignTypedStruct(domain EIP712Domain, message interface{}) ([]byte, error) {
+	signer := e.Signer()
+	if signer == nil {
+		return nil, errNoSigner
+	}
+	ts, err := NewTypedStruct(domain, message)
+	if err != nil {
+		return nil, err
+	}
+	digest := ts.Digest()
+	return signHashWithV27(signer, digest[:])
+}
+
+func (d EIP712Domain) types() []apitypes.Type {
+	var types []apitypes.Type
+	if d.Name != "" {
+		types = append(types, apitypes.Type{Name: "name", Type: "string"})
+	}
+	if d.Version != "" {
+		types = append(types, apitypes.Type{Name: "version", Type: "string"})
+	}
+	if d.ChainId != nil {
+		types = append(types, apitypes.Type{Name: "chainId", Type: "uint256"})
+	}
+	if d.VerifyingContract != (common.Address{}) {
+		types = append(types, apitypes.Type{Name: "verifyingContract", Type: "address"})
+	}
+	if d.Salt != ([32]byte{}) {
+		types = append(types, apitypes.Type{Name: "salt", Type: "bytes32"})
+	}
+	return types
+}
+
+func (ts *TypedStruct) hashDomain() ([]byte, error) {
+	values := map[string]reflect.Value{
+		"name":              reflect.ValueOf(ts.Domain.Name),
+		"version":           reflect.ValueOf(ts.Domain.Version),
+		"chainId":           reflect.ValueOf(ts.Domain.ChainId),
+		"verifyingContract": reflect.ValueOf(ts.Domain.VerifyingContract),
+		"salt":              reflect.ValueOf(ts.Domain.Salt),
+	}
+	typeHash := ts.TypeHash("EIP712Domain")
+	buf := bytes.NewBuffer(typeHash[:])
+	for _, field := range ts.Types["EIP712Domain"] {
+		enc, err := ts.encodeValue(field.Type, values[field.Name])
+		if err != nil {
+			return nil, fmt.Errorf("domain %s: %v", field.Name, err)
+		}
+		buf.Write(enc)
+	}
+	return crypto.Keccak256(buf.Bytes()), nil
+}
+
+// addType registers the EIP-712 type of struct t and its nested structs, returning the type name
+func (ts *TypedStruct) addType(t reflect.Type, seen map[string]reflect.Type) (string, error) {
+	name := t.Name()
+	if typer, ok := reflect.New(t).Interface().(EIP712Typer); ok {
+		name = typer.EIP712Type()
+	}
+	if name == "" {
+		return "", fmt.Errorf("anonymous struct %v has no EIP-712 type name", t)
+	}
+	if name == "EIP712Domain" {
+		return "", fmt.Errorf("type name %s is reserved", name)
+	}
+	if prev, ok := seen[name]; ok {
+		if prev != t {
+			return "", fmt.Errorf("EIP-712 type name %s is used by both %v and %v", name, prev, t)
+		}
+		return name, nil
+	}
+	seen[name] = t
+
+	var members []apitypes.Type
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("eip712")
+		if tag == "-" {
+			continue
+		}
+		memberName, memberType, _ := strings.Cut(tag, ",")
+		if memberName == "" {
+			memberName = lowerFirst(field.Name)
+		}
+		derived, err := ts.typeName(field.Type, seen)
+		if err != nil {
+			return "", fmt.Errorf("%v.%s: %v", t, field.Name, err)
+		}
+		if memberType == "" {
+			memberType = derived
+		}
+		members = append(members, apitypes.Type{Name: memberName, Type: memberType})
+		ts.fields[name+"."+memberName] = field.Index
+	}
+	if len(members) == 0 {
+		return "", fmt.Errorf("struct %v has no EIP-712 members", t)
+	}
+	ts.Types[name] = members
+	return name, nil
+}
+
+var (
+	addressType = reflect.TypeOf(common.Address{})
+	bigIntType  = reflect.TypeOf(big.Int{})
+)
+
+// typeName derives the EIP-712 type of a Go type
+func (ts *TypedStruct) typeName(t reflect.Type, seen map[string]reflect.Type) (string, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case addressType:
+		return "address", nil
+	case bigIntType:
+		return "uint256", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.String:
+		return "string", nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("uint%d", t.Bits()), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("int%d", t.Bits()), nil
+	case reflect.Uint:
+		return "uint256", nil
+	case reflect.Int:
+		return "int256", nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "bytes", nil
+		}
+		elem, err := ts.typeName(t.Elem(), seen)
+		if err != nil {
+			return "", err
+		}
+		return elem + "[]", nil
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			if t.Len() < 1 || t.Len() > 32 {
+				return "", fmt.Errorf("unsupported byte array length %d", t.Len())
+			}
+			return fmt.Sprintf("bytes%d", t.Len()), nil
+		}
+		elem, err := ts.typeName(t.Elem(), seen)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s[%d]", elem, t.Len()), nil
+	case reflect.Struct:
+		return ts.addType(t, seen)
+	}
+	return "", fmt.Errorf("unsupported type %v", t)
+}
+
+func (ts *TypedStruct) dependencies(typeName string, found []string) []string {
+	typeName = baseTypeName(typeName)
+	if _, ok := ts.Types[typeName]; !ok {
+		return found
+	}
+	for _, dep := range found {
+		if dep == typeName {
+			return found
+		}
+	}
+	found = append(found, typeName)
+	for _, field := range ts.Types[typeName] {
+		found = ts.dependencies(field.Type, found)
+	}
+	return found
+}
+
+func (ts *TypedStruct) hashStruct(typeName string, v reflect.Value) ([]byte, error) {
+	v, err := indirect(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", typeName, err)
+	}
+	typeHash := ts.TypeHash(typeName)
+	buf := bytes.NewBuffer(typeHash[:])
+	for _, field := range ts.Types[typeName] {
+		enc, err := ts.encodeValue(field.Type, v.FieldByIndex(ts.fields[typeName+"."+field.Name]))
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", typeName, field.Name, err)
+		}
+		buf.Write(enc)
+	}
+	return crypto.Keccak256(buf.Bytes()), nil
+}
+
+// encodeValue returns the 32 bytes encodeData of a member value
+func (ts *TypedStruct) encodeValue(typ string, v reflect.Value) ([]byte, error) {
+	if elemType, n, ok := arrayElemType(typ); ok {
+		v, err := indirect(v)
+		if err != nil {
+			return nil, err
+		}
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil, fmt.Errorf("cannot use %v as %s", v.Type(), typ)
+		}
+		if n >= 0 && v.Len() != n {
+			return nil, fmt.Errorf("%s expects %d items, got %d", typ, n, v.Len())
+		}
+		var buf bytes.Buffer
+		for i := 0; i < v.Len(); i++ {
+			enc, err := ts.encodeValue(elemType, v.Index(i))
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %v", i, err)
+			}
+			buf.Write(enc)
+		}
+		return crypto.Keccak256(buf.Bytes()), nil
+	}
+	if _, ok := ts.Types[typ]; ok {
+		return ts.hashStruct(typ, v)
+	}
+	return encodePrimitive(typ, v)
+}
+
+func encodePrimitive(typ string, v reflect.Value) ([]byte, error) {
+	v, err := indirect(v)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case typ == "address":
+		if v.Type() != addressType {
+			return nil, fmt.Errorf("cannot use %v as address", v.Type())
+		}
+		return common.LeftPadBytes(v.Interface().(common.Address).Bytes(), 32), nil
+	case typ == "bool":
+		if v.Kind() != reflect.Bool {
+			return nil, fmt.Errorf("cannot use %v as bool", v.Type())
+		}
+		if v.Bool() {
+			return math.PaddedBigBytes(common.Big1, 32), nil
+		}
+		return make([]byte, 32), nil
+	case typ == "string":
+		if v.Kind() != reflect.String {
+			return nil, fmt.Errorf("cannot use %v as string", v.Type())
+		}
+		return crypto.Keccak256([]byte(v.String())), nil
+	case typ == "bytes":
+		b, ok := byteValue(v)
+		if !ok {
+			return nil, fmt.Errorf("cannot use %v as bytes", v.Type())
+		}
+		return crypto.Keccak256(b), nil
+	case strings.HasPrefix(typ, "bytes"):
+		size, err := strconv.Atoi(strings.TrimPrefix(typ, "bytes"))
+		if err != nil || size < 1 || size > 32 {
+			return nil, fmt.Errorf("unsupported type %s", typ)
+		}
+		b, ok := byteValue(v)
+		if !ok || len(b) != size {
+			return nil, fmt.Errorf("cannot use %v as %s", v.Type(), typ)
+		}
+		return common.RightPadBytes(b, 32), nil
+	case strings.HasPrefix(typ, "uint"), strings.HasPrefix(typ, "int"):
+		n, err := integerValue(typ, v)
+		if err != nil {
+			return nil, err
+		}
+		return math.U256Bytes(n), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s", typ)
+}
+
+// integerValue converts v to a big.Int checked against the bounds of uintN/intN
+func integerValue(typ string, v reflect.Value) (*big.Int, error) {
+	signed := strings.HasPrefix(typ, "int")
+	bits := 256
+	if size := strings.TrimPrefix(strings.TrimPrefix(typ, "u"), "int"); size != "" {
+		n, err := strconv.Atoi(size)
+		if err != nil || n < 8 || n > 256 || n%8 != 0 {
+			return nil, fmt.Errorf("unsupported type %s", typ)
+		}
+		bits = n
+	}
+
+	var n *big.Int
+	switch {
+	case v.Type() == bigIntType:
+		b := v.Interface().(big.Int)
+		n = new(big.Int).Set(&b)
+	case v.CanInt():
+		n = big.NewInt(v.Int())
+	case v.CanUint():
+		n = new(big.Int).SetUint64(v.Uint())
+	default:
+		return nil, fmt.Errorf("cannot use %v as %s", v.Type(), typ)
+	}
+
+	if signed {
+		limit := new(big.Int).Lsh(common.Big1, uint(bits-1))
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("%v overflows %s", n, typ)
+		}
+	} else if n.Sign() < 0 || n.BitLen() > bits {
+		return nil, fmt.Errorf("%v overflows %s", n, typ)
+	}
+	return n, nil
+}
+
+// messageMap converts a struct value to the apitypes message format
+func (ts *TypedStruct) messageMap(typeName string, v reflect.Value) map[string]interface{} {
+	v, _ = indirect(v)
+	m := make(map[string]interface{})
+	for _, field := range ts.Types[typeName] {
+		m[field.Name] = ts.messageValue(field.Type, v.FieldByIndex(ts.fields[typeName+"."+field.Name]))
+	}
+	return m
+}
+
+func (ts *TypedStruct) messageValue(typ string, v reflect.Value) interface{} {
+	v, err := indirect(v)
+	if err != nil {
+		return nil
+	}
+	if elemType, _, ok := arrayElemType(typ); ok {
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = ts.messageValue(elemType, v.Index(i))
+		}
+		return items
+	}
+	if _, ok := ts.Types[typ]; ok {
+		return ts.messageMap(typ, v)
+	}
+	switch {
+	case typ == "address":
+		return v.Interface().(common.Address).Hex()
+	case typ == "bool", typ == "string":
+		return v.Interface()
+	case strings.HasPrefix(typ, "bytes"):
+		b, _ := byteValue(v)
+		return hexutil.Bytes(b)
+	default:
+		n, err := integerValue(typ, v)
+		if err != nil {
+			return nil
+		}
+		return (*math.HexOrDecimal256)(n)
+	}
+}
+
+// arrayElemType splits "T[N]" or "T[]" into T and N (-1 for dynamic arrays)
+func arrayElemType(typ string) (string, int, bool) {
+	if !strings.HasSuffix(typ, "]") {
+		return "", 0, false
+	}
+	open := strings.LastIndex(typ, "[")
+	if open < 0 {
+		return "", 0, false
+	}
+	size := typ[open+1 : len(typ)-1]
+	if size == "" {
+		return typ[:open], -1, true
+	}
+	n, err := strconv.Atoi(size)
+	if err != nil {
+		return "", 0, false
+	}
+	return typ[:open], n, true
+}
+
+func baseTypeName(typ string) string {
+	if i := strings.Index(typ, "["); i >= 0 {
+		return typ[:i]
+	}
+	return typ
+}
+
+func byteValue(v reflect.Value) ([]byte, bool) {
+	switch {
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return v.Bytes(), true
+	case v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return b, true
+	}
+	return nil, false
+}
+
+func indirect(v reflect.Value) (reflect.Value, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, fmt.Errorf("nil value")
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return v, fmt.Errorf("nil value")
+	}
+	return v, nil
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
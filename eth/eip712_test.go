@@ -0,0 +1,118 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type Person struct {
+	Name   string         `eip712:"name"`
+	Wallet common.Address `eip712:"wallet"`
+}
+
+type Mail struct {
+	From     Person `eip712:"from"`
+	To       Person `eip712:"to"`
+	Contents string `eip712:"contents"`
+	Ignored  string `eip712:"-"`
+}
+
+// Example from https://eips.ethereum.org/EIPS/eip-712
+func TestTypedStructEIP712Example(t *testing.T) {
+	domain := EIP712Domain{
+		Name:              "Ether Mail",
+		Version:           "1",
+		ChainId:           big.NewInt(1),
+		VerifyingContract: common.HexToAddress("0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"),
+	}
+	mail := Mail{
+		From:     Person{Name: "Cow", Wallet: common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")},
+		To:       Person{Name: "Bob", Wallet: common.HexToAddress("0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB")},
+		Contents: "Hello, Bob!",
+		Ignored:  "not hashed",
+	}
+	ts, err := NewTypedStruct(domain, &mail)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if enc := ts.EncodeType("Mail"); enc != "Mail(Person from,Person to,string contents)Person(string name,address wallet)" {
+		t.Fatalf("unexpected encodeType %s", enc)
+	}
+	if got := ts.DomainSeparator().Hex(); got != "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f" {
+		t.Fatalf("unexpected domain separator %s", got)
+	}
+	if got := ts.StructHash().Hex(); got != "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e" {
+		t.Fatalf("unexpected struct hash %s", got)
+	}
+	if got := ts.Digest().Hex(); got != "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2" {
+		t.Fatalf("unexpected digest %s", got)
+	}
+
+	e := NewEth(nil)
+	if err := e.SetAccount(privateKeyUsedForTest); err != nil {
+		t.Fatal(err)
+	}
+	sig, err := e.SignTypedStruct(domain, mail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyTypedData(e.Address(), ts.TypedData(), sig); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type Order struct {
+	Maker    common.Address
+	Assets   []Asset
+	Amount   *big.Int `eip712:"amount,uint96"`
+	Nonce    uint64
+	Salt     [32]byte
+	Data     []byte
+	Expiry   int32
+	Fillable bool
+}
+
+type Asset struct {
+	Token   common.Address `eip712:"token"`
+	TokenId *big.Int       `eip712:"tokenId"`
+}
+
+func TestTypedStructMatchesApitypes(t *testing.T) {
+	domain := EIP712Domain{Name: "Exchange", ChainId: big.NewInt(5)}
+	order := Order{
+		Maker: common.HexToAddress("0x1000000000000000000000000000000000000001"),
+		Assets: []Asset{
+			{Token: common.HexToAddress("0x2000000000000000000000000000000000000002"), TokenId: big.NewInt(7)},
+			{Token: common.HexToAddress("0x3000000000000000000000000000000000000003"), TokenId: big.NewInt(8)},
+		},
+		Amount:   big.NewInt(1e18),
+		Nonce:    42,
+		Salt:     common.HexToHash("0x01"),
+		Data:     []byte{1, 2, 3},
+		Expiry:   -1,
+		Fillable: true,
+	}
+	ts, err := NewTypedStruct(domain, order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enc := ts.EncodeType("Order"); enc != "Order(address maker,Asset[] assets,uint96 amount,uint64 nonce,bytes32 salt,bytes data,int32 expiry,bool fillable)Asset(address token,uint256 tokenId)" {
+		t.Fatalf("unexpected encodeType %s", enc)
+	}
+
+	expected, err := typedDataHash(ts.TypedData())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ts.Digest() != common.BytesToHash(expected) {
+		t.Fatalf("digest %v, apitypes %x", ts.Digest(), expected)
+	}
+
+	order.Amount = new(big.Int).Lsh(big.NewInt(1), 96)
+	if _, err := NewTypedStruct(domain, order); err == nil {
+		t.Fatal("expected uint96 overflow error")
+	}
+}
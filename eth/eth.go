@@ -3,17 +3,18 @@ package eth
 import (
 	"crypto/ecdsa"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/chenzhijie/go-web3/rpc"
 	"github.com/chenzhijie/go-web3/types"
 	"github.com/chenzhijie/go-web3/utils"
-	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/crypto"
 
 	eTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -23,57 +24,81 @@ const (
 	PRIORITY_FEE_INCREASE_BOUNDARY = 200
 )
 
-// Eth is the eth namespace
+var errNoSigner = errors.New("please setup private key before signing")
+
+// Eth is the eth namespace. The default account, chain id, gas oracle and tx polling
+// settings may be changed while other goroutines use the instance.
 type Eth struct {
-	c             *rpc.Client
-	privateKey    *ecdsa.PrivateKey
-	address       common.Address
-	chainId       *big.Int
-	txPollTimeout int
-	utils         *utils.Utils
+	c              *rpc.Client
+	lock           sync.RWMutex
+	signer         Signer
+	wallet         *Wallet
+	gasOracle      GasOracle
+	chainId        *big.Int
+	txPollTimeout  int
+	txPollInterval time.Duration
+	utils          *utils.Utils
 }
 
 // Create a eth instance
 func NewEth(c *rpc.Client) *Eth {
 	return &Eth{
-		c:     c,
-		utils: &utils.Utils{},
+		c:      c,
+		wallet: NewWallet(),
+		utils:  &utils.Utils{},
 	}
 }
 
 // Setup default ethereum account with privateKey (hex format)
 func (e *Eth) SetAccount(privateKey string) error {
-	if len(privateKey) == 0 {
-		return fmt.Errorf("private key is empty")
-	}
-	privKey, err := crypto.HexToECDSA(privateKey)
+	signer, err := NewLocalSignerFromHex(privateKey)
 	if err != nil {
 		return err
 	}
+	e.SetSigner(signer)
+	return nil
+}
 
-	e.privateKey = privKey
-
-	addr := crypto.PubkeyToAddress(privKey.PublicKey)
-	copy(e.address[:], addr[:])
+// Setup default signer for signing and sending transactions, the signer is also added to the wallet
+func (e *Eth) SetSigner(signer Signer) {
+	e.wallet.Add(signer)
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.signer = signer
+}
 
-	return nil
+// Get current default signer
+func (e *Eth) Signer() Signer {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.signer
 }
 
+// GetPrivateKey returns the private key of the default signer if it is a LocalSigner
 func (e *Eth) GetPrivateKey() *ecdsa.PrivateKey {
-	return e.privateKey
+	if local, ok := e.Signer().(*LocalSigner); ok {
+		return local.PrivateKey()
+	}
+	return nil
 }
 
 func (e *Eth) GetChainId() *big.Int {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
 	return e.chainId
 }
 
 // Setup current network chainId
 func (e *Eth) SetChainId(chainId int64) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
 	e.chainId = big.NewInt(chainId)
 }
 
 // Setup timeout for polling confirmation from txs (unit second)
 func (e *Eth) SetTxPollTimeout(timeout int) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
 	if timeout == 0 {
 		// default tx poll timeout is 720s
 		e.txPollTimeout = 720
@@ -82,6 +107,13 @@ func (e *Eth) SetTxPollTimeout(timeout int) {
 	e.txPollTimeout = timeout
 }
 
+// Get timeout for polling confirmation from txs (unit second)
+func (e *Eth) TxPollTimeout() int {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.txPollTimeout
+}
+
 // Get accounts from rpc providers
 func (e *Eth) Accounts() ([]common.Address, error) {
 	var out []common.Address
@@ -93,7 +125,11 @@ func (e *Eth) Accounts() ([]common.Address, error) {
 
 // Get current default account address
 func (e *Eth) Address() common.Address {
-	return e.address
+	signer := e.Signer()
+	if signer == nil {
+		return common.Address{}
+	}
+	return signer.Address()
 }
 
 // Get current block height
@@ -114,6 +150,11 @@ func (e *Eth) GetBlockHeaderByNumber(number *big.Int, full bool) (*eTypes.Header
 	return head, nil
 }
 
+// Get block hash by block number, nil if the block does not exist
+func (e *Eth) GetBlockHash(number *big.Int) (*common.Hash, error) {
+	return e.getBlockHash(number)
+}
+
 // Get block header by block number
 func (e *Eth) GetBlocByNumber(number *big.Int, full bool) (*eTypes.Block, error) {
 	return e.getBlock("eth_getBlockByNumber", utils.ToBlockNumArg(number), full)
@@ -151,8 +192,13 @@ func (e *Eth) GetTransactionReceipt(hash common.Hash) (*eTypes.Receipt, error) {
 
 // Get nonce of account
 func (e *Eth) GetNonce(addr common.Address, blockNumber *big.Int) (uint64, error) {
+	return e.GetNonceAt(addr, types.BlockNumberOrHashWithNumber(blockNumber))
+}
+
+// Get nonce of account at a block selected by number, tag or hash
+func (e *Eth) GetNonceAt(addr common.Address, block types.BlockNumberOrHash) (uint64, error) {
 	var nonce string
-	if err := e.c.Call("eth_getTransactionCount", &nonce, addr, utils.ToBlockNumArg(blockNumber)); err != nil {
+	if err := e.c.Call("eth_getTransactionCount", &nonce, addr, block); err != nil {
 		return 0, err
 	}
 	return utils.ParseUint64orHex(nonce)
@@ -160,8 +206,13 @@ func (e *Eth) GetNonce(addr common.Address, blockNumber *big.Int) (uint64, error
 
 // Get ether balance of account
 func (e *Eth) GetBalance(addr common.Address, blockNumber *big.Int) (*big.Int, error) {
+	return e.GetBalanceAt(addr, types.BlockNumberOrHashWithNumber(blockNumber))
+}
+
+// Get ether balance of account at a block selected by number, tag or hash
+func (e *Eth) GetBalanceAt(addr common.Address, block types.BlockNumberOrHash) (*big.Int, error) {
 	var out string
-	if err := e.c.Call("eth_getBalance", &out, addr, utils.ToBlockNumArg(blockNumber)); err != nil {
+	if err := e.c.Call("eth_getBalance", &out, addr, block); err != nil {
 		return nil, err
 	}
 	b, ok := new(big.Int).SetString(out[2:], 16)
@@ -171,6 +222,41 @@ func (e *Eth) GetBalance(addr common.Address, blockNumber *big.Int) (*big.Int, e
 	return b, nil
 }
 
+// Get contract code of account
+func (e *Eth) GetCode(addr common.Address, blockNumber *big.Int) ([]byte, error) {
+	return e.GetCodeAt(addr, types.BlockNumberOrHashWithNumber(blockNumber))
+}
+
+// Get contract code of account at a block selected by number, tag or hash
+func (e *Eth) GetCodeAt(addr common.Address, block types.BlockNumberOrHash) ([]byte, error) {
+	var out hexutil.Bytes
+	if err := e.c.Call("eth_getCode", &out, addr, block); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Get a storage slot of account
+func (e *Eth) GetStorageAt(addr common.Address, slot common.Hash, block types.BlockNumberOrHash) (common.Hash, error) {
+	var out hexutil.Bytes
+	if err := e.c.Call("eth_getStorageAt", &out, addr, slot, block); err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(out), nil
+}
+
+// Get the merkle proof of account and its storage slots (EIP-1186)
+func (e *Eth) GetProof(addr common.Address, slots []common.Hash, block types.BlockNumberOrHash) (*types.AccountProof, error) {
+	if slots == nil {
+		slots = []common.Hash{}
+	}
+	var out *types.AccountProof
+	if err := e.c.Call("eth_getProof", &out, addr, slots, block); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Get gas price for Non-EIP1559 tx
 func (e *Eth) GasPrice() (uint64, error) {
 	var out string
@@ -180,6 +266,15 @@ func (e *Eth) GasPrice() (uint64, error) {
 	return utils.ParseUint64orHex(out)
 }
 
+// Get gas price for Non-EIP1559 tx as big int
+func (e *Eth) SuggestGasPrice() (*big.Int, error) {
+	var out hexutil.Big
+	if err := e.c.Call("eth_gasPrice", &out); err != nil {
+		return nil, err
+	}
+	return (*big.Int)(&out), nil
+}
+
 // Get fee history for EIP1559 blocks
 func (e *Eth) FeeHistory(historicalBlocks int, blockNumber *big.Int, feeHistoryPercentile []float64) (*types.FeeHistory, error) {
 	var out *types.FeeHistory
@@ -191,13 +286,57 @@ func (e *Eth) FeeHistory(historicalBlocks int, blockNumber *big.Int, feeHistoryP
 
 // Do Call functions
 func (e *Eth) Call(msg *types.CallMsg, block *big.Int) (string, error) {
+	return e.CallAt(msg, types.BlockNumberOrHashWithNumber(block))
+}
+
+// Call at a block selected by number, tag or hash
+func (e *Eth) CallAt(msg *types.CallMsg, block types.BlockNumberOrHash) (string, error) {
+	var out string
+	if err := e.c.Call("eth_call", &out, msg, block); err != nil {
+		return "", wrapRevert(nil, err)
+	}
+	return out, nil
+}
+
+// Call with state overrides and block overrides, both are optional
+func (e *Eth) CallWithOverrides(
+	msg *types.CallMsg,
+	block *big.Int,
+	stateOverride types.StateOverride,
+	blockOverrides *types.BlockOverrides,
+) (string, error) {
+	return e.CallWithOverridesAt(msg, types.BlockNumberOrHashWithNumber(block), stateOverride, blockOverrides)
+}
+
+// Call with state overrides and block overrides at a block selected by number, tag or hash
+func (e *Eth) CallWithOverridesAt(
+	msg *types.CallMsg,
+	block types.BlockNumberOrHash,
+	stateOverride types.StateOverride,
+	blockOverrides *types.BlockOverrides,
+) (string, error) {
 	var out string
-	if err := e.c.Call("eth_call", &out, msg, utils.ToBlockNumArg(block)); err != nil {
-		return "", err
+	if err := e.c.Call("eth_call", &out, callParams(msg, block, stateOverride, blockOverrides)...); err != nil {
+		return "", wrapRevert(nil, err)
 	}
 	return out, nil
 }
 
+// callParams builds eth_call params, leaving out trailing overrides that are not set
+func callParams(msg interface{}, block types.BlockNumberOrHash, stateOverride types.StateOverride, blockOverrides *types.BlockOverrides) []interface{} {
+	params := []interface{}{msg, block}
+	if blockOverrides != nil {
+		if stateOverride == nil {
+			stateOverride = types.StateOverride{}
+		}
+		return append(params, stateOverride, blockOverrides)
+	}
+	if len(stateOverride) > 0 {
+		params = append(params, stateOverride)
+	}
+	return params
+}
+
 // Estimate gas for deploying contract
 func (e *Eth) EstimateGasContract(bin []byte) (uint64, error) {
 	var out string
@@ -205,7 +344,7 @@ func (e *Eth) EstimateGasContract(bin []byte) (uint64, error) {
 		"data": "0x" + hex.EncodeToString(bin),
 	}
 	if err := e.c.Call("eth_estimateGas", &out, msg); err != nil {
-		return 0, err
+		return 0, wrapRevert(nil, err)
 	}
 	return utils.ParseUint64orHex(out)
 }
@@ -214,15 +353,15 @@ func (e *Eth) EstimateGasContract(bin []byte) (uint64, error) {
 func (e *Eth) EstimateGas(msg *types.CallMsg) (uint64, error) {
 	var out string
 	if err := e.c.Call("eth_estimateGas", &out, msg); err != nil {
-		return 0, err
+		return 0, wrapRevert(nil, err)
 	}
 	return utils.ParseUint64orHex(out)
 }
 
 // Get currnet network chainId from provider
 func (e *Eth) ChainID() (*big.Int, error) {
-	if e.chainId != nil {
-		return e.chainId, nil
+	if chainId := e.GetChainId(); chainId != nil {
+		return chainId, nil
 	}
 	var out string
 	if err := e.c.Call("eth_chainId", &out); err != nil {
@@ -240,6 +379,15 @@ func (e *Eth) GetLogs(fliter *types.Fliter) ([]*types.Event, error) {
 	return out, nil
 }
 
+// FilterLogs returns the logs matching the filter
+func (e *Eth) FilterLogs(filter *types.FilterQuery) ([]*types.Log, error) {
+	out := make([]*types.Log, 0)
+	if err := e.c.Call("eth_getLogs", &out, filter); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (e *Eth) SuggestGasTipCap() (*big.Int, error) {
 	var hex hexutil.Big
 	if err := e.c.Call("eth_maxPriorityFeePerGas", &hex); err != nil {
@@ -307,32 +455,9 @@ func (e *Eth) EstimatePriorityFee(historicalBlocks int, blockNumber *big.Int, fe
 	return rewards[midIndex].ToInt(), nil
 }
 
+// EstimateFee suggests EIP-1559 fees with the current gas oracle, see SetGasOracle
 func (e *Eth) EstimateFee() (*EstimateFee, error) {
-	header, err := e.GetBlockHeaderByNumber(nil, false)
-	if err != nil {
-		return nil, err
-	}
-	priorityFee, err := e.SuggestGasTipCap()
-	if err != nil {
-		return nil, err
-	}
-	potentialMaxFee := big.NewInt(1).Mul(header.BaseFee, getBaseFeeMultiplier(header.BaseFee))
-	potentialMaxFee = big.NewInt(1).Div(potentialMaxFee, big.NewInt(10))
-
-	maxFeePerGas := big.NewInt(0)
-
-	if priorityFee.Cmp(potentialMaxFee) > 0 {
-		maxFeePerGas = big.NewInt(1).Add(potentialMaxFee, priorityFee)
-	} else {
-		maxFeePerGas = potentialMaxFee
-	}
-
-	fee := &EstimateFee{
-		BaseFee:              header.BaseFee,
-		MaxPriorityFeePerGas: priorityFee,
-		MaxFeePerGas:         maxFeePerGas,
-	}
-	return fee, nil
+	return e.SuggestFee()
 }
 
 func (e *Eth) DecodeParameters(parameters []string, data []byte) ([]interface{}, error) {
@@ -346,27 +471,20 @@ func (e *Eth) EncodeParameters(parameters []string, data []interface{}) ([]byte,
 // SignText signs raw text message
 // keccak256("\x19Ethereum Signed Message:\n"${message length}${message}).
 func (e *Eth) SignText(data []byte) ([]byte, error) {
-	hashData := accounts.TextHash(data)
-	signature, err := crypto.Sign(hashData, e.privateKey)
-	if err != nil {
-		return nil, err
-	}
-	if signature[64] == 0 || signature[64] == 1 {
-		signature[64] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	signer := e.Signer()
+	if signer == nil {
+		return nil, errNoSigner
 	}
-	return signature, nil
+	return signer.SignText(data)
 }
 
 // EcSign signs raw hash message
 func (e *Eth) EcSign(hashData []byte) ([]byte, error) {
-	signature, err := crypto.Sign(hashData, e.privateKey)
-	if err != nil {
-		return nil, err
-	}
-	if signature[64] == 0 || signature[64] == 1 {
-		signature[64] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	signer := e.Signer()
+	if signer == nil {
+		return nil, errNoSigner
 	}
-	return signature, nil
+	return signHashWithV27(signer, hashData)
 }
 
 // SignTypedData signs EIP-712 conformant typed data
@@ -375,45 +493,18 @@ func (e *Eth) EcSign(hashData []byte) ([]byte, error) {
 // - the signature,
 // - and/or any error
 func (e *Eth) SignTypedData(data apitypes.TypedData) ([]byte, error) {
-	if e.privateKey == nil {
-		return nil, fmt.Errorf("please setup private key before signing")
-	}
-	domainSeparator, err := data.HashStruct("EIP712Domain", data.Domain.Map())
-	if err != nil {
-		return nil, err
-	}
-
-	typedDataHash, err := data.HashStruct(data.PrimaryType, data.Message)
-	if err != nil {
-		return nil, err
+	signer := e.Signer()
+	if signer == nil {
+		return nil, errNoSigner
 	}
-
-	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
-
-	sighash := crypto.Keccak256(rawData)
-
-	signature, err := crypto.Sign(sighash, e.privateKey)
-	if err != nil {
-		return nil, err
-	}
-
-	if signature[64] == 0 || signature[64] == 1 {
-		signature[64] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
-	}
-
-	return signature, nil
+	return signer.SignTypedData(data)
 }
 
-func getBaseFeeMultiplier(baseFee *big.Int) *big.Int {
-	u := utils.Utils{}
-	if baseFee.Cmp(u.ToGWei(40)) <= 0 {
-		return big.NewInt(20)
-	}
-	if baseFee.Cmp(u.ToGWei(100)) <= 0 {
-		return big.NewInt(16)
-	}
-	if baseFee.Cmp(u.ToGWei(200)) <= 0 {
-		return big.NewInt(14)
+// signTx signs the transaction with the default signer for current chain
+func (e *Eth) signTx(tx *eTypes.Transaction) (*eTypes.Transaction, error) {
+	signer := e.Signer()
+	if signer == nil {
+		return nil, errNoSigner
 	}
-	return big.NewInt(12)
+	return signer.SignTx(tx, e.GetChainId())
 }
@@ -1,9 +1,18 @@
 package eth
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
@@ -70,3 +79,163 @@ func TestSignTypedData(t *testing.T) {
 	}
 	fmt.Printf("signature %x\n", signature)
 }
+
+// wrappedSigner delegates to a local signer, standing in for a remote signer
+type wrappedSigner struct {
+	*LocalSigner
+	signed int
+}
+
+func (w *wrappedSigner) SignHash(hash []byte) ([]byte, error) {
+	w.signed++
+	return w.LocalSigner.SignHash(hash)
+}
+
+func TestSetSigner(t *testing.T) {
+	local, err := NewLocalSignerFromHex(privateKeyUsedForTest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer := &wrappedSigner{LocalSigner: local}
+
+	eth := NewEth(nil)
+	eth.SetSigner(signer)
+	if eth.Address() != local.Address() {
+		t.Fatalf("address mismatch %v != %v", eth.Address(), local.Address())
+	}
+
+	signature, err := eth.SignText([]byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "fac68dfe57b08c93fec39e3427f09278329681405f4ee127e7674b83e9efc20837ec42cb248ed4a8d47e6add61d290e0d60454da4954d81218051a65e5d9b9751b"
+	if hex.EncodeToString(signature) != expected {
+		t.Fatalf("unexpected signature %x", signature)
+	}
+
+	if _, err := eth.EcSign(crypto.Keccak256([]byte("hello"))); err != nil {
+		t.Fatal(err)
+	}
+	if signer.signed != 1 {
+		t.Fatalf("EcSign did not go through the signer")
+	}
+
+	eth.SetChainId(1)
+	tx, err := eth.NewEIP1559Tx(common.Address{}, big.NewInt(1), 21000, big.NewInt(1), big.NewInt(2), nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	from, err := eTypes.Sender(eTypes.LatestSignerForChainID(big.NewInt(1)), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != local.Address() {
+		t.Fatalf("tx signed by %v, expected %v", from, local.Address())
+	}
+}
+
+func TestSignWithoutSigner(t *testing.T) {
+	eth := NewEth(nil)
+	if _, err := eth.SignText([]byte("hello")); err == nil {
+		t.Fatal("expected error without signer")
+	}
+}
+
+func TestBlockNumberOrHashParams(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	slot := common.HexToHash("0x02")
+
+	var blocks []string
+	m := newMockServer(t)
+	m.handle("eth_getBalance", func(params []json.RawMessage) (interface{}, error) {
+		blocks = append(blocks, string(params[1]))
+		return "0x10", nil
+	})
+	m.handle("eth_getStorageAt", func(params []json.RawMessage) (interface{}, error) {
+		blocks = append(blocks, string(params[2]))
+		return slot.Hex(), nil
+	})
+	m.handle("eth_getProof", func(params []json.RawMessage) (interface{}, error) {
+		blocks = append(blocks, string(params[2]))
+		return map[string]interface{}{
+			"address":      addr,
+			"accountProof": []string{"0x01"},
+			"balance":      "0x10",
+			"codeHash":     common.Hash{},
+			"nonce":        "0x1",
+			"storageHash":  common.Hash{},
+			"storageProof": []interface{}{map[string]interface{}{"key": slot, "value": "0x5", "proof": []string{"0x02"}}},
+		}, nil
+	})
+	m.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		blocks = append(blocks, string(params[1]))
+		return hexutil.Encode(common.LeftPadBytes([]byte{7}, 32)), nil
+	})
+	m.handle("eth_simulateV1", func(params []json.RawMessage) (interface{}, error) {
+		blocks = append(blocks, string(params[1]))
+		return []interface{}{map[string]interface{}{"number": "0x2", "calls": []interface{}{}}}, nil
+	})
+	e := m.eth(t)
+
+	if balance, err := e.GetBalanceAt(addr, types.BlockNumberOrHashWithHash(hash, true)); err != nil || balance.Int64() != 16 {
+		t.Fatalf("unexpected balance %v %v", balance, err)
+	}
+	if _, err := e.GetBalance(addr, big.NewInt(5)); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := e.GetStorageAt(addr, slot, types.BlockNumberOrHashWithTag(types.SafeBlock)); err != nil || value != slot {
+		t.Fatalf("unexpected storage %v %v", value, err)
+	}
+	proof, err := e.GetProof(addr, []common.Hash{slot}, types.BlockNumberOrHashWithTag(types.FinalizedBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.Address != addr || uint64(proof.Nonce) != 1 || len(proof.StorageProof) != 1 || proof.StorageProof[0].Value.ToInt().Int64() != 5 {
+		t.Fatalf("unexpected proof %+v", proof)
+	}
+
+	contr, err := e.NewContract(`[{"inputs":[],"name":"value","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`, addr.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := types.BlockNumberOrHashWithHash(hash, false)
+	ret, err := contr.CallWithOpts(&CallOpts{BlockNumber: big.NewInt(1), Block: &block}, "value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret[0].(*big.Int).Int64() != 7 {
+		t.Fatalf("unexpected value %v", ret)
+	}
+	if value, err := contr.CallAt(types.BlockNumberOrHashWithHash(hash, true), "value"); err != nil || value.(*big.Int).Int64() != 7 {
+		t.Fatalf("unexpected value %v %v", value, err)
+	}
+	if _, err := contr.Call("value"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := contr.CallWithMultiReturnsAt(types.BlockNumberOrHashWithTag(types.SafeBlock), "value"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := contr.CallAtWithMultiReturns(big.NewInt(3), "value"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.SimulateAt(&SimulateOpts{BlockStateCalls: []*SimulateBlock{{}}}, types.BlockNumberOrHashWithHash(hash, false)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`{"blockHash":"` + hash.Hex() + `","requireCanonical":true}`,
+		`"0x5"`,
+		`"safe"`,
+		`"finalized"`,
+		`{"blockHash":"` + hash.Hex() + `"}`,
+		`{"blockHash":"` + hash.Hex() + `","requireCanonical":true}`,
+		`"latest"`,
+		`"safe"`,
+		`"0x3"`,
+		`{"blockHash":"` + hash.Hex() + `"}`,
+	}
+	if strings.Join(blocks, " ") != strings.Join(want, " ") {
+		t.Fatalf("unexpected block params %v", blocks)
+	}
+}
@@ -0,0 +1,155 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrUnknownEvent = errors.New("log does not match any event of the abi")
+
+// EventLog is a log decoded with a contract ABI
+type EventLog struct {
+	Name  string
+	Event abi.Event
+	// Args holds the indexed and non-indexed arguments by name. Indexed strings, bytes,
+	// arrays and tuples are only available as the common.Hash of their value.
+	Args map[string]interface{}
+	Log  *types.Log
+}
+
+// ParseLog identifies the event of log by topic0 and decodes its arguments
+func (c *Contract) ParseLog(log *types.Log) (*EventLog, error) {
+	if len(log.Topics) == 0 {
+		return nil, ErrUnknownEvent
+	}
+	event, err := c.abi.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, ErrUnknownEvent
+	}
+
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if len(log.Topics)-1 != len(indexed) {
+		return nil, fmt.Errorf("event %s has %d indexed arguments, log has %d topics", event.Name, len(indexed), len(log.Topics)-1)
+	}
+
+	args := make(map[string]interface{}, len(event.Inputs))
+	if err := event.Inputs.UnpackIntoMap(args, log.Data); err != nil {
+		return nil, err
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	return &EventLog{Name: event.Name, Event: *event, Args: args, Log: log}, nil
+}
+
+// ParseLogInto decodes log into out, a pointer to a struct with a field for every event
+// argument named like abigen does or tagged with `abi:"name"`, and returns the event name
+func (c *Contract) ParseLogInto(out interface{}, log *types.Log) (string, error) {
+	l, err := c.ParseLog(log)
+	if err != nil {
+		return "", err
+	}
+	return l.Name, l.Decode(out)
+}
+
+// Decode copies the arguments into out, a pointer to a struct with a field for every
+// event argument named like abigen does or tagged with `abi:"name"`
+func (l *EventLog) Decode(out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode event %s into %T: want a pointer to a struct", l.Name, out)
+	}
+	v = v.Elem()
+
+	// unnamed arguments are named arg0, arg1... by the abi parser
+	for _, arg := range l.Event.Inputs {
+		name := arg.Name
+		field := structField(v, name)
+		if !field.IsValid() {
+			return fmt.Errorf("decode event %s into %T: no field for argument %s", l.Name, out, name)
+		}
+		value := reflect.ValueOf(l.Args[name])
+		switch {
+		case !value.IsValid():
+			return fmt.Errorf("decode event %s into %T: argument %s is missing", l.Name, out, name)
+		case value.Type().AssignableTo(field.Type()):
+			field.Set(value)
+		case value.Type().ConvertibleTo(field.Type()):
+			field.Set(value.Convert(field.Type()))
+		default:
+			return fmt.Errorf("decode event %s into %T: argument %s is %v, field is %v", l.Name, out, name, value.Type(), field.Type())
+		}
+	}
+	return nil
+}
+
+// structField finds the field of the argument by abi tag or by the abigen field name
+func structField(v reflect.Value, name string) reflect.Value {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("abi") == name {
+			return v.Field(i)
+		}
+	}
+	return v.FieldByName(abi.ToCamelCase(name))
+}
+
+// EventFilter builds a filter of the event logs of the contract. Values fill the indexed
+// arguments in order: nil matches anything, a []interface{} matches any of its values.
+// Indexed strings and bytes are matched by hash, a common.Hash is used as the topic as is.
+// e.g. EventFilter("Transfer", nil, to) matches all Transfer events to address to.
+func (c *Contract) EventFilter(eventName string, values ...interface{}) (*types.FilterQuery, error) {
+	event, ok := c.abi.Events[eventName]
+	if !ok {
+		return nil, fmt.Errorf("event %v not found", eventName)
+	}
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if len(values) > len(indexed) {
+		return nil, fmt.Errorf("event %s has %d indexed arguments, got %d values", eventName, len(indexed), len(values))
+	}
+
+	query := make([][]interface{}, len(values))
+	for i, value := range values {
+		rules, ok := value.([]interface{})
+		if !ok && value != nil {
+			rules = []interface{}{value}
+		}
+		for _, rule := range rules {
+			if _, ok := rule.(common.Hash); ok {
+				continue
+			}
+			if _, err := (abi.Arguments{{Type: indexed[i].Type}}).Pack(rule); err != nil {
+				return nil, fmt.Errorf("event %s argument %s: %v", eventName, indexed[i].Name, err)
+			}
+		}
+		query[i] = rules
+	}
+	topics, err := abi.MakeTopics(query...)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := types.NewFilterQuery().SetTopic(0, event.ID)
+	for i, position := range topics {
+		filter.SetTopic(i+1, position...)
+	}
+	if c.addr != (common.Address{}) {
+		filter.SetAddresses(c.addr)
+	}
+	return filter, nil
+}
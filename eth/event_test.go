@@ -0,0 +1,155 @@
+package eth
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const eventTestABI = `[
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"owner","type":"address"},{"indexed":true,"name":"spender","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Approval","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"key","type":"string"},{"indexed":false,"name":"","type":"bytes"}],"name":"Stored","type":"event"}
+]`
+
+func TestParseLog(t *testing.T) {
+	contr, err := NewContract(eventTestABI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := contr.AllEvents()
+	sort.Strings(events)
+	if len(events) != 3 || events[0] != "Approval" || contr.Event("Transfer").ID != crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")) {
+		t.Fatalf("unexpected events %v", events)
+	}
+
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	log := &types.Log{
+		Topics:   []common.Hash{contr.Event("Transfer").ID, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:     common.BigToHash(big.NewInt(42)).Bytes(),
+		LogIndex: 3,
+	}
+	parsed, err := contr.ParseLog(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Name != "Transfer" || parsed.Args["from"] != from || parsed.Args["to"] != to || parsed.Args["value"].(*big.Int).Int64() != 42 || parsed.Log.LogIndex != 3 {
+		t.Fatalf("unexpected parsed log %+v", parsed)
+	}
+
+	var transfer struct {
+		From  common.Address
+		To    common.Address `abi:"to"`
+		Value *big.Int
+	}
+	name, err := contr.ParseLogInto(&transfer, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "Transfer" || transfer.From != from || transfer.To != to || transfer.Value.Int64() != 42 {
+		t.Fatalf("unexpected transfer %+v", transfer)
+	}
+	var wrong struct {
+		From  string
+		To    common.Address
+		Value *big.Int
+	}
+	if _, err := contr.ParseLogInto(&wrong, log); err == nil {
+		t.Fatal("expected type mismatch error")
+	}
+
+	// indexed dynamic arguments are only available as hashes, unnamed arguments as argN
+	bytesTy := contr.Event("Stored").Inputs.NonIndexed()
+	data, err := bytesTy.Pack([]byte{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err = contr.ParseLog(&types.Log{
+		Topics: []common.Hash{contr.Event("Stored").ID, crypto.Keccak256Hash([]byte("name"))},
+		Data:   data,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Args["key"] != crypto.Keccak256Hash([]byte("name")) || len(parsed.Args["arg1"].([]byte)) != 2 {
+		t.Fatalf("unexpected stored log %+v", parsed.Args)
+	}
+
+	if _, err := contr.ParseLog(&types.Log{Topics: []common.Hash{{1}}}); !errors.Is(err, ErrUnknownEvent) {
+		t.Fatalf("expected ErrUnknownEvent, got %v", err)
+	}
+	if _, err := contr.ParseLog(&types.Log{Topics: log.Topics[:2], Data: log.Data}); err == nil {
+		t.Fatal("expected topic count error")
+	}
+}
+
+func TestEventFilter(t *testing.T) {
+	token := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	other := common.HexToAddress("0x4000000000000000000000000000000000000004")
+
+	m := newMockServer(t)
+	var filter map[string]interface{}
+	m.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		if err := json.Unmarshal(params[0], &filter); err != nil {
+			return nil, err
+		}
+		return []interface{}{map[string]interface{}{
+			"address":          token,
+			"topics":           []common.Hash{crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), {}, common.BytesToHash(to.Bytes())},
+			"data":             common.BigToHash(big.NewInt(7)),
+			"blockNumber":      "0x10",
+			"blockHash":        common.Hash{1},
+			"transactionHash":  common.Hash{2},
+			"transactionIndex": "0x0",
+			"logIndex":         "0x1",
+			"removed":          false,
+		}}, nil
+	})
+	e := m.eth(t)
+	contr, err := e.NewContract(eventTestABI, token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q, err := contr.EventFilter("Transfer", nil, []interface{}{to, other})
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs, err := e.FilterLogs(q.SetBlockRange(big.NewInt(1), big.NewInt(16)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	topics := filter["topics"].([]interface{})
+	if filter["address"] != strings.ToLower(token.Hex()) || filter["fromBlock"] != "0x1" || len(topics) != 3 || topics[1] != nil {
+		t.Fatalf("unexpected filter %v", filter)
+	}
+	if recipients := topics[2].([]interface{}); len(recipients) != 2 || recipients[0] != common.BytesToHash(to.Bytes()).Hex() {
+		t.Fatalf("unexpected recipients %v", topics[2])
+	}
+	parsed, err := contr.ParseLog(logs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Args["to"] != to || parsed.Log.LogIndex != 1 {
+		t.Fatalf("unexpected log %+v", parsed)
+	}
+
+	if _, err := contr.EventFilter("Transfer", "not an address"); err == nil {
+		t.Fatal("expected type error")
+	}
+	if _, err := contr.EventFilter("Transfer", nil, nil, nil); err == nil {
+		t.Fatal("expected too many values error")
+	}
+	if q, err := contr.EventFilter("Stored", "name"); err != nil || q.Topics[1][0] != crypto.Keccak256Hash([]byte("name")) {
+		t.Fatalf("unexpected string topic %v %v", q, err)
+	}
+}
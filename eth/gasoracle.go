@@ -0,0 +1,340 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/chenzhijie/go-web3/types"
+)
+
+// GasOracle suggests transaction fees, see EstimateFee for the pricing modes
+type GasOracle interface {
+	SuggestFee() (*EstimateFee, error)
+}
+
+// GasOracleFunc adapts a function to GasOracle
+type GasOracleFunc func() (*EstimateFee, error)
+
+func (f GasOracleFunc) SuggestFee() (*EstimateFee, error) {
+	return f()
+}
+
+// Urgency selects the fee history reward percentile of FeeHistoryGasOracle
+type Urgency int
+
+const (
+	UrgencySlow Urgency = iota
+	UrgencyStandard
+	UrgencyFast
+)
+
+var urgencyPercentiles = map[Urgency]float64{
+	UrgencySlow:     10,
+	UrgencyStandard: 50,
+	UrgencyFast:     90,
+}
+
+const (
+	defaultFeeHistoryBlocks = 20
+	// max fee per gas covers the base fee doubling, i.e. 6 full blocks in a row
+	baseFeeHeadroom = 2
+)
+
+var errNoGasOracle = errors.New("no gas oracle suggested a fee")
+
+// ErrLegacyFeeMode is returned when EIP-1559 txs are built but the gas oracle suggests
+// legacy fees, i.e. the chain has no EIP-1559
+var ErrLegacyFeeMode = errors.New("gas oracle suggests legacy fees, send a legacy tx instead")
+
+// Set gas oracle used for filling the fees of transactions, default is NodeGasOracle
+func (e *Eth) SetGasOracle(oracle GasOracle) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.gasOracle = oracle
+}
+
+// Get current gas oracle
+func (e *Eth) GasOracle() GasOracle {
+	e.lock.RLock()
+	oracle := e.gasOracle
+	e.lock.RUnlock()
+	if oracle == nil {
+		return NewNodeGasOracle(e)
+	}
+	return oracle
+}
+
+// SuggestFee suggests fees with the current gas oracle
+func (e *Eth) SuggestFee() (*EstimateFee, error) {
+	return e.GasOracle().SuggestFee()
+}
+
+// fillFeeCaps fills missing fee caps from the gas oracle, legacy suggestions are
+// rejected with ErrLegacyFeeMode
+func (e *Eth) fillFeeCaps(gasTipCap, gasFeeCap *big.Int) (*big.Int, *big.Int, error) {
+	if gasTipCap != nil && gasFeeCap != nil {
+		return gasTipCap, gasFeeCap, nil
+	}
+	fee, err := e.SuggestFee()
+	if err != nil {
+		return nil, nil, err
+	}
+	if fee.IsLegacy() {
+		return nil, nil, ErrLegacyFeeMode
+	}
+	if gasTipCap == nil {
+		gasTipCap = fee.MaxPriorityFeePerGas
+	}
+	if gasFeeCap == nil {
+		gasFeeCap = fee.MaxFeePerGas
+	}
+	return gasTipCap, gasFeeCap, nil
+}
+
+// NodeGasOracle suggests the priority fee with eth_maxPriorityFeePerGas and
+// max fee per gas as 2 * base fee + priority fee.
+// Nodes without eth_maxPriorityFeePerGas get the median priority fee of recent blocks,
+// chains without EIP-1559 get the legacy eth_gasPrice.
+type NodeGasOracle struct {
+	e *Eth
+}
+
+func NewNodeGasOracle(e *Eth) *NodeGasOracle {
+	return &NodeGasOracle{e: e}
+}
+
+func (o *NodeGasOracle) SuggestFee() (*EstimateFee, error) {
+	header, err := o.e.GetBlockHeaderByNumber(nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if header.BaseFee == nil {
+		return o.e.legacyFee()
+	}
+	priorityFee, err := o.e.SuggestGasTipCap()
+	if isMethodNotFound(err) {
+		priorityFee, err = o.e.feeHistoryTip(defaultFeeHistoryBlocks, urgencyPercentiles[UrgencyStandard])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newEstimateFee(header.BaseFee, priorityFee), nil
+}
+
+// FeeHistoryGasOracle suggests the priority fee as the median over recent blocks of
+// the reward percentile selected by urgency, and max fee per gas from the base fee
+// of the next block
+type FeeHistoryGasOracle struct {
+	e          *Eth
+	Blocks     int
+	Percentile float64
+}
+
+func NewFeeHistoryGasOracle(e *Eth, urgency Urgency) *FeeHistoryGasOracle {
+	percentile, ok := urgencyPercentiles[urgency]
+	if !ok {
+		percentile = urgencyPercentiles[UrgencyStandard]
+	}
+	return &FeeHistoryGasOracle{
+		e:          e,
+		Blocks:     defaultFeeHistoryBlocks,
+		Percentile: percentile,
+	}
+}
+
+func (o *FeeHistoryGasOracle) SuggestFee() (*EstimateFee, error) {
+	feeHistory, err := o.e.FeeHistory(o.Blocks, nil, []float64{o.Percentile})
+	if err == nil && len(feeHistory.BaseFeePerGas) == 0 {
+		err = fmt.Errorf("fee history has no base fee")
+	}
+	if err != nil {
+		// eth_feeHistory fails on chains without EIP-1559
+		if header, herr := o.e.GetBlockHeaderByNumber(nil, false); herr == nil && header.BaseFee == nil {
+			return o.e.legacyFee()
+		}
+		return nil, err
+	}
+	// the last base fee is the one of the next block
+	nextBaseFee := feeHistory.BaseFeePerGas[len(feeHistory.BaseFeePerGas)-1].ToInt()
+
+	tip, err := medianReward(feeHistory)
+	if err != nil {
+		return nil, err
+	}
+	return newEstimateFee(nextBaseFee, tip), nil
+}
+
+// FixedGasOracle always suggests the same fees
+type FixedGasOracle struct {
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+func NewFixedGasOracle(maxFeePerGas, maxPriorityFeePerGas *big.Int) *FixedGasOracle {
+	return &FixedGasOracle{
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+	}
+}
+
+func (o *FixedGasOracle) SuggestFee() (*EstimateFee, error) {
+	return &EstimateFee{
+		MaxFeePerGas:         new(big.Int).Set(o.MaxFeePerGas),
+		MaxPriorityFeePerGas: new(big.Int).Set(o.MaxPriorityFeePerGas),
+	}, nil
+}
+
+// CappedGasOracle limits the fees suggested by another oracle, nil caps are ignored
+type CappedGasOracle struct {
+	Oracle               GasOracle
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+func NewCappedGasOracle(oracle GasOracle, maxFeePerGas, maxPriorityFeePerGas *big.Int) *CappedGasOracle {
+	return &CappedGasOracle{
+		Oracle:               oracle,
+		MaxFeePerGas:         maxFeePerGas,
+		MaxPriorityFeePerGas: maxPriorityFeePerGas,
+	}
+}
+
+func (o *CappedGasOracle) SuggestFee() (*EstimateFee, error) {
+	fee, err := o.Oracle.SuggestFee()
+	if err != nil {
+		return nil, err
+	}
+	if o.MaxFeePerGas != nil && fee.MaxFeePerGas.Cmp(o.MaxFeePerGas) > 0 {
+		fee.MaxFeePerGas = new(big.Int).Set(o.MaxFeePerGas)
+	}
+	if o.MaxPriorityFeePerGas != nil && fee.MaxPriorityFeePerGas.Cmp(o.MaxPriorityFeePerGas) > 0 {
+		fee.MaxPriorityFeePerGas = new(big.Int).Set(o.MaxPriorityFeePerGas)
+	}
+	// priority fee can never exceed max fee per gas
+	if fee.MaxPriorityFeePerGas.Cmp(fee.MaxFeePerGas) > 0 {
+		fee.MaxPriorityFeePerGas = new(big.Int).Set(fee.MaxFeePerGas)
+	}
+	if fee.GasPrice != nil && fee.GasPrice.Cmp(fee.MaxFeePerGas) > 0 {
+		fee.GasPrice = new(big.Int).Set(fee.MaxFeePerGas)
+	}
+	return fee, nil
+}
+
+// MinGasOracle suggests the lowest fees of the oracles, oracles failing are skipped.
+// All oracles must suggest fees of the same mode.
+func MinGasOracle(oracles ...GasOracle) GasOracle {
+	return combineGasOracles(oracles, -1)
+}
+
+// MaxGasOracle suggests the highest fees of the oracles, oracles failing are skipped.
+// All oracles must suggest fees of the same mode.
+func MaxGasOracle(oracles ...GasOracle) GasOracle {
+	return combineGasOracles(oracles, 1)
+}
+
+// combineGasOracles picks per fee field the value v with v.Cmp(current) == sign
+func combineGasOracles(oracles []GasOracle, sign int) GasOracle {
+	return GasOracleFunc(func() (*EstimateFee, error) {
+		var result *EstimateFee
+		var errs []error
+		for _, oracle := range oracles {
+			fee, err := oracle.SuggestFee()
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if result == nil {
+				result = fee
+				continue
+			}
+			if fee.Mode != result.Mode {
+				return nil, fmt.Errorf("gas oracles suggest fees of mixed modes %v and %v", result.Mode, fee.Mode)
+			}
+			if fee.MaxFeePerGas.Cmp(result.MaxFeePerGas) == sign {
+				result.MaxFeePerGas = fee.MaxFeePerGas
+			}
+			if fee.MaxPriorityFeePerGas.Cmp(result.MaxPriorityFeePerGas) == sign {
+				result.MaxPriorityFeePerGas = fee.MaxPriorityFeePerGas
+			}
+			if fee.GasPrice != nil && (result.GasPrice == nil || fee.GasPrice.Cmp(result.GasPrice) == sign) {
+				result.GasPrice = fee.GasPrice
+			}
+			if result.BaseFee == nil {
+				result.BaseFee = fee.BaseFee
+			}
+		}
+		if result == nil {
+			return nil, errors.Join(append([]error{errNoGasOracle}, errs...)...)
+		}
+		if result.MaxPriorityFeePerGas.Cmp(result.MaxFeePerGas) > 0 {
+			result.MaxPriorityFeePerGas = new(big.Int).Set(result.MaxFeePerGas)
+		}
+		return result, nil
+	})
+}
+
+// feeHistoryTip returns the median of the reward percentile over recent blocks
+func (e *Eth) feeHistoryTip(blocks int, percentile float64) (*big.Int, error) {
+	feeHistory, err := e.FeeHistory(blocks, nil, []float64{percentile})
+	if err != nil {
+		return nil, err
+	}
+	return medianReward(feeHistory)
+}
+
+func (e *Eth) legacyFee() (*EstimateFee, error) {
+	gasPrice, err := e.SuggestGasPrice()
+	if err != nil {
+		return nil, err
+	}
+	return &EstimateFee{
+		Mode:                 FeeModeLegacy,
+		GasPrice:             gasPrice,
+		MaxFeePerGas:         new(big.Int).Set(gasPrice),
+		MaxPriorityFeePerGas: new(big.Int).Set(gasPrice),
+	}, nil
+}
+
+func medianReward(feeHistory *types.FeeHistory) (*big.Int, error) {
+	rewards := make([]*big.Int, 0, len(feeHistory.Reward))
+	for _, item := range feeHistory.Reward {
+		if len(item) == 0 || item[0] == nil {
+			continue
+		}
+		rewards = append(rewards, item[0].ToInt())
+	}
+	if len(rewards) == 0 {
+		return nil, fmt.Errorf("reward is empty")
+	}
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+	return rewards[len(rewards)/2], nil
+}
+
+// isMethodNotFound reports whether err is a json-rpc error of a method the node does not serve
+func isMethodNotFound(err error) bool {
+	var rpcErr *codec.ErrorObject
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.Code == -32601 {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Message)
+	return strings.Contains(msg, "method not found") ||
+		strings.Contains(msg, "does not exist") ||
+		strings.Contains(msg, "not supported")
+}
+
+func newEstimateFee(baseFee, priorityFee *big.Int) *EstimateFee {
+	maxFeePerGas := new(big.Int).Mul(baseFee, big.NewInt(baseFeeHeadroom))
+	maxFeePerGas.Add(maxFeePerGas, priorityFee)
+	return &EstimateFee{
+		BaseFee:              baseFee,
+		MaxPriorityFeePerGas: priorityFee,
+		MaxFeePerGas:         maxFeePerGas,
+	}
+}
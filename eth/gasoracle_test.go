@@ -0,0 +1,194 @@
+package eth
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func mockHeader(number int64, baseFee *big.Int) *eTypes.Header {
+	return &eTypes.Header{
+		Number:     big.NewInt(number),
+		Difficulty: big.NewInt(0),
+		GasLimit:   30_000_000,
+		BaseFee:    baseFee,
+	}
+}
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e9))
+}
+
+func TestNodeGasOracle(t *testing.T) {
+	m := newMockServer(t)
+	m.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return mockHeader(100, gwei(10)), nil
+	})
+	m.handle("eth_maxPriorityFeePerGas", func(params []json.RawMessage) (interface{}, error) {
+		return (*hexutil.Big)(gwei(2)), nil
+	})
+	e := m.eth(t)
+
+	fee, err := e.EstimateFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.BaseFee.Cmp(gwei(10)) != 0 || fee.MaxPriorityFeePerGas.Cmp(gwei(2)) != 0 || fee.MaxFeePerGas.Cmp(gwei(22)) != 0 {
+		t.Fatalf("unexpected fee %+v", fee)
+	}
+}
+
+func TestFeeHistoryGasOracle(t *testing.T) {
+	m := newMockServer(t)
+	var percentiles []float64
+	m.handle("eth_feeHistory", func(params []json.RawMessage) (interface{}, error) {
+		if err := json.Unmarshal(params[2], &percentiles); err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{
+			"oldestBlock":   "0x1",
+			"baseFeePerGas": []*hexutil.Big{(*hexutil.Big)(gwei(8)), (*hexutil.Big)(gwei(9)), (*hexutil.Big)(gwei(10)), (*hexutil.Big)(gwei(11))},
+			"gasUsedRatio":  []float64{0.5, 0.9, 0.9},
+			"reward":        [][]*hexutil.Big{{(*hexutil.Big)(gwei(3))}, {(*hexutil.Big)(gwei(1))}, {(*hexutil.Big)(gwei(5))}},
+		}, nil
+	})
+	e := m.eth(t)
+
+	fee, err := NewFeeHistoryGasOracle(e, UrgencyFast).SuggestFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(percentiles) != 1 || percentiles[0] != 90 {
+		t.Fatalf("unexpected percentiles %v", percentiles)
+	}
+	// median reward 3 gwei, next base fee 11 gwei
+	if fee.MaxPriorityFeePerGas.Cmp(gwei(3)) != 0 || fee.MaxFeePerGas.Cmp(gwei(25)) != 0 {
+		t.Fatalf("unexpected fee %+v", fee)
+	}
+}
+
+func TestComposedGasOracles(t *testing.T) {
+	low := NewFixedGasOracle(gwei(20), gwei(1))
+	high := NewFixedGasOracle(gwei(50), gwei(3))
+	failing := GasOracleFunc(func() (*EstimateFee, error) {
+		return nil, errors.New("unavailable")
+	})
+
+	fee, err := MinGasOracle(high, low, failing).SuggestFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.MaxFeePerGas.Cmp(gwei(20)) != 0 || fee.MaxPriorityFeePerGas.Cmp(gwei(1)) != 0 {
+		t.Fatalf("unexpected min fee %+v", fee)
+	}
+
+	fee, err = NewCappedGasOracle(MaxGasOracle(low, high), gwei(40), gwei(2)).SuggestFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.MaxFeePerGas.Cmp(gwei(40)) != 0 || fee.MaxPriorityFeePerGas.Cmp(gwei(2)) != 0 {
+		t.Fatalf("unexpected capped fee %+v", fee)
+	}
+
+	if _, err := MaxGasOracle(failing).SuggestFee(); !errors.Is(err, errNoGasOracle) {
+		t.Fatalf("expected errNoGasOracle, got %v", err)
+	}
+	// the fixed oracle must not be mutated by composition
+	if low.MaxFeePerGas.Cmp(gwei(20)) != 0 {
+		t.Fatalf("fixed oracle mutated to %v", low.MaxFeePerGas)
+	}
+}
+
+func TestNewEIP1559TxUsesGasOracle(t *testing.T) {
+	e := NewEth(nil)
+	e.SetChainId(1)
+	e.SetGasOracle(NewFixedGasOracle(gwei(30), gwei(2)))
+
+	tx, err := e.NewEIP1559Tx(common.Address{}, big.NewInt(1), 21000, nil, nil, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.GasFeeCap().Cmp(gwei(30)) != 0 || tx.GasTipCap().Cmp(gwei(2)) != 0 {
+		t.Fatalf("unexpected caps %v %v", tx.GasFeeCap(), tx.GasTipCap())
+	}
+
+	tx, err = e.NewEIP1559Tx(common.Address{}, big.NewInt(1), 21000, gwei(1), nil, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.GasFeeCap().Cmp(gwei(30)) != 0 || tx.GasTipCap().Cmp(gwei(1)) != 0 {
+		t.Fatalf("unexpected caps %v %v", tx.GasFeeCap(), tx.GasTipCap())
+	}
+}
+
+func TestNodeGasOracleLegacyChain(t *testing.T) {
+	m := newMockServer(t)
+	m.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return mockHeader(100, nil), nil
+	})
+	m.handle("eth_gasPrice", func(params []json.RawMessage) (interface{}, error) {
+		return (*hexutil.Big)(gwei(5)), nil
+	})
+	e := m.eth(t)
+
+	fee, err := e.EstimateFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fee.IsLegacy() || fee.BaseFee != nil || fee.GasPrice.Cmp(gwei(5)) != 0 || fee.MaxFeePerGas.Cmp(gwei(5)) != 0 {
+		t.Fatalf("unexpected fee %+v", fee)
+	}
+	if m.callCount("eth_maxPriorityFeePerGas") != 0 {
+		t.Fatal("priority fee queried on legacy chain")
+	}
+
+	// eth_feeHistory is missing as well
+	fee, err = NewFeeHistoryGasOracle(e, UrgencySlow).SuggestFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Mode != FeeModeLegacy {
+		t.Fatalf("unexpected fee mode %v", fee.Mode)
+	}
+
+	// EIP-1559 txs are not built from legacy fees
+	if _, err := e.NewEIP1559Tx(common.Address{}, big.NewInt(1), 21000, nil, nil, nil, 0); !errors.Is(err, ErrLegacyFeeMode) {
+		t.Fatalf("expected ErrLegacyFeeMode, got %v", err)
+	}
+	// fees of both modes are not combined
+	if _, err := MinGasOracle(NewNodeGasOracle(e), NewFixedGasOracle(gwei(30), gwei(2))).SuggestFee(); err == nil {
+		t.Fatal("expected mixed modes error")
+	}
+}
+
+func TestNodeGasOracleWithoutMaxPriorityFee(t *testing.T) {
+	m := newMockServer(t)
+	m.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return mockHeader(100, gwei(10)), nil
+	})
+	m.handle("eth_feeHistory", func(params []json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{
+			"oldestBlock":   "0x1",
+			"baseFeePerGas": []*hexutil.Big{(*hexutil.Big)(gwei(10)), (*hexutil.Big)(gwei(10))},
+			"gasUsedRatio":  []float64{0.5},
+			"reward":        [][]*hexutil.Big{{(*hexutil.Big)(gwei(4))}},
+		}, nil
+	})
+	e := m.eth(t)
+
+	fee, err := e.EstimateFee()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Mode != FeeModeLondon || fee.MaxPriorityFeePerGas.Cmp(gwei(4)) != 0 || fee.MaxFeePerGas.Cmp(gwei(24)) != 0 {
+		t.Fatalf("unexpected fee %+v", fee)
+	}
+	if m.callCount("eth_maxPriorityFeePerGas") != 1 {
+		t.Fatal("expected eth_maxPriorityFeePerGas to be tried first")
+	}
+}
@@ -0,0 +1,379 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/ethereum/go-ethereum/common"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const defaultHeadWindow = 64
+
+// HeadEventType is the type of a HeadEvent
+type HeadEventType int
+
+const (
+	// HeadEventNew is a new canonical head extending the previous one
+	HeadEventNew HeadEventType = iota
+	// HeadEventReorg replaces the Dropped headers by the Added ones
+	HeadEventReorg
+	// HeadEventFinalized is a new finalized block
+	HeadEventFinalized
+	// HeadEventSafe is a new safe block
+	HeadEventSafe
+)
+
+func (t HeadEventType) String() string {
+	switch t {
+	case HeadEventNew:
+		return "new head"
+	case HeadEventReorg:
+		return "reorg"
+	case HeadEventFinalized:
+		return "finalized"
+	case HeadEventSafe:
+		return "safe"
+	}
+	return fmt.Sprintf("HeadEventType(%d)", int(t))
+}
+
+// Head is a block header with the hash reported by the node
+type Head struct {
+	Hash   common.Hash
+	Header *eTypes.Header
+}
+
+// Number returns the block number
+func (h *Head) Number() uint64 {
+	return h.Header.Number.Uint64()
+}
+
+// HeadEvent is an event of a HeadTracker. Head is the new head, or the finalized or
+// safe block. Reorgs carry the Depth, the number of Dropped blocks, and the Dropped and
+// Added blocks oldest first; Head is then the last added block.
+type HeadEvent struct {
+	Type    HeadEventType
+	Head    *Head
+	Depth   int
+	Dropped []*Head
+	Added   []*Head
+}
+
+// HeadTrackerOpts are the options of a HeadTracker, zero values use the defaults
+type HeadTrackerOpts struct {
+	// Window is the number of recent canonical headers kept, default 64
+	Window int
+	// PollInterval is the polling interval when newHeads subscriptions are unavailable
+	PollInterval time.Duration
+	// Buffer is the capacity of the events channel
+	Buffer int
+}
+
+// HeadTracker keeps a window of recent canonical headers and emits new heads, reorgs and
+// finalized/safe advances. Heads come from newHeads subscriptions when the transport
+// supports them, otherwise the node is polled.
+type HeadTracker struct {
+	e    *Eth
+	opts HeadTrackerOpts
+
+	lock      sync.RWMutex
+	window    []*Head
+	finalized *Head
+	safe      *Head
+	noTags    bool
+
+	events chan *HeadEvent
+}
+
+// NewHeadTracker creates a head tracker, call Run to start tracking
+func (e *Eth) NewHeadTracker(opts *HeadTrackerOpts) *HeadTracker {
+	t := &HeadTracker{e: e}
+	if opts != nil {
+		t.opts = *opts
+	}
+	if t.opts.Window <= 0 {
+		t.opts.Window = defaultHeadWindow
+	}
+	if t.opts.PollInterval <= 0 {
+		t.opts.PollInterval = e.TxPollInterval()
+	}
+	t.events = make(chan *HeadEvent, t.opts.Buffer)
+	return t
+}
+
+// Events returns the events channel, it is closed when Run returns
+func (t *HeadTracker) Events() <-chan *HeadEvent {
+	return t.events
+}
+
+// Run tracks heads until ctx is done or a request fails
+func (t *HeadTracker) Run(ctx context.Context) error {
+	defer close(t.events)
+	heads, unsubscribe := t.e.watchHeadsEvery(t.opts.PollInterval)
+	defer unsubscribe()
+
+	for {
+		if err := t.Update(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-heads:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Update fetches the latest, finalized and safe blocks once and emits the events,
+// it must not be called concurrently with Run
+func (t *HeadTracker) Update(ctx context.Context) error {
+	latest, err := t.e.getHead("latest")
+	if err != nil {
+		return err
+	}
+	if latest == nil {
+		return errors.New("latest block not found")
+	}
+	events, err := t.advance(latest)
+	if err != nil {
+		return err
+	}
+
+	if !t.noTags {
+		for _, tag := range []HeadEventType{HeadEventFinalized, HeadEventSafe} {
+			event, err := t.advanceTag(tag)
+			if err != nil {
+				return err
+			}
+			if event != nil {
+				events = append(events, event)
+			}
+		}
+	}
+
+	for _, event := range events {
+		select {
+		case t.events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// advance links latest to the window and returns the new head or reorg events
+func (t *HeadTracker) advance(latest *Head) ([]*HeadEvent, error) {
+	t.lock.RLock()
+	window := t.window
+	t.lock.RUnlock()
+
+	if len(window) > 0 && window[len(window)-1].Hash == latest.Hash {
+		return nil, nil
+	}
+	index := make(map[common.Hash]int, len(window))
+	for i, h := range window {
+		index[h.Hash] = i
+	}
+	// a lagging or load balanced node returned an older canonical head
+	if _, ok := index[latest.Hash]; ok {
+		return nil, nil
+	}
+
+	// walk back from latest until a block of the window
+	added := []*Head{latest}
+	ancestor := -1
+	if len(window) > 0 {
+		for cur := latest; len(added) <= t.opts.Window; {
+			if i, ok := index[cur.Header.ParentHash]; ok {
+				ancestor = i
+				break
+			}
+			if cur.Number() == 0 || cur.Number() <= window[0].Number() {
+				break
+			}
+			parent, err := t.e.getHead(cur.Header.ParentHash)
+			if err != nil {
+				return nil, err
+			}
+			if parent == nil {
+				return nil, fmt.Errorf("parent %v of block %d not found", cur.Header.ParentHash, cur.Number())
+			}
+			added = append(added, parent)
+			cur = parent
+		}
+	}
+	for i, j := 0, len(added)-1; i < j; i, j = i+1, j-1 {
+		added[i], added[j] = added[j], added[i]
+	}
+
+	// the walk stopped on the window size after a long gap, not on a fork
+	gap := ancestor < 0 && len(window) > 0 && added[0].Number() > window[len(window)-1].Number()
+
+	var events []*HeadEvent
+	var kept []*Head
+	switch {
+	case len(window) == 0 || gap:
+		events = append(events, &HeadEvent{Type: HeadEventNew, Head: latest})
+	case ancestor == len(window)-1:
+		kept = window
+		for _, h := range added {
+			events = append(events, &HeadEvent{Type: HeadEventNew, Head: h})
+		}
+	default:
+		// without a common ancestor the whole window is dropped
+		kept = window[:ancestor+1]
+		dropped := append([]*Head(nil), window[ancestor+1:]...)
+		events = append(events, &HeadEvent{
+			Type:    HeadEventReorg,
+			Head:    latest,
+			Depth:   len(dropped),
+			Dropped: dropped,
+			Added:   added,
+		})
+	}
+
+	next := append(append([]*Head(nil), kept...), added...)
+	if len(next) > t.opts.Window {
+		next = next[len(next)-t.opts.Window:]
+	}
+	t.lock.Lock()
+	t.window = next
+	t.lock.Unlock()
+	return events, nil
+}
+
+func (t *HeadTracker) advanceTag(typ HeadEventType) (*HeadEvent, error) {
+	tag := "finalized"
+	if typ == HeadEventSafe {
+		tag = "safe"
+	}
+	head, err := t.e.getHead(tag)
+	if isBlockTagUnsupported(err) {
+		// nodes without finality reject the tags
+		t.noTags = true
+		return nil, nil
+	}
+	if isBlockTagNotFound(err) {
+		// no finalized or safe block yet, e.g. while syncing
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		return nil, nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	prev := &t.finalized
+	if typ == HeadEventSafe {
+		prev = &t.safe
+	}
+	if *prev != nil && (*prev).Hash == head.Hash {
+		return nil, nil
+	}
+	*prev = head
+	return &HeadEvent{Type: typ, Head: head}, nil
+}
+
+// Head returns the current canonical head, nil before the first update
+func (t *HeadTracker) Head() *Head {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	if len(t.window) == 0 {
+		return nil
+	}
+	return t.window[len(t.window)-1]
+}
+
+// Headers returns the window of recent canonical headers, oldest first
+func (t *HeadTracker) Headers() []*Head {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return append([]*Head(nil), t.window...)
+}
+
+// HeadByNumber returns the canonical header of the window at number, nil if out of the window
+func (t *HeadTracker) HeadByNumber(number uint64) *Head {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	if len(t.window) == 0 {
+		return nil
+	}
+	first := t.window[0].Number()
+	if number < first || number-first >= uint64(len(t.window)) {
+		return nil
+	}
+	return t.window[number-first]
+}
+
+// Finalized returns the last finalized block, nil if unknown
+func (t *HeadTracker) Finalized() *Head {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.finalized
+}
+
+// Safe returns the last safe block, nil if unknown
+func (t *HeadTracker) Safe() *Head {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.safe
+}
+
+// isBlockTagUnsupported reports whether the node rejected the finalized or safe tag
+func isBlockTagUnsupported(err error) bool {
+	var rpcErr *codec.ErrorObject
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	if rpcErr.Code == -32602 || isMethodNotFound(err) {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Message)
+	return strings.Contains(msg, "unknown block tag") ||
+		strings.Contains(msg, "invalid block tag") ||
+		strings.Contains(msg, "invalid block number")
+}
+
+// isBlockTagNotFound reports whether the node has no finalized or safe block yet
+func isBlockTagNotFound(err error) bool {
+	var rpcErr *codec.ErrorObject
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	msg := strings.ToLower(rpcErr.Message)
+	return strings.Contains(msg, "finalized block not found") || strings.Contains(msg, "safe block not found")
+}
+
+// getHead gets a header with its node hash by block tag or hash, nil if not found
+func (e *Eth) getHead(block interface{}) (*Head, error) {
+	method := "eth_getBlockByNumber"
+	if _, ok := block.(common.Hash); ok {
+		method = "eth_getBlockByHash"
+	}
+	var raw json.RawMessage
+	if err := e.c.Call(method, &raw, block, false); err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var header *eTypes.Header
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, err
+	}
+	var hash rpcBlockHash
+	if err := json.Unmarshal(raw, &hash); err != nil {
+		return nil, err
+	}
+	return &Head{Hash: hash.Hash, Header: header}, nil
+}
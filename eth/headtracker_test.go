@@ -0,0 +1,247 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// mockHeadChain serves a canonical chain of headers that can fork
+type mockHeadChain struct {
+	lock      sync.Mutex
+	canonical []*eTypes.Header
+	byHash    map[common.Hash]*eTypes.Header
+	finalized uint64
+	safe      uint64
+	// lag serves latest lag blocks behind the tip, like a lagging node
+	lag uint64
+	// tagErr fails the finalized and safe tags
+	tagErr error
+}
+
+// extend appends headers up to number, fork distinguishes headers of a new fork
+func (c *mockHeadChain) extend(number uint64, fork byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for n := uint64(len(c.canonical)); n <= number; n++ {
+		h := mockHeader(int64(n), gwei(1))
+		h.Extra = []byte{fork}
+		if n > 0 {
+			h.ParentHash = c.canonical[n-1].Hash()
+		}
+		c.canonical = append(c.canonical, h)
+		c.byHash[h.Hash()] = h
+	}
+}
+
+// reorg drops the headers from number on
+func (c *mockHeadChain) reorg(number uint64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.canonical = c.canonical[:number]
+}
+
+func newMockHeadChain(t *testing.T) (*mockHeadChain, *Eth) {
+	c := &mockHeadChain{byHash: map[common.Hash]*eTypes.Header{}}
+	m := newMockServer(t)
+	m.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		var tag string
+		if err := json.Unmarshal(params[0], &tag); err != nil {
+			return nil, err
+		}
+		switch tag {
+		case "latest":
+			return c.canonical[uint64(len(c.canonical)-1)-c.lag], nil
+		case "finalized", "safe":
+			if c.tagErr != nil {
+				return nil, c.tagErr
+			}
+			if tag == "finalized" {
+				return c.canonical[c.finalized], nil
+			}
+			return c.canonical[c.safe], nil
+		}
+		n, err := hexutil.DecodeUint64(tag)
+		if err != nil || n >= uint64(len(c.canonical)) {
+			return nil, err
+		}
+		return c.canonical[n], nil
+	})
+	m.handle("eth_getBlockByHash", func(params []json.RawMessage) (interface{}, error) {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		var hash common.Hash
+		if err := json.Unmarshal(params[0], &hash); err != nil {
+			return nil, err
+		}
+		return c.byHash[hash], nil
+	})
+	return c, m.eth(t)
+}
+
+func receiveHeadEvents(t *testing.T, tr *HeadTracker, n int) []*HeadEvent {
+	var events []*HeadEvent
+	for i := 0; i < n; i++ {
+		select {
+		case ev := <-tr.Events():
+			events = append(events, ev)
+		default:
+			t.Fatalf("got %d events, want %d", len(events), n)
+		}
+	}
+	select {
+	case ev := <-tr.Events():
+		t.Fatalf("unexpected event %v %d", ev.Type, ev.Head.Number())
+	default:
+	}
+	return events
+}
+
+func TestHeadTracker(t *testing.T) {
+	chain, e := newMockHeadChain(t)
+	chain.extend(10, 0)
+	chain.finalized, chain.safe = 5, 8
+
+	tr := e.NewHeadTracker(&HeadTrackerOpts{Window: 8, Buffer: 16})
+	ctx := context.Background()
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	events := receiveHeadEvents(t, tr, 3)
+	if events[0].Type != HeadEventNew || events[0].Head.Number() != 10 ||
+		events[1].Type != HeadEventFinalized || events[1].Head.Number() != 5 ||
+		events[2].Type != HeadEventSafe || events[2].Head.Number() != 8 {
+		t.Fatalf("unexpected events %+v", events)
+	}
+
+	// missed heads are filled from parents
+	chain.extend(13, 0)
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	events = receiveHeadEvents(t, tr, 3)
+	for i, ev := range events {
+		if ev.Type != HeadEventNew || ev.Head.Number() != uint64(11+i) {
+			t.Fatalf("unexpected event %v %d", ev.Type, ev.Head.Number())
+		}
+	}
+	if len(tr.Headers()) != 4 || tr.HeadByNumber(12).Hash != chain.canonical[12].Hash() {
+		t.Fatalf("unexpected window %d", len(tr.Headers()))
+	}
+
+	// 12 and 13 are replaced by 12', 13' and 14'
+	chain.reorg(12)
+	chain.extend(14, 1)
+	chain.finalized = 10
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	events = receiveHeadEvents(t, tr, 2)
+	reorg := events[0]
+	if reorg.Type != HeadEventReorg || reorg.Depth != 2 || len(reorg.Added) != 3 ||
+		reorg.Dropped[0].Number() != 12 || reorg.Added[0].Number() != 12 || reorg.Head.Number() != 14 {
+		t.Fatalf("unexpected reorg %+v", reorg)
+	}
+	if events[1].Type != HeadEventFinalized || tr.Finalized().Number() != 10 || tr.Safe().Number() != 8 {
+		t.Fatalf("unexpected finality %+v", events[1])
+	}
+	if tr.Head().Hash != chain.canonical[14].Hash() || tr.HeadByNumber(13).Hash != chain.canonical[13].Hash() {
+		t.Fatal("window not updated to the new fork")
+	}
+}
+
+func TestHeadTrackerLaggingNode(t *testing.T) {
+	chain, e := newMockHeadChain(t)
+	chain.extend(7, 0)
+	chain.finalized, chain.safe = 5, 6
+	tr := e.NewHeadTracker(&HeadTrackerOpts{Window: 8, Buffer: 16})
+	ctx := context.Background()
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	receiveHeadEvents(t, tr, 3)
+	chain.extend(10, 0)
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	receiveHeadEvents(t, tr, 3)
+
+	// an older head of the window is not a reorg
+	chain.lag = 2
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	receiveHeadEvents(t, tr, 0)
+	if tr.Head().Number() != 10 || len(tr.Headers()) != 4 {
+		t.Fatalf("window changed to head %d", tr.Head().Number())
+	}
+	chain.lag = 0
+	chain.extend(11, 0)
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if events := receiveHeadEvents(t, tr, 1); events[0].Type != HeadEventNew || events[0].Head.Number() != 11 {
+		t.Fatalf("unexpected event %+v", events[0])
+	}
+
+	// transient errors do not disable the finality tags
+	chain.tagErr = &codec.ErrorObject{Code: -32000, Message: "upstream request timeout"}
+	if err := tr.Update(ctx); err == nil {
+		t.Fatal("expected tag error")
+	}
+	chain.tagErr = nil
+	chain.finalized = 9
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if events := receiveHeadEvents(t, tr, 1); events[0].Type != HeadEventFinalized || events[0].Head.Number() != 9 {
+		t.Fatalf("unexpected event %+v", events[0])
+	}
+
+	// nodes rejecting the tags stop tracking them
+	chain.tagErr = &codec.ErrorObject{Code: -32602, Message: "invalid argument 0: unknown block tag"}
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	chain.tagErr = nil
+	chain.finalized = 10
+	if err := tr.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	receiveHeadEvents(t, tr, 0)
+}
+
+func TestHeadTrackerRun(t *testing.T) {
+	chain, e := newMockHeadChain(t)
+	chain.extend(3, 0)
+	tr := e.NewHeadTracker(&HeadTrackerOpts{PollInterval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- tr.Run(ctx) }()
+
+	if ev := <-tr.Events(); ev.Type != HeadEventNew || ev.Head.Number() != 3 {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+	<-tr.Events() // finalized
+	<-tr.Events() // safe
+	chain.extend(4, 0)
+	if ev := <-tr.Events(); ev.Type != HeadEventNew || ev.Head.Number() != 4 {
+		t.Fatalf("unexpected event %+v", ev)
+	}
+	cancel()
+	for range tr.Events() {
+	}
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
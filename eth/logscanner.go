@@ -0,0 +1,339 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	defaultLogScanChunkSize   = 2000
+	defaultLogScanMaxChunk    = 50000
+	defaultLogScanConcurrency = 4
+)
+
+// LogScanOpts are the options of Eth.ScanLogs, zero values use the defaults
+type LogScanOpts struct {
+	// ChunkSize is the initial number of blocks per eth_getLogs request
+	ChunkSize uint64
+	// MinChunkSize and MaxChunkSize bound the adaptive chunk size
+	MinChunkSize uint64
+	MaxChunkSize uint64
+	// Concurrency is the maximum number of requests in flight
+	Concurrency int
+	// ResumeToken continues a scan of the same filter and range after the chunk it was
+	// taken from
+	ResumeToken string
+}
+
+// LogChunk is the logs of blocks [From, To]. ResumeToken resumes a scan of the same
+// filter and range after this chunk.
+type LogChunk struct {
+	From        uint64
+	To          uint64
+	Logs        []*types.Log
+	ResumeToken string
+}
+
+// LogScanner streams the logs of a block range in block order
+type LogScanner struct {
+	e      *Eth
+	filter types.FilterQuery
+	from   uint64
+	to     uint64
+	// id identifies the filter and range in resume tokens
+	id []byte
+
+	minSize     uint64
+	maxSize     uint64
+	concurrency int
+
+	lock sync.Mutex
+	size uint64
+
+	chunks chan *LogChunk
+	err    error
+}
+
+// ScanLogs streams the logs of filter in blocks [from, to]. The range is split into chunks
+// fetched concurrently; chunks failing with result size or range limit errors are bisected
+// and the chunk size grows again after successes. Other errors, including provider rate
+// limits, stop the scan; the ResumeToken of the last chunk read continues it.
+// Read Chunks until it is closed, then Err.
+func (e *Eth) ScanLogs(ctx context.Context, filter *types.FilterQuery, from, to uint64, opts *LogScanOpts) *LogScanner {
+	if opts == nil {
+		opts = &LogScanOpts{}
+	}
+	s := &LogScanner{
+		e:           e,
+		from:        from,
+		to:          to,
+		size:        opts.ChunkSize,
+		minSize:     opts.MinChunkSize,
+		maxSize:     opts.MaxChunkSize,
+		concurrency: opts.Concurrency,
+		chunks:      make(chan *LogChunk),
+	}
+	if filter != nil {
+		s.filter = *filter
+	}
+	if s.size == 0 {
+		s.size = defaultLogScanChunkSize
+	}
+	if s.minSize == 0 {
+		s.minSize = 1
+	}
+	if s.maxSize == 0 {
+		s.maxSize = defaultLogScanMaxChunk
+	}
+	if s.maxSize < s.size {
+		s.maxSize = s.size
+	}
+	if s.concurrency <= 0 {
+		s.concurrency = defaultLogScanConcurrency
+	}
+
+	if s.filter.BlockHash != nil {
+		s.err = errors.New("can not scan a filter with blockHash")
+		close(s.chunks)
+		return s
+	}
+	s.id = logScanID(&s.filter, from, to)
+
+	if opts.ResumeToken != "" {
+		next, size, id, err := parseLogScanToken(opts.ResumeToken)
+		if err == nil && !bytes.Equal(id, s.id) {
+			err = errors.New("token of another filter or range")
+		}
+		if err != nil || next < from || next > to+1 {
+			s.err = fmt.Errorf("invalid resume token %q for blocks [%d, %d]", opts.ResumeToken, from, to)
+			close(s.chunks)
+			return s
+		}
+		s.from, s.size = next, size
+	}
+
+	go s.run(ctx)
+	return s
+}
+
+// Chunks returns the log chunks in block order, it is closed at the end of the scan or on error
+func (s *LogScanner) Chunks() <-chan *LogChunk {
+	return s.chunks
+}
+
+// Err returns the error that stopped the scan, valid once Chunks is closed
+func (s *LogScanner) Err() error {
+	return s.err
+}
+
+// ChunkSize returns the current adaptive chunk size
+func (s *LogScanner) ChunkSize() uint64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.size
+}
+
+func (s *LogScanner) run(ctx context.Context) {
+	defer close(s.chunks)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		seq   int
+		chunk *LogChunk
+		err   error
+	}
+	// at most concurrency requests are in flight, so workers never block on send
+	results := make(chan result, s.concurrency)
+	pending := make(map[int]*LogChunk)
+	next, seq, delivered, inflight := s.from, 0, 0, 0
+	done := next > s.to
+
+	for {
+		for !done && inflight < s.concurrency && len(pending) < s.concurrency {
+			from := next
+			to := from + s.ChunkSize() - 1
+			if to > s.to || to < from {
+				to = s.to
+			}
+			go func(seq int, from, to uint64) {
+				logs, err := s.fetch(ctx, from, to)
+				results <- result{seq: seq, chunk: &LogChunk{From: from, To: to, Logs: logs}, err: err}
+			}(seq, from, to)
+			seq++
+			inflight++
+			if to == s.to {
+				done = true
+			} else {
+				next = to + 1
+			}
+		}
+		if inflight == 0 {
+			return
+		}
+
+		select {
+		case r := <-results:
+			inflight--
+			if r.err != nil {
+				s.err = r.err
+				return
+			}
+			pending[r.seq] = r.chunk
+			for {
+				chunk, ok := pending[delivered]
+				if !ok {
+					break
+				}
+				chunk.ResumeToken = fmt.Sprintf("%d-%d-%x", chunk.To+1, s.ChunkSize(), s.id)
+				select {
+				case s.chunks <- chunk:
+				case <-ctx.Done():
+					s.err = ctx.Err()
+					return
+				}
+				delete(pending, delivered)
+				delivered++
+			}
+		case <-ctx.Done():
+			s.err = ctx.Err()
+			return
+		}
+	}
+}
+
+// fetch gets the logs of [from, to], bisecting the range on limit errors
+func (s *LogScanner) fetch(ctx context.Context, from, to uint64) ([]*types.Log, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	filter := s.filter
+	filter.FromBlock = new(big.Int).SetUint64(from)
+	filter.ToBlock = new(big.Int).SetUint64(to)
+	logs, err := s.e.FilterLogs(&filter)
+	if err == nil {
+		s.grow(to - from + 1)
+		return logs, nil
+	}
+	if from == to || !isLogLimitError(err) {
+		return nil, fmt.Errorf("get logs of blocks [%d, %d]: %w", from, to, err)
+	}
+
+	mid := from + (to-from)/2
+	s.shrink(mid - from + 1)
+	left, err := s.fetch(ctx, from, mid)
+	if err != nil {
+		return nil, err
+	}
+	right, err := s.fetch(ctx, mid+1, to)
+	if err != nil {
+		return nil, err
+	}
+	return append(left, right...), nil
+}
+
+// grow doubles the chunk size after a full chunk succeeded
+func (s *LogScanner) grow(size uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if size >= s.size {
+		s.size *= 2
+		if s.size > s.maxSize {
+			s.size = s.maxSize
+		}
+	}
+}
+
+// shrink lowers the chunk size to a size that was split on limit errors
+func (s *LogScanner) shrink(size uint64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if size < s.minSize {
+		size = s.minSize
+	}
+	if size < s.size {
+		s.size = size
+	}
+}
+
+// logScanID hashes the scanned filter without its block range and the scanned range
+func logScanID(filter *types.FilterQuery, from, to uint64) []byte {
+	f := *filter
+	f.FromBlock, f.ToBlock, f.FromTag, f.ToTag = nil, nil, "", ""
+	data, _ := json.Marshal(&f)
+	return crypto.Keccak256([]byte(fmt.Sprintf("%s-%d-%d", data, from, to)))[:8]
+}
+
+func parseLogScanToken(token string) (next, size uint64, id []byte, err error) {
+	if _, err := fmt.Sscanf(token, "%d-%d-%x", &next, &size, &id); err != nil {
+		return 0, 0, nil, err
+	}
+	if size == 0 {
+		return 0, 0, nil, errors.New("zero chunk size")
+	}
+	return next, size, id, nil
+}
+
+// isLogLimitError reports whether eth_getLogs failed on the result size or block range
+// limits of the provider, e.g. "query returned more than 10000 results" or
+// "block range is too wide". Rate limits are not, bisecting would only send more requests.
+func isLogLimitError(err error) bool {
+	var rpcErr *codec.ErrorObject
+	if !errors.As(err, &rpcErr) || isRateLimitError(rpcErr) {
+		return false
+	}
+	msg := strings.ToLower(rpcErr.Message)
+	for _, s := range []string{
+		"query returned more than",
+		"response size exceeded",
+		"response size is larger",
+		"too many logs",
+		"too many results",
+		"exceeds max results",
+		"block range is too wide",
+		"block range too wide",
+		"block range is too large",
+		"block range too large",
+		"block range limit",
+		"exceed maximum block range",
+		"exceeds maximum block range",
+		"max block range",
+		"is limited to a",
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRateLimitError reports whether the provider throttled the request
+func isRateLimitError(rpcErr *codec.ErrorObject) bool {
+	if rpcErr.Code == 429 {
+		return true
+	}
+	msg := strings.ToLower(rpcErr.Message)
+	for _, s := range []string{
+		"rate limit",
+		"rate exceeded",
+		"too many requests",
+		"request count exceeded",
+		"capacity",
+		"throttl",
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// mockLogs serves a log per block and rejects ranges wider than limit blocks
+func mockLogs(t *testing.T, limit uint64) (*mockServer, func() int) {
+	m := newMockServer(t)
+	var lock sync.Mutex
+	inflight, maxInflight := 0, 0
+	m.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		lock.Lock()
+		inflight++
+		if inflight > maxInflight {
+			maxInflight = inflight
+		}
+		lock.Unlock()
+		defer func() {
+			lock.Lock()
+			inflight--
+			lock.Unlock()
+		}()
+
+		var filter struct {
+			FromBlock hexutil.Uint64 `json:"fromBlock"`
+			ToBlock   hexutil.Uint64 `json:"toBlock"`
+		}
+		if err := json.Unmarshal(params[0], &filter); err != nil {
+			return nil, err
+		}
+		if uint64(filter.ToBlock-filter.FromBlock+1) > limit {
+			return nil, &codec.ErrorObject{Code: -32005, Message: "query returned more than 10000 results"}
+		}
+		logs := make([]*types.Log, 0)
+		for n := filter.FromBlock; n <= filter.ToBlock; n++ {
+			logs = append(logs, &types.Log{BlockNumber: n, Topics: []common.Hash{}, Data: []byte{}})
+		}
+		return logs, nil
+	})
+	return m, func() int {
+		lock.Lock()
+		defer lock.Unlock()
+		return maxInflight
+	}
+}
+
+func TestScanLogs(t *testing.T) {
+	m, maxInflight := mockLogs(t, 100)
+	e := m.eth(t)
+
+	s := e.ScanLogs(context.Background(), types.NewFilterQuery(), 10, 1009, &LogScanOpts{ChunkSize: 400, Concurrency: 3})
+	next := uint64(10)
+	var tokens []string
+	for chunk := range s.Chunks() {
+		if chunk.From != next {
+			t.Fatalf("chunk [%d, %d] out of order, want from %d", chunk.From, chunk.To, next)
+		}
+		for _, l := range chunk.Logs {
+			if uint64(l.BlockNumber) != next {
+				t.Fatalf("unexpected log of block %d, want %d", l.BlockNumber, next)
+			}
+			next++
+		}
+		tokens = append(tokens, chunk.ResumeToken)
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if next != 1010 {
+		t.Fatalf("scanned up to %d", next)
+	}
+	if size := s.ChunkSize(); size > 200 {
+		t.Fatalf("chunk size not adapted: %d", size)
+	}
+	if n := maxInflight(); n > 3 {
+		t.Fatalf("%d requests in flight, limit 3", n)
+	}
+
+	// resume after the first chunk
+	s = e.ScanLogs(context.Background(), types.NewFilterQuery(), 10, 1009, &LogScanOpts{ResumeToken: tokens[0]})
+	first := true
+	for chunk := range s.Chunks() {
+		if first && chunk.From == 10 {
+			t.Fatal("resumed scan restarted from the beginning")
+		}
+		first = false
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, resume := range []struct {
+		filter   *types.FilterQuery
+		from, to uint64
+		token    string
+	}{
+		{nil, 10, 1009, "bad"},
+		// tokens of another filter or range are rejected
+		{types.NewFilterQuery().AddAddress(common.HexToAddress("0x01")), 10, 1009, tokens[0]},
+		{types.NewFilterQuery(), 10, 2000, tokens[0]},
+	} {
+		s = e.ScanLogs(context.Background(), resume.filter, resume.from, resume.to, &LogScanOpts{ResumeToken: resume.token})
+		for range s.Chunks() {
+		}
+		if s.Err() == nil {
+			t.Fatalf("expected invalid resume token error for %+v", resume)
+		}
+	}
+}
+
+func TestScanLogsError(t *testing.T) {
+	m := newMockServer(t)
+	m.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		return nil, &codec.ErrorObject{Code: -32000, Message: "header not found"}
+	})
+	e := m.eth(t)
+
+	s := e.ScanLogs(context.Background(), nil, 0, 100, &LogScanOpts{ChunkSize: 10})
+	for range s.Chunks() {
+	}
+	if s.Err() == nil {
+		t.Fatal("expected error")
+	}
+	// other errors are not bisected
+	if n := m.callCount("eth_getLogs"); n > defaultLogScanConcurrency {
+		t.Fatalf("unexpected %d requests", n)
+	}
+
+	// nor are rate limits sharing the limit exceeded error code
+	m = newMockServer(t)
+	m.handle("eth_getLogs", func(params []json.RawMessage) (interface{}, error) {
+		return nil, &codec.ErrorObject{Code: -32005, Message: "daily request count exceeded, request rate limited"}
+	})
+	e = m.eth(t)
+	s = e.ScanLogs(context.Background(), nil, 0, 100, &LogScanOpts{ChunkSize: 10})
+	for range s.Chunks() {
+	}
+	if s.Err() == nil {
+		t.Fatal("expected error")
+	}
+	if n := m.callCount("eth_getLogs"); n > defaultLogScanConcurrency {
+		t.Fatalf("unexpected %d requests", n)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s = e.ScanLogs(ctx, nil, 0, 100, nil)
+	for range s.Chunks() {
+	}
+	if s.Err() == nil {
+		t.Fatal("expected context error")
+	}
+}
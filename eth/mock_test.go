@@ -0,0 +1,88 @@
+package eth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/rpc"
+	"github.com/chenzhijie/go-web3/rpc/codec"
+)
+
+type mockHandler func(params []json.RawMessage) (interface{}, error)
+
+// mockServer is a json-rpc http server serving canned responses for tests
+type mockServer struct {
+	*httptest.Server
+
+	lock     sync.Mutex
+	handlers map[string]mockHandler
+	calls    map[string]int
+}
+
+func newMockServer(t *testing.T) *mockServer {
+	m := &mockServer{
+		handlers: map[string]mockHandler{},
+		calls:    map[string]int{},
+	}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.serve))
+	t.Cleanup(m.Close)
+	return m
+}
+
+func (m *mockServer) handle(method string, h mockHandler) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.handlers[method] = h
+}
+
+func (m *mockServer) callCount(method string) int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.calls[method]
+}
+
+func (m *mockServer) eth(t *testing.T) *Eth {
+	c, err := rpc.NewClient(m.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewEth(c)
+}
+
+func (m *mockServer) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     uint64            `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	m.lock.Lock()
+	h, ok := m.handlers[req.Method]
+	m.calls[req.Method]++
+	m.lock.Unlock()
+
+	resp := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      req.ID,
+	}
+	if !ok {
+		resp["error"] = &codec.ErrorObject{Code: -32601, Message: "the method " + req.Method + " does not exist/is not available"}
+	} else if result, err := h(req.Params); err != nil {
+		if obj, ok := err.(*codec.ErrorObject); ok {
+			resp["error"] = obj
+		} else {
+			resp["error"] = &codec.ErrorObject{Code: -32000, Message: err.Error()}
+		}
+	} else {
+		resp["result"] = result
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
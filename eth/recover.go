@@ -0,0 +1,120 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var (
+	ErrInvalidSignature    = errors.New("invalid signature")
+	ErrMalleableSignature  = errors.New("invalid signature: s value in upper half of curve order")
+	ErrSignatureMismatched = errors.New("signature is not signed by the expected address")
+
+	secp256k1HalfN = new(big.Int).Div(crypto.S256().Params().N, big.NewInt(2))
+)
+
+// RecoverHash recovers the signer address of a hash signature.
+// Signatures are 65 bytes [R || S || V] with V as 0/1 or 27/28,
+// or 64 bytes EIP-2098 compact [R || yParityAndS]. High-s signatures are rejected.
+func RecoverHash(hash []byte, signature []byte) (common.Address, error) {
+	if len(hash) != common.HashLength {
+		return common.Address{}, fmt.Errorf("hash is required to be exactly %d bytes (%d)", common.HashLength, len(hash))
+	}
+	sig, err := normalizeSignature(signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// RecoverText recovers the signer address of a SignText signature
+func RecoverText(data []byte, signature []byte) (common.Address, error) {
+	return RecoverHash(accounts.TextHash(data), signature)
+}
+
+// RecoverTypedData recovers the signer address of an EIP-712 SignTypedData signature
+func RecoverTypedData(data apitypes.TypedData, signature []byte) (common.Address, error) {
+	hash, err := typedDataHash(data)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return RecoverHash(hash, signature)
+}
+
+// VerifyHash checks that the hash signature is signed by signer and returns the recovered address.
+// ErrSignatureMismatched is returned if another address signed it.
+func VerifyHash(signer common.Address, hash []byte, signature []byte) (common.Address, error) {
+	return verify(signer)(RecoverHash(hash, signature))
+}
+
+// VerifyText checks that the SignText signature is signed by signer and returns the recovered address
+func VerifyText(signer common.Address, data []byte, signature []byte) (common.Address, error) {
+	return verify(signer)(RecoverText(data, signature))
+}
+
+// VerifyTypedData checks that the EIP-712 signature is signed by signer and returns the recovered address
+func VerifyTypedData(signer common.Address, data apitypes.TypedData, signature []byte) (common.Address, error) {
+	return verify(signer)(RecoverTypedData(data, signature))
+}
+
+// CompactSignature converts a 65 bytes signature to 64 bytes EIP-2098 compact signature
+func CompactSignature(signature []byte) ([]byte, error) {
+	sig, err := normalizeSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	compact := make([]byte, 64)
+	copy(compact, sig[:64])
+	compact[32] |= sig[64] << 7
+	return compact, nil
+}
+
+func verify(signer common.Address) func(common.Address, error) (common.Address, error) {
+	return func(recovered common.Address, err error) (common.Address, error) {
+		if err != nil {
+			return common.Address{}, err
+		}
+		if recovered != signer {
+			return recovered, ErrSignatureMismatched
+		}
+		return recovered, nil
+	}
+}
+
+// normalizeSignature returns a 65 bytes [R || S || V] signature with V as 0/1
+func normalizeSignature(signature []byte) ([]byte, error) {
+	sig := make([]byte, crypto.SignatureLength)
+	switch len(signature) {
+	case crypto.SignatureLength:
+		copy(sig, signature)
+		if sig[64] >= 27 {
+			sig[64] -= 27
+		}
+	case 64:
+		copy(sig, signature)
+		sig[64] = signature[32] >> 7
+		sig[32] &= 0x7f
+	default:
+		return nil, fmt.Errorf("%w: length %d", ErrInvalidSignature, len(signature))
+	}
+
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	if s.Cmp(secp256k1HalfN) > 0 {
+		return nil, ErrMalleableSignature
+	}
+	if !crypto.ValidateSignatureValues(sig[64], r, s, true) {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
@@ -0,0 +1,108 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+func TestRecoverText(t *testing.T) {
+	e := NewEth(nil)
+	if err := e.SetAccount(privateKeyUsedForTest); err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("hello")
+	sig, err := e.SignText(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := VerifyText(e.Address(), msg, sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != e.Address() {
+		t.Fatalf("recovered %v, expected %v", addr, e.Address())
+	}
+
+	// v as 0/1
+	raw := common.CopyBytes(sig)
+	raw[64] -= 27
+	if addr, err := RecoverText(msg, raw); err != nil || addr != e.Address() {
+		t.Fatalf("recover with v 0/1: %v %v", addr, err)
+	}
+
+	// EIP-2098 compact signature
+	compact, err := CompactSignature(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr, err := RecoverText(msg, compact); err != nil || addr != e.Address() {
+		t.Fatalf("recover compact: %v %v", addr, err)
+	}
+
+	if _, err := VerifyText(common.Address{1}, msg, sig); err != ErrSignatureMismatched {
+		t.Fatalf("expected mismatch, got %v", err)
+	}
+	if _, err := RecoverText(msg, sig[:10]); err == nil {
+		t.Fatal("expected invalid length error")
+	}
+}
+
+func TestRecoverRejectsHighS(t *testing.T) {
+	key, err := crypto.HexToECDSA(privateKeyUsedForTest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := crypto.Keccak256([]byte("hello"))
+	sig, err := crypto.Sign(hash, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// flip s to n - s and v accordingly, producing the malleable twin signature
+	n := crypto.S256().Params().N
+	s := new(big.Int).SetBytes(sig[32:64])
+	highS := new(big.Int).Sub(n, s)
+	malleable := common.CopyBytes(sig)
+	copy(malleable[32:64], common.LeftPadBytes(highS.Bytes(), 32))
+	malleable[64] ^= 1
+
+	if _, err := RecoverHash(hash, malleable); err != ErrMalleableSignature {
+		t.Fatalf("expected ErrMalleableSignature, got %v", err)
+	}
+}
+
+func TestRecoverTypedData(t *testing.T) {
+	typedData := apitypes.TypedData{
+		Domain: apitypes.TypedDataDomain{
+			Name:    "Test",
+			Version: "1",
+		},
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+			},
+			"Mail": {
+				{Name: "contents", Type: "string"},
+			},
+		},
+		Message:     apitypes.TypedDataMessage{"contents": "hello"},
+		PrimaryType: "Mail",
+	}
+	e := NewEth(nil)
+	if err := e.SetAccount(privateKeyUsedForTest); err != nil {
+		t.Fatal(err)
+	}
+	sig, err := e.SignTypedData(typedData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := VerifyTypedData(e.Address(), typedData, sig); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,250 @@
+package eth
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	ErrTxNotReverted = errors.New("transaction did not revert")
+
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+)
+
+// panicReasons explains the Panic(uint256) codes of the solidity compiler
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler inserted panic",
+	0x01: "assert(false)",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "enum overflow",
+	0x22: "invalid encoded storage byte array accessed",
+	0x31: "out-of-bounds array access; popping on an empty array",
+	0x32: "out-of-bounds access of an array or bytesN",
+	0x41: "out of memory",
+	0x51: "uninitialized function",
+}
+
+// RevertError is a decoded revert of a call.
+// Name is "Error" for Error(string), "Panic" for Panic(uint256), the error name for
+// errors of the contract ABI, and empty if the revert data is unknown or missing.
+type RevertError struct {
+	Name      string
+	Signature string
+	Args      []interface{}
+	// Reason is the Error(string) message or the explained Panic(uint256) code
+	Reason    string
+	PanicCode *big.Int
+	Data      []byte
+
+	err error
+}
+
+func (e *RevertError) Error() string {
+	switch {
+	case e.Name == "Error":
+		return "execution reverted: " + e.Reason
+	case e.Name == "Panic":
+		return "execution reverted: panic: " + e.Reason
+	case e.Name != "":
+		values := make([]string, len(e.Args))
+		for i, arg := range e.Args {
+			values[i] = fmt.Sprint(arg)
+		}
+		return fmt.Sprintf("execution reverted: %s(%s)", e.Name, strings.Join(values, ", "))
+	case len(e.Data) > 0:
+		return "execution reverted: " + hexutil.Encode(e.Data)
+	}
+	return "execution reverted"
+}
+
+// Unwrap returns the rpc error the revert was decoded from
+func (e *RevertError) Unwrap() error {
+	return e.err
+}
+
+// IsPanic reports whether the revert is a Panic(uint256), e.g. a failed assert or an overflow
+func (e *RevertError) IsPanic() bool {
+	return e.Name == "Panic"
+}
+
+// DecodeRevert decodes Error(string) and Panic(uint256) revert data
+func DecodeRevert(data []byte) *RevertError {
+	return decodeRevert(nil, data)
+}
+
+// DecodeRevert decodes revert data with the errors of the contract ABI,
+// Error(string) and Panic(uint256)
+func (c *Contract) DecodeRevert(data []byte) *RevertError {
+	return decodeRevert(c.abi.Errors, data)
+}
+
+func decodeRevert(errs map[string]abi.Error, data []byte) *RevertError {
+	rev := &RevertError{Data: common.CopyBytes(data)}
+	if len(data) < 4 {
+		return rev
+	}
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		if reason, err := abi.UnpackRevert(data); err == nil {
+			rev.Name, rev.Signature, rev.Reason = "Error", "Error(string)", reason
+			rev.Args = []interface{}{reason}
+		}
+	case bytes.Equal(data[:4], panicSelector) && len(data) == 36:
+		code := new(big.Int).SetBytes(data[4:])
+		rev.Name, rev.Signature, rev.PanicCode = "Panic", "Panic(uint256)", code
+		rev.Args = []interface{}{code}
+		rev.Reason = fmt.Sprintf("unknown panic code %#x", code)
+		if code.IsUint64() {
+			if reason, ok := panicReasons[code.Uint64()]; ok {
+				rev.Reason = fmt.Sprintf("%s (%#x)", reason, code)
+			}
+		}
+	default:
+		for _, e := range errs {
+			if !bytes.Equal(data[:4], e.ID[:4]) {
+				continue
+			}
+			if args, err := e.Inputs.Unpack(data[4:]); err == nil {
+				rev.Name, rev.Signature, rev.Args = e.Name, e.Sig, args
+			}
+			break
+		}
+	}
+	return rev
+}
+
+// RevertData extracts the revert data of an rpc error
+func RevertData(err error) ([]byte, bool) {
+	var rpcErr *codec.ErrorObject
+	if !errors.As(err, &rpcErr) {
+		return nil, false
+	}
+	s, ok := rpcErr.Data.(string)
+	if !ok {
+		return nil, false
+	}
+	// some nodes prefix the data, e.g. "Reverted 0x..."
+	i := strings.Index(s, "0x")
+	if i < 0 {
+		return nil, false
+	}
+	data, decodeErr := hex.DecodeString(s[i+2:])
+	if decodeErr != nil || len(data) == 0 {
+		return nil, false
+	}
+	return data, true
+}
+
+// wrapRevert converts rpc errors of reverted calls into a *RevertError decoded with errs
+func wrapRevert(errs map[string]abi.Error, err error) error {
+	if data, ok := RevertData(err); ok {
+		rev := decodeRevert(errs, data)
+		rev.err = err
+		return rev
+	}
+	var rpcErr *codec.ErrorObject
+	if errors.As(err, &rpcErr) && strings.HasPrefix(rpcErr.Message, "execution reverted") {
+		rev := &RevertError{err: err}
+		if reason := strings.TrimPrefix(rpcErr.Message, "execution reverted: "); reason != rpcErr.Message {
+			rev.Name, rev.Signature, rev.Reason = "Error", "Error(string)", reason
+			rev.Args = []interface{}{reason}
+		}
+		return rev
+	}
+	return err
+}
+
+// isRevert reports whether err is a reverted call rather than a node or transport failure
+func isRevert(err error) bool {
+	var rev *RevertError
+	if errors.As(err, &rev) {
+		return true
+	}
+	var rpcErr *codec.ErrorObject
+	return errors.As(err, &rpcErr) && strings.HasPrefix(rpcErr.Message, "execution reverted")
+}
+
+func (c *Contract) wrapRevert(err error) error {
+	return wrapRevert(c.abi.Errors, err)
+}
+
+// TransactionRevertReason replays a failed mined tx with eth_call at its parent block
+// to recover the revert reason, decoded with the errors of contract if not nil.
+// The replay runs on the state before the block, so txs earlier in the same block are not
+// taken into account.
+func (e *Eth) TransactionRevertReason(hash common.Hash, contract *Contract) (*RevertError, error) {
+	receipt, err := e.GetTransactionReceipt(hash)
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, fmt.Errorf("receipt of tx %v not found", hash)
+	}
+	if receipt.Status == eTypes.ReceiptStatusSuccessful {
+		return nil, ErrTxNotReverted
+	}
+	tx, err := e.GetTransactionByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("tx %v not found", hash)
+	}
+
+	var signer eTypes.Signer = eTypes.HomesteadSigner{}
+	if tx.Protected() {
+		signer = eTypes.LatestSignerForChainID(tx.ChainId())
+	}
+	from, err := eTypes.Sender(signer, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	gas := hexutil.Uint64(tx.Gas())
+	msg := &txMsg{
+		From:  from,
+		To:    tx.To(),
+		Gas:   &gas,
+		Value: (*hexutil.Big)(tx.Value()),
+		Data:  tx.Data(),
+	}
+	parent := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	var out hexutil.Bytes
+	err = e.c.Call("eth_call", &out, callParams(msg, types.BlockNumberOrHashWithNumber(parent), nil, nil)...)
+	if err == nil {
+		return nil, ErrTxNotReverted
+	}
+
+	var errs map[string]abi.Error
+	if contract != nil {
+		errs = contract.abi.Errors
+	}
+	var rev *RevertError
+	if !errors.As(wrapRevert(errs, err), &rev) {
+		return nil, err
+	}
+	return rev, nil
+}
+
+// txMsg is the eth_call and eth_estimateGas message of a tx, To is nil for contract creations
+type txMsg struct {
+	From  common.Address  `json:"from"`
+	To    *common.Address `json:"to,omitempty"`
+	Gas   *hexutil.Uint64 `json:"gas,omitempty"`
+	Value *hexutil.Big    `json:"value,omitempty"`
+	Data  hexutil.Bytes   `json:"data"`
+}
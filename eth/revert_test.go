@@ -0,0 +1,146 @@
+package eth
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func packRevert(t *testing.T, sig string, types []string, args ...interface{}) []byte {
+	var arguments abi.Arguments
+	for _, typ := range types {
+		ty, err := abi.NewType(typ, "", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		arguments = append(arguments, abi.Argument{Type: ty})
+	}
+	data, err := arguments.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(crypto.Keccak256([]byte(sig))[:4], data...)
+}
+
+func TestDecodeRevert(t *testing.T) {
+	contr, err := NewContract(simulateTestABI)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rev := DecodeRevert(packRevert(t, "Error(string)", []string{"string"}, "not owner"))
+	if rev.Name != "Error" || rev.Reason != "not owner" || rev.Error() != "execution reverted: not owner" {
+		t.Fatalf("unexpected revert %+v", rev)
+	}
+
+	rev = DecodeRevert(packRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(0x11)))
+	if !rev.IsPanic() || rev.PanicCode.Int64() != 0x11 || rev.Reason != "arithmetic underflow or overflow (0x11)" {
+		t.Fatalf("unexpected panic %+v", rev)
+	}
+
+	custom := packRevert(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(1), big.NewInt(5))
+	if rev = DecodeRevert(custom); rev.Name != "" || !strings.HasPrefix(rev.Error(), "execution reverted: 0x") {
+		t.Fatalf("custom error decoded without abi %+v", rev)
+	}
+	rev = contr.DecodeRevert(custom)
+	if rev.Name != "InsufficientBalance" || rev.Signature != "InsufficientBalance(uint256,uint256)" || rev.Args[1].(*big.Int).Int64() != 5 {
+		t.Fatalf("unexpected custom error %+v", rev)
+	}
+
+	if rev = DecodeRevert(nil); rev.Error() != "execution reverted" {
+		t.Fatalf("unexpected empty revert %v", rev)
+	}
+}
+
+func TestContractCallRevert(t *testing.T) {
+	custom := packRevert(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(1), big.NewInt(5))
+	m := newMockServer(t)
+	m.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		return nil, &codec.ErrorObject{Code: 3, Message: "execution reverted", Data: hexutil.Encode(custom)}
+	})
+	m.handle("eth_estimateGas", func(params []json.RawMessage) (interface{}, error) {
+		return nil, &codec.ErrorObject{Code: 3, Message: "execution reverted: not owner"}
+	})
+	e := m.eth(t)
+
+	contr, err := e.NewContract(simulateTestABI, "0x2000000000000000000000000000000000000002")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = contr.CallWithOpts(nil, "swap", big.NewInt(5))
+	var rev *RevertError
+	if !errors.As(err, &rev) || rev.Name != "InsufficientBalance" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	var rpcErr *codec.ErrorObject
+	if !errors.As(err, &rpcErr) || rpcErr.Code != 3 {
+		t.Fatalf("rpc error not wrapped %v", err)
+	}
+
+	_, err = e.EstimateGas(nil)
+	if !errors.As(err, &rev) || rev.Reason != "not owner" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestTransactionRevertReason(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	signer := eTypes.LatestSignerForChainID(big.NewInt(1))
+	tx, err := eTypes.SignNewTx(key, signer, &eTypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: gwei(1),
+		GasFeeCap: gwei(20),
+		Gas:       100000,
+		To:        &to,
+		Value:     big.NewInt(7),
+		Data:      []byte{1, 2, 3, 4},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status := eTypes.ReceiptStatusFailed
+	m := newMockServer(t)
+	m.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		return &eTypes.Receipt{Status: status, TxHash: tx.Hash(), BlockNumber: big.NewInt(100), Logs: []*eTypes.Log{}}, nil
+	})
+	m.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		return tx, nil
+	})
+	var msg map[string]string
+	var block string
+	m.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		json.Unmarshal(params[0], &msg)
+		json.Unmarshal(params[1], &block)
+		return nil, &codec.ErrorObject{Code: 3, Message: "execution reverted", Data: hexutil.Encode(packRevert(t, "Panic(uint256)", []string{"uint256"}, big.NewInt(1)))}
+	})
+	e := m.eth(t)
+
+	rev, err := e.TransactionRevertReason(tx.Hash(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rev.IsPanic() || rev.Reason != "assert(false) (0x1)" {
+		t.Fatalf("unexpected revert %+v", rev)
+	}
+	if block != "0x63" || msg["from"] != strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex()) ||
+		msg["data"] != "0x01020304" || msg["value"] != "0x7" || msg["gas"] != "0x186a0" {
+		t.Fatalf("unexpected replay %v at %s", msg, block)
+	}
+
+	status = eTypes.ReceiptStatusSuccessful
+	if _, err := e.TransactionRevertReason(tx.Hash(), nil); !errors.Is(err, ErrTxNotReverted) {
+		t.Fatalf("expected ErrTxNotReverted, got %v", err)
+	}
+}
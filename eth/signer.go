@@ -0,0 +1,112 @@
+package eth
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Signer signs hashes, transactions and messages on behalf of an account.
+// Implementations may keep the key in process (LocalSigner) or delegate to
+// a keystore, KMS or remote signer.
+type Signer interface {
+	// Address returns the account address of the signer
+	Address() common.Address
+	// SignHash signs a 32 bytes hash, V of the signature is 0 or 1
+	SignHash(hash []byte) ([]byte, error)
+	// SignTx signs the transaction for the chain id
+	SignTx(tx *eTypes.Transaction, chainId *big.Int) (*eTypes.Transaction, error)
+	// SignText signs keccak256("\x19Ethereum Signed Message:\n"${message length}${message}),
+	// V of the signature is 27 or 28
+	SignText(data []byte) ([]byte, error)
+	// SignTypedData signs EIP-712 conformant typed data, V of the signature is 27 or 28
+	SignTypedData(data apitypes.TypedData) ([]byte, error)
+}
+
+// LocalSigner is a Signer backed by an in-memory private key
+type LocalSigner struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+// Create a local signer with private key
+func NewLocalSigner(privateKey *ecdsa.PrivateKey) *LocalSigner {
+	return &LocalSigner{
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+}
+
+// Create a local signer with private key (hex format)
+func NewLocalSignerFromHex(privateKey string) (*LocalSigner, error) {
+	if len(privateKey) == 0 {
+		return nil, fmt.Errorf("private key is empty")
+	}
+	privKey, err := crypto.HexToECDSA(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalSigner(privKey), nil
+}
+
+func (s *LocalSigner) Address() common.Address {
+	return s.address
+}
+
+func (s *LocalSigner) PrivateKey() *ecdsa.PrivateKey {
+	return s.privateKey
+}
+
+func (s *LocalSigner) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.privateKey)
+}
+
+func (s *LocalSigner) SignTx(tx *eTypes.Transaction, chainId *big.Int) (*eTypes.Transaction, error) {
+	return eTypes.SignTx(tx, eTypes.LatestSignerForChainID(chainId), s.privateKey)
+}
+
+func (s *LocalSigner) SignText(data []byte) ([]byte, error) {
+	return signHashWithV27(s, accounts.TextHash(data))
+}
+
+func (s *LocalSigner) SignTypedData(data apitypes.TypedData) ([]byte, error) {
+	sighash, err := typedDataHash(data)
+	if err != nil {
+		return nil, err
+	}
+	return signHashWithV27(s, sighash)
+}
+
+// signHashWithV27 signs the hash and transforms V from 0/1 to 27/28
+func signHashWithV27(s Signer, hash []byte) ([]byte, error) {
+	signature, err := s.SignHash(hash)
+	if err != nil {
+		return nil, err
+	}
+	if signature[64] == 0 || signature[64] == 1 {
+		signature[64] += 27 // Transform V from 0/1 to 27/28 according to the yellow paper
+	}
+	return signature, nil
+}
+
+// typedDataHash computes keccak256("\x19\x01${domainSeparator}${hashStruct(message)}")
+func typedDataHash(data apitypes.TypedData) ([]byte, error) {
+	domainSeparator, err := data.HashStruct("EIP712Domain", data.Domain.Map())
+	if err != nil {
+		return nil, err
+	}
+
+	typedDataHash, err := data.HashStruct(data.PrimaryType, data.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	rawData := []byte(fmt.Sprintf("\x19\x01%s%s", string(domainSeparator), string(typedDataHash)))
+	return crypto.Keccak256(rawData), nil
+}
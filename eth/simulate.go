@@ -0,0 +1,225 @@
+package eth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// SimulateCall is a call of a simulated block, unset fields are filled by the node
+type SimulateCall struct {
+	From                 common.Address
+	To                   *common.Address
+	Gas                  uint64
+	GasPrice             *big.Int
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+	Value                *big.Int
+	Nonce                *uint64
+	Data                 []byte
+
+	// contract and method decode the result of calls built by Contract.SimulateCall
+	contract *Contract
+	method   string
+}
+
+func (c *SimulateCall) MarshalJSON() ([]byte, error) {
+	type call struct {
+		From                 common.Address  `json:"from"`
+		To                   *common.Address `json:"to,omitempty"`
+		Gas                  *hexutil.Uint64 `json:"gas,omitempty"`
+		GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+		MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+		MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+		Value                *hexutil.Big    `json:"value,omitempty"`
+		Nonce                *hexutil.Uint64 `json:"nonce,omitempty"`
+		Input                hexutil.Bytes   `json:"input,omitempty"`
+	}
+	out := call{
+		From:                 c.From,
+		To:                   c.To,
+		GasPrice:             (*hexutil.Big)(c.GasPrice),
+		MaxFeePerGas:         (*hexutil.Big)(c.MaxFeePerGas),
+		MaxPriorityFeePerGas: (*hexutil.Big)(c.MaxPriorityFeePerGas),
+		Value:                (*hexutil.Big)(c.Value),
+		Nonce:                (*hexutil.Uint64)(c.Nonce),
+		Input:                c.Data,
+	}
+	if c.Gas != 0 {
+		out.Gas = (*hexutil.Uint64)(&c.Gas)
+	}
+	return json.Marshal(&out)
+}
+
+// SimulateBlock is a block of eth_simulateV1, its calls are executed in order on top of
+// the state left by the previous blocks
+type SimulateBlock struct {
+	BlockOverrides *types.BlockOverrides `json:"blockOverrides,omitempty"`
+	StateOverrides types.StateOverride   `json:"stateOverrides,omitempty"`
+	Calls          []*SimulateCall       `json:"calls"`
+}
+
+// SimulateOpts are the options of eth_simulateV1.
+// Validation enables nonce, balance and fee checks like a real block,
+// TraceTransfers adds ERC20-like Transfer logs for ether transfers from the 0xeeee...eeee address.
+type SimulateOpts struct {
+	BlockStateCalls        []*SimulateBlock `json:"blockStateCalls"`
+	TraceTransfers         bool             `json:"traceTransfers,omitempty"`
+	Validation             bool             `json:"validation,omitempty"`
+	ReturnFullTransactions bool             `json:"returnFullTransactions,omitempty"`
+}
+
+// SimulateCallError is the error of a failed simulated call, Data is the revert data of reverts
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimulateCallResult is the result of a simulated call
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*eTypes.Log      `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *SimulateCallError `json:"error,omitempty"`
+
+	call *SimulateCall
+}
+
+// Success reports whether the call succeeded
+func (r *SimulateCallResult) Success() bool {
+	return r.Status == hexutil.Uint64(eTypes.ReceiptStatusSuccessful)
+}
+
+// RevertData returns the revert data of a reverted call
+func (r *SimulateCallResult) RevertData() []byte {
+	if r.Error != nil && r.Error.Data != "" {
+		if data, err := hexutil.Decode(r.Error.Data); err == nil {
+			return data
+		}
+	}
+	if !r.Success() {
+		return r.ReturnData
+	}
+	return nil
+}
+
+// Err returns the error of a failed call, a *RevertError decoded through the ABI
+// of the contract of the call for reverts, nil if the call succeeded
+func (r *SimulateCallResult) Err() error {
+	if r.Success() {
+		return nil
+	}
+	msg := "execution reverted"
+	if r.Error != nil && r.Error.Message != "" {
+		msg = r.Error.Message
+	}
+	data := r.RevertData()
+	if len(data) == 0 {
+		return errors.New(msg)
+	}
+	var errs map[string]abi.Error
+	if r.call != nil && r.call.contract != nil {
+		errs = r.call.contract.abi.Errors
+	}
+	return decodeRevert(errs, data)
+}
+
+// Decode unpacks the return data with the method of calls built by Contract.SimulateCall
+func (r *SimulateCallResult) Decode() ([]interface{}, error) {
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	if r.call == nil || r.call.contract == nil {
+		return nil, errors.New("no contract abi to decode the call result")
+	}
+	return r.call.contract.abi.Unpack(r.call.method, r.ReturnData)
+}
+
+// SimulatedBlock is a block of the eth_simulateV1 result
+type SimulatedBlock struct {
+	Number        *hexutil.Big          `json:"number"`
+	Hash          common.Hash           `json:"hash"`
+	ParentHash    common.Hash           `json:"parentHash"`
+	Timestamp     hexutil.Uint64        `json:"timestamp"`
+	GasLimit      hexutil.Uint64        `json:"gasLimit"`
+	GasUsed       hexutil.Uint64        `json:"gasUsed"`
+	Miner         common.Address        `json:"miner"`
+	BaseFeePerGas *hexutil.Big          `json:"baseFeePerGas,omitempty"`
+	Transactions  []json.RawMessage     `json:"transactions"`
+	Calls         []*SimulateCallResult `json:"calls"`
+}
+
+// Simulate executes the blocks of opts on top of block with eth_simulateV1
+func (e *Eth) Simulate(opts *SimulateOpts, block *big.Int) ([]*SimulatedBlock, error) {
+	return e.SimulateAt(opts, types.BlockNumberOrHashWithNumber(block))
+}
+
+// SimulateAt executes the blocks of opts on top of a block selected by number, tag or hash.
+// The node fills gaps between the block numbers of opts with empty blocks, they are returned
+// in order with the simulated blocks.
+func (e *Eth) SimulateAt(opts *SimulateOpts, block types.BlockNumberOrHash) ([]*SimulatedBlock, error) {
+	if opts == nil || len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("no blocks to simulate")
+	}
+	var out []*SimulatedBlock
+	if err := e.c.Call("eth_simulateV1", &out, opts, block); err != nil {
+		return nil, err
+	}
+	i := 0
+	for _, b := range out {
+		if i == len(opts.BlockStateCalls) || !simulatedBlockOf(b, opts.BlockStateCalls[i]) {
+			if len(b.Calls) > 0 {
+				return nil, fmt.Errorf("simulated block %v with %d calls was not requested", (*big.Int)(b.Number), len(b.Calls))
+			}
+			continue
+		}
+		calls := opts.BlockStateCalls[i].Calls
+		if len(b.Calls) != len(calls) {
+			return nil, fmt.Errorf("simulated %d calls in block %d, want %d", len(b.Calls), i, len(calls))
+		}
+		for j, r := range b.Calls {
+			r.call = calls[j]
+		}
+		i++
+	}
+	if i != len(opts.BlockStateCalls) {
+		return nil, fmt.Errorf("simulated %d blocks, want %d", i, len(opts.BlockStateCalls))
+	}
+	return out, nil
+}
+
+// simulatedBlockOf reports whether b is the simulation of the requested block, the
+// empty blocks filling gaps come before blocks with a number override
+func simulatedBlockOf(b *SimulatedBlock, requested *SimulateBlock) bool {
+	if requested.BlockOverrides == nil || requested.BlockOverrides.Number == nil {
+		return true
+	}
+	return b.Number != nil && b.Number.ToInt().Cmp(requested.BlockOverrides.Number.ToInt()) == 0
+}
+
+// SimulateCall builds a call of the method for Eth.Simulate, its result is decoded
+// with the contract abi by SimulateCallResult.Decode
+func (c *Contract) SimulateCall(from common.Address, value *big.Int, methodName string, args ...interface{}) (*SimulateCall, error) {
+	data, err := c.EncodeABI(methodName, args...)
+	if err != nil {
+		return nil, err
+	}
+	to := c.addr
+	return &SimulateCall{
+		From:     from,
+		To:       &to,
+		Value:    value,
+		Data:     data,
+		contract: c,
+		method:   methodName,
+	}, nil
+}
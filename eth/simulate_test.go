@@ -0,0 +1,208 @@
+package eth
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const simulateTestABI = `[
+	{"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"name":"approve","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"name":"amount","type":"uint256"}],"name":"swap","outputs":[{"name":"out","type":"uint256"}],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}],"name":"InsufficientBalance","type":"error"}
+]`
+
+func TestSimulate(t *testing.T) {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	token := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	contr, err := NewContract(simulateTestABI, token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	uint256, _ := abi.NewType("uint256", "", nil)
+	customErr, err := abi.Arguments{{Type: uint256}, {Type: uint256}}.Pack(big.NewInt(1), big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	customErr = append(crypto.Keccak256([]byte("InsufficientBalance(uint256,uint256)"))[:4], customErr...)
+
+	m := newMockServer(t)
+	var sent struct {
+		BlockStateCalls []struct {
+			BlockOverrides map[string]interface{} `json:"blockOverrides"`
+			StateOverrides types.StateOverride    `json:"stateOverrides"`
+			Calls          []map[string]string    `json:"calls"`
+		} `json:"blockStateCalls"`
+		TraceTransfers bool `json:"traceTransfers"`
+		Validation     bool `json:"validation"`
+	}
+	var tag string
+	m.handle("eth_simulateV1", func(params []json.RawMessage) (interface{}, error) {
+		if err := json.Unmarshal(params[0], &sent); err != nil {
+			return nil, err
+		}
+		json.Unmarshal(params[1], &tag)
+		return []interface{}{
+			map[string]interface{}{
+				"number":    "0x65",
+				"timestamp": "0x10",
+				"gasLimit":  "0x1c9c380",
+				"gasUsed":   "0x1d4c0",
+				"calls": []interface{}{
+					map[string]interface{}{
+						"returnData": "0x" + strings.Repeat("0", 63) + "1",
+						"gasUsed":    "0xb5e0",
+						"status":     "0x1",
+						"logs": []interface{}{map[string]interface{}{
+							"address":         token,
+							"topics":          []common.Hash{crypto.Keccak256Hash([]byte("Approval(address,address,uint256)"))},
+							"data":            "0x",
+							"blockNumber":     "0x65",
+							"transactionHash": common.Hash{1},
+							"logIndex":        "0x0",
+						}},
+					},
+					map[string]interface{}{
+						"returnData": hexutil.Bytes(customErr),
+						"gasUsed":    "0x5208",
+						"status":     "0x0",
+						"logs":       []interface{}{},
+						"error":      map[string]interface{}{"code": 3, "message": "execution reverted", "data": hexutil.Bytes(customErr)},
+					},
+				},
+			},
+		}, nil
+	})
+	e := m.eth(t)
+
+	approve, err := contr.SimulateCall(from, nil, "approve", from, big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	swap, err := contr.SimulateCall(from, nil, "swap", big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	number := hexutil.Big(*big.NewInt(101))
+	blocks, err := e.Simulate(&SimulateOpts{
+		BlockStateCalls: []*SimulateBlock{{
+			BlockOverrides: &types.BlockOverrides{Number: &number, Coinbase: &from},
+			StateOverrides: types.StateOverride{}.SetBalance(from, big.NewInt(1e18)),
+			Calls:          []*SimulateCall{approve, swap},
+		}},
+		TraceTransfers: true,
+		Validation:     true,
+	}, big.NewInt(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tag != "0x64" || !sent.TraceTransfers || !sent.Validation || len(sent.BlockStateCalls) != 1 {
+		t.Fatalf("unexpected params %+v %s", sent, tag)
+	}
+	blockCalls := sent.BlockStateCalls[0]
+	if blockCalls.BlockOverrides["feeRecipient"] != strings.ToLower(from.Hex()) || blockCalls.StateOverrides[from].Balance == nil {
+		t.Fatalf("unexpected overrides %+v", blockCalls)
+	}
+	if len(blockCalls.Calls) != 2 || blockCalls.Calls[0]["input"] != hexutil.Encode(approve.Data) || blockCalls.Calls[0]["to"] != strings.ToLower(token.Hex()) {
+		t.Fatalf("unexpected calls %+v", blockCalls.Calls)
+	}
+	if _, ok := blockCalls.Calls[0]["gas"]; ok {
+		t.Fatal("unset gas must be left to the node")
+	}
+
+	if len(blocks) != 1 || blocks[0].Number.ToInt().Int64() != 101 || len(blocks[0].Calls) != 2 {
+		t.Fatalf("unexpected blocks %+v", blocks)
+	}
+	approved := blocks[0].Calls[0]
+	ret, err := approved.Decode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret[0] != true || len(approved.Logs) != 1 || approved.Logs[0].Address != token {
+		t.Fatalf("unexpected approve result %v %+v", ret, approved)
+	}
+
+	swapped := blocks[0].Calls[1]
+	if swapped.Success() {
+		t.Fatal("expected swap to fail")
+	}
+	if _, err := swapped.Decode(); err == nil || err.Error() != "execution reverted: InsufficientBalance(1, 5)" {
+		t.Fatalf("unexpected revert error %v", err)
+	}
+}
+
+func TestSimulateBlockGap(t *testing.T) {
+	from := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	token := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	contr, err := NewContract(simulateTestABI, token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// blocks 102 and 103 fill the gap between the requested blocks 101 and 104
+	m := newMockServer(t)
+	m.handle("eth_simulateV1", func(params []json.RawMessage) (interface{}, error) {
+		result := func(ret int64) []interface{} {
+			return []interface{}{map[string]interface{}{
+				"returnData": hexutil.Bytes(common.BigToHash(big.NewInt(ret)).Bytes()),
+				"gasUsed":    "0x5208",
+				"status":     "0x1",
+				"logs":       []interface{}{},
+			}}
+		}
+		return []interface{}{
+			map[string]interface{}{"number": "0x65", "calls": result(1)},
+			map[string]interface{}{"number": "0x66", "calls": []interface{}{}},
+			map[string]interface{}{"number": "0x67", "calls": []interface{}{}},
+			map[string]interface{}{"number": "0x68", "calls": result(7)},
+		}, nil
+	})
+	e := m.eth(t)
+
+	approve, err := contr.SimulateCall(from, nil, "approve", from, big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	swap, err := contr.SimulateCall(from, nil, "swap", big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, last := hexutil.Big(*big.NewInt(101)), hexutil.Big(*big.NewInt(104))
+	blocks, err := e.SimulateAt(&SimulateOpts{BlockStateCalls: []*SimulateBlock{
+		{BlockOverrides: &types.BlockOverrides{Number: &first}, Calls: []*SimulateCall{approve}},
+		{BlockOverrides: &types.BlockOverrides{Number: &last}, Calls: []*SimulateCall{swap}},
+	}}, types.BlockNumberOrHashWithTag(types.LatestBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blocks) != 4 || len(blocks[1].Calls) != 0 {
+		t.Fatalf("unexpected blocks %+v", blocks)
+	}
+	if ret, err := blocks[0].Calls[0].Decode(); err != nil || ret[0] != true {
+		t.Fatalf("unexpected approve result %v %v", ret, err)
+	}
+	if ret, err := blocks[3].Calls[0].Decode(); err != nil || ret[0].(*big.Int).Int64() != 7 {
+		t.Fatalf("unexpected swap result %v %v", ret, err)
+	}
+
+	// a block of calls that was not requested is rejected
+	m.handle("eth_simulateV1", func(params []json.RawMessage) (interface{}, error) {
+		return []interface{}{
+			map[string]interface{}{"number": "0x66", "calls": []interface{}{map[string]interface{}{"status": "0x1"}}},
+		}, nil
+	})
+	if _, err := e.SimulateAt(&SimulateOpts{BlockStateCalls: []*SimulateBlock{
+		{BlockOverrides: &types.BlockOverrides{Number: &first}, Calls: []*SimulateCall{approve}},
+	}}, types.BlockNumberOrHashWithTag(types.LatestBlock)); err == nil {
+		t.Fatal("expected error for an unexpected block")
+	}
+}
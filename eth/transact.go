@@ -0,0 +1,120 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var errUnboundContract = errors.New("contract is not bound to an Eth, create it with Eth.NewContract")
+
+// Tx is the handle of a sent contract tx. Receipt and Events are set once it is mined,
+// Events are the decoded logs of the contract in log order.
+type Tx struct {
+	Hash        common.Hash
+	Transaction *eTypes.Transaction
+	Receipt     *eTypes.Receipt
+	Events      []*EventLog
+
+	contract      *Contract
+	confirmations uint64
+}
+
+// Wait waits for the receipt with the confirmations of the tx options until the tx poll
+// timeout and decodes the contract events. Failed txs return the receipt and the revert
+// reason decoded with the contract errors.
+func (t *Tx) Wait() (*eTypes.Receipt, error) {
+	if t.Receipt != nil {
+		return t.Receipt, nil
+	}
+	receipt, err := t.contract.e.waitTx(t.Hash, t.confirmations, t.contract)
+	if err != nil {
+		return receipt, err
+	}
+	events := make([]*EventLog, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		if log.Address != t.contract.addr {
+			continue
+		}
+		event, err := t.contract.ParseLog(types.NewLog(log))
+		if errors.Is(err, ErrUnknownEvent) {
+			continue
+		}
+		if err != nil {
+			return receipt, fmt.Errorf("parse log %d of tx %v: %w", log.Index, t.Hash, err)
+		}
+		events = append(events, event)
+	}
+	t.Receipt, t.Events = receipt, events
+	return receipt, nil
+}
+
+// EventsByName returns the decoded events of the receipt named name
+func (t *Tx) EventsByName(name string) []*EventLog {
+	var events []*EventLog
+	for _, event := range t.Events {
+		if event.Name == name {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// Transact sends a tx calling methodName with args. The call is first run with eth_call on
+// the pending block so reverts are returned as a *RevertError decoded with the contract
+// errors before anything is sent, then the tx is filled as described by TxOpts, signed by
+// the account and sent.
+func (c *Contract) Transact(opts *TxOpts, methodName string, args ...interface{}) (*Tx, error) {
+	if c.e == nil {
+		return nil, errUnboundContract
+	}
+	if opts == nil {
+		opts = &TxOpts{}
+	}
+	data, err := c.EncodeABI(methodName, args...)
+	if err != nil {
+		return nil, err
+	}
+	method := c.Methods(methodName)
+	if opts.Value != nil && opts.Value.Sign() > 0 && !method.Payable && method.StateMutability != "payable" {
+		return nil, fmt.Errorf("method %v is not payable", methodName)
+	}
+
+	from := opts.From
+	if from == (common.Address{}) {
+		from = c.e.Address()
+	}
+	msg := &txMsg{From: from, To: &c.addr, Value: (*hexutil.Big)(opts.Value), Data: data}
+	var out hexutil.Bytes
+	// the pending state includes the txs sent before this one with lower nonces
+	if err := c.provider.Call("eth_call", &out, callParams(msg, types.BlockNumberOrHashWithTag(types.PendingBlock), nil, nil)...); err != nil {
+		return nil, c.wrapRevert(err)
+	}
+
+	tx, err := c.e.sendTx(opts, &c.addr, data)
+	if err != nil {
+		return nil, c.wrapRevert(err)
+	}
+	return &Tx{
+		Hash:          tx.Hash(),
+		Transaction:   tx,
+		contract:      c,
+		confirmations: opts.Confirmations,
+	}, nil
+}
+
+// TransactAndWait sends a tx like Transact and waits for its receipt like Tx.Wait
+func (c *Contract) TransactAndWait(opts *TxOpts, methodName string, args ...interface{}) (*Tx, error) {
+	tx, err := c.Transact(opts, methodName, args...)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.Wait(); err != nil {
+		return tx, err
+	}
+	return tx, nil
+}
@@ -0,0 +1,107 @@
+package eth
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const transactTestABI = `[
+	{"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[],"name":"deposit","outputs":[],"stateMutability":"payable","type":"function"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"},
+	{"inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}],"name":"InsufficientBalance","type":"error"}
+]`
+
+func TestTransact(t *testing.T) {
+	chain, m, e := newMockTxChain(t)
+	e.SetGasOracle(NewFixedGasOracle(gwei(30), gwei(2)))
+	token := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	to := common.HexToAddress("0x3000000000000000000000000000000000000003")
+	transferID := crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+	var preflight struct {
+		From common.Address `json:"from"`
+		To   common.Address `json:"to"`
+	}
+	var preflightBlock string
+	m.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		if err := json.Unmarshal(params[0], &preflight); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(params[1], &preflightBlock); err != nil {
+			return nil, err
+		}
+		return hexutil.Encode(common.LeftPadBytes([]byte{1}, 32)), nil
+	})
+	// the transfer log of the token and a log of another contract
+	chain.logs = func(tx *eTypes.Transaction) []*eTypes.Log {
+		return []*eTypes.Log{
+			{
+				Address: token,
+				Topics:  []common.Hash{transferID, common.BytesToHash(e.Address().Bytes()), common.BytesToHash(to.Bytes())},
+				Data:    common.LeftPadBytes(big.NewInt(5).Bytes(), 32),
+			},
+			{Address: to, Topics: []common.Hash{transferID}},
+		}
+	}
+
+	contr, err := e.NewContract(transactTestABI, token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := contr.TransactAndWait(nil, "transfer", to, big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preflight.From != e.Address() || preflight.To != token || preflightBlock != "pending" {
+		t.Fatalf("unexpected preflight %+v", preflight)
+	}
+	sent := chain.sent[0]
+	if *sent.To() != token || sent.Gas() != 300000 || tx.Hash != sent.Hash() || tx.Receipt == nil {
+		t.Fatalf("unexpected tx %+v", sent)
+	}
+	if len(tx.Events) != 1 || tx.Events[0].Args["value"].(*big.Int).Int64() != 5 || len(tx.EventsByName("Transfer")) != 1 {
+		t.Fatalf("unexpected events %+v", tx.Events)
+	}
+
+	if _, err := contr.Transact(&TxOpts{Value: big.NewInt(1)}, "transfer", to, big.NewInt(5)); err == nil {
+		t.Fatal("expected error for value sent to a non payable method")
+	}
+	nonce := uint64(9)
+	tx, err = contr.Transact(&TxOpts{Value: big.NewInt(1), Nonce: &nonce}, "deposit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.Transaction.Value().Int64() != 1 || tx.Transaction.Nonce() != 9 || tx.Receipt != nil {
+		t.Fatalf("unexpected tx %+v", tx.Transaction)
+	}
+
+	// reverts are caught by the preflight call and nothing is sent
+	revert := packRevert(t, "InsufficientBalance(uint256,uint256)", []string{"uint256", "uint256"}, big.NewInt(1), big.NewInt(5))
+	m.handle("eth_call", func(params []json.RawMessage) (interface{}, error) {
+		return nil, &codec.ErrorObject{Code: 3, Message: "execution reverted", Data: hexutil.Encode(revert)}
+	})
+	var rev *RevertError
+	if _, err := contr.Transact(nil, "transfer", to, big.NewInt(5)); !errors.As(err, &rev) || rev.Name != "InsufficientBalance" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(chain.sent) != 2 {
+		t.Fatalf("reverting tx sent")
+	}
+
+	unbound, err := NewContract(transactTestABI, token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := unbound.Transact(nil, "deposit"); err != errUnboundContract {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
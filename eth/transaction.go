@@ -1,9 +1,9 @@
 package eth
 
 import (
+	"context"
 	"fmt"
 	"math/big"
-	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -21,6 +21,10 @@ func (e *Eth) NewEIP1559Tx(
 	data []byte,
 	nonce uint64,
 ) (*eTypes.Transaction, error) {
+	gasTipCap, gasFeeCap, err := e.fillFeeCaps(gasTipCap, gasFeeCap)
+	if err != nil {
+		return nil, err
+	}
 
 	dynamicFeeTx := &eTypes.DynamicFeeTx{
 
@@ -32,19 +36,15 @@ func (e *Eth) NewEIP1559Tx(
 		Value:     amount,
 		Data:      data,
 	}
-	if e.chainId != nil {
-		dynamicFeeTx.ChainID = e.chainId
+	if chainId := e.GetChainId(); chainId != nil {
+		dynamicFeeTx.ChainID = chainId
 	}
 
-	if e.privateKey == nil {
+	if e.Signer() == nil {
 		return eTypes.NewTx(dynamicFeeTx), nil
 	}
 
-	signedTx, err := eTypes.SignNewTx(
-		e.privateKey,
-		eTypes.LatestSignerForChainID(e.chainId),
-		dynamicFeeTx,
-	)
+	signedTx, err := e.signTx(eTypes.NewTx(dynamicFeeTx))
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +61,10 @@ func (e *Eth) SendRawEIP1559Transaction(
 	data []byte,
 ) (common.Hash, error) {
 	var hash common.Hash
+	gasTipCap, gasFeeCap, err := e.fillFeeCaps(gasTipCap, gasFeeCap)
+	if err != nil {
+		return hash, err
+	}
 	dynamicFeeTx := &eTypes.DynamicFeeTx{
 		Nonce:     nonce,
 		GasTipCap: gasTipCap,
@@ -71,7 +75,7 @@ func (e *Eth) SendRawEIP1559Transaction(
 		Data:      data,
 	}
 
-	signedTx, err := eTypes.SignNewTx(e.privateKey, eTypes.LatestSignerForChainID(e.chainId), dynamicFeeTx)
+	signedTx, err := e.signTx(eTypes.NewTx(dynamicFeeTx))
 	if err != nil {
 		return hash, err
 	}
@@ -98,7 +102,7 @@ func (e *Eth) SendRawTransaction(
 
 	tx := eTypes.NewTransaction(nonce, to, amount, gasLimit, gasPrice, data)
 
-	signedTx, err := eTypes.SignTx(tx, eTypes.NewEIP155Signer(e.chainId), e.privateKey)
+	signedTx, err := e.signTx(tx)
 	if err != nil {
 		return hash, err
 	}
@@ -123,7 +127,7 @@ func (e *Eth) SyncSendRawTransaction(
 
 	tx := eTypes.NewTransaction(nonce, to, amount, gasLimit, gasPrice, data)
 
-	signedTx, err := eTypes.SignTx(tx, eTypes.NewEIP155Signer(e.chainId), e.privateKey)
+	signedTx, err := e.signTx(tx)
 	if err != nil {
 		return nil, err
 	}
@@ -137,39 +141,7 @@ func (e *Eth) SyncSendRawTransaction(
 		return nil, err
 	}
 
-	type ReceiptCh struct {
-		ret *eTypes.Receipt
-		err error
-	}
-
-	var timeoutFlag int32
-	ch := make(chan *ReceiptCh, 1)
-
-	go func() {
-		for {
-			time.Sleep(time.Second)
-			receipt, _ := e.GetTransactionReceipt(hash)
-			if receipt != nil {
-				ch <- &ReceiptCh{
-					ret: receipt,
-					err: nil,
-				}
-				break
-			}
-			if atomic.LoadInt32(&timeoutFlag) == 1 {
-				break
-			}
-		}
-	}()
-
-	select {
-	case result := <-ch:
-		return result.ret, result.err
-	case <-time.After(time.Duration(e.txPollTimeout) * time.Second):
-		atomic.StoreInt32(&timeoutFlag, 1)
-		return nil, fmt.Errorf("Transaction was not mined within %v seconds, "+
-			"please make sure your transaction was properly sent. Be aware that it might still be mined!", e.txPollTimeout)
-	}
+	return e.waitMinedWithTimeout(hash, 0)
 }
 
 func (e *Eth) SyncSendEIP1559RawTransaction(
@@ -181,6 +153,10 @@ func (e *Eth) SyncSendEIP1559RawTransaction(
 	gasFeeCap *big.Int,
 	data []byte,
 ) (*eTypes.Receipt, error) {
+	gasTipCap, gasFeeCap, err := e.fillFeeCaps(gasTipCap, gasFeeCap)
+	if err != nil {
+		return nil, err
+	}
 
 	dynamicFeeTx := &eTypes.DynamicFeeTx{
 		Nonce:     nonce,
@@ -192,7 +168,7 @@ func (e *Eth) SyncSendEIP1559RawTransaction(
 		Data:      data,
 	}
 
-	signedTx, err := eTypes.SignNewTx(e.privateKey, eTypes.LatestSignerForChainID(e.chainId), dynamicFeeTx)
+	signedTx, err := e.signTx(eTypes.NewTx(dynamicFeeTx))
 	if err != nil {
 		return nil, err
 	}
@@ -207,37 +183,19 @@ func (e *Eth) SyncSendEIP1559RawTransaction(
 		return nil, err
 	}
 
-	type ReceiptCh struct {
-		ret *eTypes.Receipt
-		err error
-	}
-
-	var timeoutFlag int32
-	ch := make(chan *ReceiptCh, 1)
-
-	go func() {
-		for {
-			time.Sleep(time.Second)
-			receipt, _ := e.GetTransactionReceipt(hash)
-			if receipt != nil {
-				ch <- &ReceiptCh{
-					ret: receipt,
-					err: nil,
-				}
-				break
-			}
-			if atomic.LoadInt32(&timeoutFlag) == 1 {
-				break
-			}
-		}
-	}()
-
-	select {
-	case result := <-ch:
-		return result.ret, result.err
-	case <-time.After(time.Duration(e.txPollTimeout) * time.Second):
-		atomic.StoreInt32(&timeoutFlag, 1)
+	return e.waitMinedWithTimeout(hash, 0)
+}
+
+// waitMinedWithTimeout waits for the receipt of hash with confirmations until tx poll timeout
+func (e *Eth) waitMinedWithTimeout(hash common.Hash, confirmations uint64) (*eTypes.Receipt, error) {
+	timeout := e.TxPollTimeout()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	receipt, err := e.WaitMined(ctx, hash, confirmations)
+	if err == context.DeadlineExceeded {
 		return nil, fmt.Errorf("Transaction was not mined within %v seconds, "+
-			"please make sure your transaction was properly sent. Be aware that it might still be mined!", e.txPollTimeout)
+			"please make sure your transaction was properly sent. Be aware that it might still be mined!", timeout)
 	}
+	return receipt, err
 }
@@ -2,8 +2,37 @@ package eth
 
 import "math/big"
 
+// FeeMode is the pricing mode of a chain
+type FeeMode int
+
+const (
+	// FeeModeLondon prices transactions with EIP-1559 base fee and priority fee
+	FeeModeLondon FeeMode = iota
+	// FeeModeLegacy prices transactions with a single gas price
+	FeeModeLegacy
+)
+
+func (m FeeMode) String() string {
+	switch m {
+	case FeeModeLondon:
+		return "london"
+	case FeeModeLegacy:
+		return "legacy"
+	}
+	return "unknown"
+}
+
+// EstimateFee is a fee suggestion. In FeeModeLegacy BaseFee is nil, GasPrice is
+// set and MaxFeePerGas and MaxPriorityFeePerGas are both equal to GasPrice.
 type EstimateFee struct {
+	Mode                 FeeMode
 	BaseFee              *big.Int
 	MaxFeePerGas         *big.Int
 	MaxPriorityFeePerGas *big.Int
+	GasPrice             *big.Int
+}
+
+// IsLegacy reports whether the chain has no EIP-1559 and legacy transactions should be sent
+func (f *EstimateFee) IsLegacy() bool {
+	return f.Mode == FeeModeLegacy
 }
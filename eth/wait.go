@@ -0,0 +1,194 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/chenzhijie/go-web3/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrTxReplaced is returned by WaitMined when the sender's nonce has been
+	// consumed by a different transaction.
+	ErrTxReplaced = errors.New("transaction was replaced by another transaction with the same nonce")
+)
+
+const defaultTxPollInterval = time.Second
+
+type rpcTxSender struct {
+	From  common.Address `json:"from"`
+	Nonce hexutil.Uint64 `json:"nonce"`
+}
+
+type rpcBlockHash struct {
+	Hash common.Hash `json:"hash"`
+}
+
+// Setup interval for polling receipts and heads when subscriptions are unavailable
+func (e *Eth) SetTxPollInterval(interval time.Duration) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.txPollInterval = interval
+}
+
+// Get current interval for polling receipts and heads
+func (e *Eth) TxPollInterval() time.Duration {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.txPollInterval
+}
+
+// WaitMined waits until the transaction is included in the canonical chain with
+// at least `confirmations` blocks (including its own block) and returns the receipt.
+// New blocks are observed through newHeads subscriptions when the transport
+// supports them, otherwise the node is polled.
+// If the block holding the transaction is reorged out, waiting continues until the
+// transaction is included again. ErrTxReplaced is returned if the sender nonce is
+// used by another transaction.
+func (e *Eth) WaitMined(ctx context.Context, hash common.Hash, confirmations uint64) (*eTypes.Receipt, error) {
+	if confirmations == 0 {
+		confirmations = 1
+	}
+
+	heads, unsubscribe := e.watchHeads()
+	defer unsubscribe()
+
+	var sender *rpcTxSender
+	for {
+		receipt, err := e.GetTransactionReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if receipt != nil {
+			confirmed, err := e.isConfirmed(receipt, confirmations)
+			if err != nil {
+				return nil, err
+			}
+			if confirmed {
+				return receipt, nil
+			}
+		} else {
+			if sender == nil {
+				if err := e.c.Call("eth_getTransactionByHash", &sender, hash); err != nil {
+					return nil, err
+				}
+			}
+			if sender != nil {
+				replaced, err := e.isReplaced(hash, sender)
+				if err != nil {
+					return nil, err
+				}
+				if replaced {
+					return nil, ErrTxReplaced
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-heads:
+		}
+	}
+}
+
+// watchHeads returns a channel signalled for every new head.
+func (e *Eth) watchHeads() (<-chan struct{}, func()) {
+	return e.watchHeadsEvery(e.TxPollInterval())
+}
+
+// watchHeadsEvery is watchHeads polling at interval when subscriptions are unavailable.
+func (e *Eth) watchHeadsEvery(interval time.Duration) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	notify := func() {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+
+	if e.c.SubscriptionEnabled() {
+		cancel, err := e.c.Subscribe("newHeads", func(b []byte) {
+			notify()
+		})
+		if err == nil {
+			return ch, func() { cancel() }
+		}
+	}
+
+	if interval == 0 {
+		interval = defaultTxPollInterval
+	}
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				notify()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return ch, func() {
+		ticker.Stop()
+		close(done)
+	}
+}
+
+// isConfirmed checks the receipt block is still canonical and deep enough.
+func (e *Eth) isConfirmed(receipt *eTypes.Receipt, confirmations uint64) (bool, error) {
+	if receipt.BlockNumber == nil {
+		return false, nil
+	}
+	head, err := e.GetBlockNumber()
+	if err != nil {
+		return false, err
+	}
+	mined := receipt.BlockNumber.Uint64()
+	if head < mined || head-mined+1 < confirmations {
+		return false, nil
+	}
+
+	canonical, err := e.getBlockHash(receipt.BlockNumber)
+	if err != nil {
+		return false, err
+	}
+	return canonical != nil && *canonical == receipt.BlockHash, nil
+}
+
+// isReplaced reports whether the sender nonce has moved past the transaction
+// nonce while the transaction itself has no receipt.
+func (e *Eth) isReplaced(hash common.Hash, sender *rpcTxSender) (bool, error) {
+	nonce, err := e.GetNonce(sender.From, nil)
+	if err != nil {
+		return false, err
+	}
+	if nonce <= uint64(sender.Nonce) {
+		return false, nil
+	}
+	// The transaction may have been mined in between.
+	receipt, err := e.GetTransactionReceipt(hash)
+	if err != nil {
+		return false, err
+	}
+	return receipt == nil, nil
+}
+
+func (e *Eth) getBlockHash(number *big.Int) (*common.Hash, error) {
+	var b *rpcBlockHash
+	if err := e.c.Call("eth_getBlockByNumber", &b, utils.ToBlockNumArg(number), false); err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, nil
+	}
+	return &b.Hash, nil
+}
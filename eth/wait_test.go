@@ -0,0 +1,129 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	waitTxHash    = common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111")
+	waitBlockHash = common.HexToHash("0x2222222222222222222222222222222222222222222222222222222222222222")
+	waitReorgHash = common.HexToHash("0x3333333333333333333333333333333333333333333333333333333333333333")
+)
+
+func mockReceipt(blockHash common.Hash, blockNumber uint64) map[string]interface{} {
+	return map[string]interface{}{
+		"transactionHash":   waitTxHash,
+		"transactionIndex":  "0x0",
+		"blockHash":         blockHash,
+		"blockNumber":       hexutil.EncodeUint64(blockNumber),
+		"cumulativeGasUsed": "0x5208",
+		"gasUsed":           "0x5208",
+		"effectiveGasPrice": "0x1",
+		"logs":              []interface{}{},
+		"logsBloom":         hexutil.Encode(make([]byte, 256)),
+		"status":            "0x1",
+		"type":              "0x2",
+	}
+}
+
+func TestWaitMinedConfirmations(t *testing.T) {
+	m := newMockServer(t)
+	var head uint64 = 10
+	m.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		return mockReceipt(waitBlockHash, 10), nil
+	})
+	m.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return hexutil.EncodeUint64(atomic.AddUint64(&head, 1) - 1), nil
+	})
+	m.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"hash": waitBlockHash}, nil
+	})
+
+	e := m.eth(t)
+	e.SetTxPollInterval(time.Millisecond)
+	receipt, err := e.WaitMined(context.Background(), waitTxHash, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.BlockHash != waitBlockHash {
+		t.Fatalf("unexpected block hash %v", receipt.BlockHash)
+	}
+	if atomic.LoadUint64(&head) < 13 {
+		t.Fatalf("receipt returned before 3 confirmations, head %v", head)
+	}
+}
+
+func TestWaitMinedReorg(t *testing.T) {
+	m := newMockServer(t)
+	var receiptCalls int32
+	m.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		// first seen in a block that gets reorged out, then in the canonical one
+		if atomic.AddInt32(&receiptCalls, 1) <= 2 {
+			return mockReceipt(waitReorgHash, 10), nil
+		}
+		return mockReceipt(waitBlockHash, 11), nil
+	})
+	m.handle("eth_blockNumber", func(params []json.RawMessage) (interface{}, error) {
+		return "0xb", nil
+	})
+	m.handle("eth_getBlockByNumber", func(params []json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{"hash": waitBlockHash}, nil
+	})
+
+	e := m.eth(t)
+	e.SetTxPollInterval(time.Millisecond)
+	receipt, err := e.WaitMined(context.Background(), waitTxHash, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.BlockHash != waitBlockHash {
+		t.Fatalf("returned receipt from non canonical block %v", receipt.BlockHash)
+	}
+}
+
+func TestWaitMinedReplaced(t *testing.T) {
+	m := newMockServer(t)
+	m.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+	m.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{
+			"from":  "0x71c7656ec7ab88b098defb751b7401b5f6d8976f",
+			"nonce": "0x5",
+		}, nil
+	})
+	m.handle("eth_getTransactionCount", func(params []json.RawMessage) (interface{}, error) {
+		return "0x6", nil
+	})
+
+	e := m.eth(t)
+	e.SetTxPollInterval(time.Millisecond)
+	if _, err := e.WaitMined(context.Background(), waitTxHash, 1); err != ErrTxReplaced {
+		t.Fatalf("expected ErrTxReplaced, got %v", err)
+	}
+}
+
+func TestWaitMinedTimeout(t *testing.T) {
+	m := newMockServer(t)
+	m.handle("eth_getTransactionReceipt", func(params []json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+	m.handle("eth_getTransactionByHash", func(params []json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+
+	e := m.eth(t)
+	e.SetTxPollInterval(time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := e.WaitMined(ctx, waitTxHash, 1); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
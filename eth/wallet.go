@@ -0,0 +1,201 @@
+package eth
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Wallet is a registry of signers keyed by address, safe for concurrent use
+type Wallet struct {
+	lock    sync.RWMutex
+	signers map[common.Address]Signer
+}
+
+// Create an empty wallet
+func NewWallet() *Wallet {
+	return &Wallet{
+		signers: make(map[common.Address]Signer),
+	}
+}
+
+// Add adds or replaces the signer of its address
+func (w *Wallet) Add(signer Signer) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.signers[signer.Address()] = signer
+}
+
+// Remove removes the signer of address
+func (w *Wallet) Remove(addr common.Address) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	delete(w.signers, addr)
+}
+
+// Get returns the signer of address
+func (w *Wallet) Get(addr common.Address) (Signer, bool) {
+	w.lock.RLock()
+	defer w.lock.RUnlock()
+	s, ok := w.signers[addr]
+	return s, ok
+}
+
+// Accounts returns all addresses in the wallet sorted in ascending order
+func (w *Wallet) Accounts() []common.Address {
+	w.lock.RLock()
+	addrs := make([]common.Address, 0, len(w.signers))
+	for addr := range w.signers {
+		addrs = append(addrs, addr)
+	}
+	w.lock.RUnlock()
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	return addrs
+}
+
+// Get the wallet holding all signers of this instance
+func (e *Eth) Wallet() *Wallet {
+	return e.wallet
+}
+
+// AddSigner adds a signer to the wallet without changing the default account
+func (e *Eth) AddSigner(signer Signer) {
+	e.wallet.Add(signer)
+}
+
+// WithAccount returns a view of Eth whose default account is addr.
+// The view shares the rpc client and wallet with e and is not affected by
+// later SetAccount/SetSigner calls on e, so each goroutine can hold its own view.
+func (e *Eth) WithAccount(addr common.Address) (*Eth, error) {
+	signer, ok := e.wallet.Get(addr)
+	if !ok {
+		return nil, fmt.Errorf("account %v not found in wallet", addr)
+	}
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return &Eth{
+		c:              e.c,
+		signer:         signer,
+		wallet:         e.wallet,
+		gasOracle:      e.gasOracle,
+		chainId:        e.chainId,
+		txPollTimeout:  e.txPollTimeout,
+		txPollInterval: e.txPollInterval,
+		utils:          e.utils,
+	}, nil
+}
+
+// SignTextFrom signs raw text message with the account from
+func (e *Eth) SignTextFrom(from common.Address, data []byte) ([]byte, error) {
+	view, err := e.WithAccount(from)
+	if err != nil {
+		return nil, err
+	}
+	return view.SignText(data)
+}
+
+// EcSignFrom signs raw hash message with the account from
+func (e *Eth) EcSignFrom(from common.Address, hashData []byte) ([]byte, error) {
+	view, err := e.WithAccount(from)
+	if err != nil {
+		return nil, err
+	}
+	return view.EcSign(hashData)
+}
+
+// SignTypedDataFrom signs EIP-712 conformant typed data with the account from
+func (e *Eth) SignTypedDataFrom(from common.Address, data apitypes.TypedData) ([]byte, error) {
+	view, err := e.WithAccount(from)
+	if err != nil {
+		return nil, err
+	}
+	return view.SignTypedData(data)
+}
+
+// SignTxFrom signs the transaction with the account from for current chain
+func (e *Eth) SignTxFrom(from common.Address, tx *eTypes.Transaction) (*eTypes.Transaction, error) {
+	view, err := e.WithAccount(from)
+	if err != nil {
+		return nil, err
+	}
+	return view.signTx(tx)
+}
+
+// SendRawTransactionFrom signs and sends a legacy transaction with the account from
+func (e *Eth) SendRawTransactionFrom(
+	from common.Address,
+	to common.Address,
+	amount *big.Int,
+	nonce uint64,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	data []byte,
+) (common.Hash, error) {
+	view, err := e.WithAccount(from)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return view.SendRawTransaction(to, amount, nonce, gasLimit, gasPrice, data)
+}
+
+// SendRawEIP1559TransactionFrom signs and sends an EIP-1559 transaction with the account from
+func (e *Eth) SendRawEIP1559TransactionFrom(
+	from common.Address,
+	to common.Address,
+	amount *big.Int,
+	nonce uint64,
+	gasLimit uint64,
+	gasTipCap *big.Int,
+	gasFeeCap *big.Int,
+	data []byte,
+) (common.Hash, error) {
+	view, err := e.WithAccount(from)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return view.SendRawEIP1559Transaction(to, amount, nonce, gasLimit, gasTipCap, gasFeeCap, data)
+}
+
+// SyncSendRawTransactionFrom signs and sends a legacy transaction with the account from and waits for the receipt
+func (e *Eth) SyncSendRawTransactionFrom(
+	from common.Address,
+	to common.Address,
+	amount *big.Int,
+	nonce uint64,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	data []byte,
+) (*eTypes.Receipt, error) {
+	view, err := e.WithAccount(from)
+	if err != nil {
+		return nil, err
+	}
+	return view.SyncSendRawTransaction(to, amount, nonce, gasLimit, gasPrice, data)
+}
+
+// SyncSendEIP1559RawTransactionFrom signs and sends an EIP-1559 transaction with the account from and waits for the receipt
+func (e *Eth) SyncSendEIP1559RawTransactionFrom(
+	from common.Address,
+	to common.Address,
+	amount *big.Int,
+	nonce uint64,
+	gasLimit uint64,
+	gasTipCap *big.Int,
+	gasFeeCap *big.Int,
+	data []byte,
+) (*eTypes.Receipt, error) {
+	view, err := e.WithAccount(from)
+	if err != nil {
+		return nil, err
+	}
+	return view.SyncSendEIP1559RawTransaction(to, amount, nonce, gasLimit, gasTipCap, gasFeeCap, data)
+}
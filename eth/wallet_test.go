@@ -0,0 +1,131 @@
+package eth
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func newTestSigners(t *testing.T, n int) []*LocalSigner {
+	signers := make([]*LocalSigner, 0, n)
+	for i := 0; i < n; i++ {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		signers = append(signers, NewLocalSigner(key))
+	}
+	return signers
+}
+
+func TestWalletAccounts(t *testing.T) {
+	e := NewEth(nil)
+	signers := newTestSigners(t, 3)
+	e.SetSigner(signers[0])
+	e.AddSigner(signers[1])
+	e.AddSigner(signers[2])
+
+	if len(e.Wallet().Accounts()) != 3 {
+		t.Fatalf("unexpected accounts %v", e.Wallet().Accounts())
+	}
+	if e.Address() != signers[0].Address() {
+		t.Fatalf("default account changed to %v", e.Address())
+	}
+
+	e.Wallet().Remove(signers[2].Address())
+	if _, err := e.WithAccount(signers[2].Address()); err == nil {
+		t.Fatal("expected removed account to be missing")
+	}
+}
+
+func TestWithAccountConcurrent(t *testing.T) {
+	e := NewEth(nil)
+	e.SetChainId(1)
+	signers := newTestSigners(t, 8)
+	for _, s := range signers {
+		e.AddSigner(s)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(signers))
+	// the default account and settings change while views are created
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 20; i++ {
+			e.SetSigner(signers[i%len(signers)])
+			e.SetChainId(int64(i + 1))
+			e.SetGasOracle(NewFixedGasOracle(big.NewInt(int64(i+1)), big.NewInt(1)))
+			e.SetTxPollTimeout(i + 1)
+		}
+	}()
+	for _, s := range signers {
+		wg.Add(1)
+		go func(addr common.Address) {
+			defer wg.Done()
+			view, err := e.WithAccount(addr)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for i := 0; i < 20; i++ {
+				msg := []byte("hello")
+				sig, err := view.SignText(msg)
+				if err != nil {
+					errs <- err
+					return
+				}
+				sig[64] -= 27
+				pub, err := crypto.SigToPub(accounts.TextHash(msg), sig)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if crypto.PubkeyToAddress(*pub) != addr {
+					t.Errorf("view for %v signed with %v", addr, crypto.PubkeyToAddress(*pub))
+				}
+			}
+		}(s.Address())
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+}
+
+func TestSignTxFrom(t *testing.T) {
+	e := NewEth(nil)
+	e.SetChainId(1)
+	signers := newTestSigners(t, 2)
+	e.SetSigner(signers[0])
+	e.AddSigner(signers[1])
+
+	tx := eTypes.NewTx(&eTypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Gas:       21000,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		To:        &common.Address{},
+		Value:     big.NewInt(1),
+	})
+	signed, err := e.SignTxFrom(signers[1].Address(), tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	from, err := eTypes.Sender(eTypes.LatestSignerForChainID(big.NewInt(1)), signed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != signers[1].Address() {
+		t.Fatalf("signed by %v, expected %v", from, signers[1].Address())
+	}
+	if _, err := e.SignTxFrom(common.Address{}, tx); err == nil {
+		t.Fatal("expected unknown account error")
+	}
+}
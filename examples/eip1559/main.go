@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/chenzhijie/go-web3"
+	"github.com/chenzhijie/go-web3/eth"
 	"github.com/chenzhijie/go-web3/utils"
 )
 
@@ -22,7 +23,9 @@ func main() {
 		panic(err)
 	}
 	fmt.Println("Current block number: ", blockNumber)
-	fee, err := web3.Eth.EstimateFee()
+	// suggest fees from the standard percentile of recent priority fees
+	web3.Eth.SetGasOracle(eth.NewFeeHistoryGasOracle(web3.Eth, eth.UrgencyStandard))
+	fee, err := web3.Eth.SuggestFee()
 	if err != nil {
 		panic(err)
 	}
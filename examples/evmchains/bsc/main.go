@@ -15,11 +15,10 @@ func main() {
 	// change to your rpc provider
 	var chainId = int64(97)
 	var rpcProvider = "https://data-seed-prebsc-2-s3.binance.org:8545/"
-	web3, err := web3.NewWeb3WithProxy(rpcProvider, os.Getenv("http_proxy"))
+	web3, err := web3.NewWeb3WithProxy(rpcProvider, os.Getenv("http_proxy"), web3.WithChainId(chainId))
 	if err != nil {
 		panic(err)
 	}
-	web3.Eth.SetChainId(chainId)
 	blockNumber, err := web3.Eth.GetBlockNumber()
 	if err != nil {
 		panic(err)
@@ -14,11 +14,10 @@ func main() {
 	// change to your rpc provider
 	var chainId = int64(80001)
 	var rpcProvider = "https://matic-testnet-archive-rpc.bwarelabs.com"
-	web3, err := web3.NewWeb3(rpcProvider)
+	web3, err := web3.NewWeb3(rpcProvider, web3.WithChainId(chainId))
 	if err != nil {
 		panic(err)
 	}
-	web3.Eth.SetChainId(chainId)
 	blockNumber, err := web3.Eth.GetBlockNumber()
 	if err != nil {
 		panic(err)
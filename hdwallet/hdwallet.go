@@ -0,0 +1,247 @@
+package hdwallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/chenzhijie/go-web3/eth"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/ripemd160"
+)
+
+// HardenedKeyStart is the index of the first hardened child key
+const HardenedKeyStart = 0x80000000
+
+var (
+	// DefaultRootDerivationPath is m/44'/60'/0'/0, account i lives at m/44'/60'/0'/0/i
+	DefaultRootDerivationPath = accounts.DefaultRootDerivationPath
+
+	ErrInvalidSeedLength = errors.New("seed length must be between 128 and 512 bits")
+	ErrInvalidChild      = errors.New("derived key is invalid, use the next index")
+
+	masterKeySalt  = []byte("Bitcoin seed")
+	xprvVersion    = []byte{0x04, 0x88, 0xad, 0xe4}
+	secp256k1Order = crypto.S256().Params().N
+)
+
+// ExtendedKey is a BIP-32 extended private key
+type ExtendedKey struct {
+	key       []byte
+	chainCode []byte
+	depth     uint8
+	parentFP  []byte
+	childNum  uint32
+}
+
+// NewMaster creates the master extended key from seed
+func NewMaster(seed []byte) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, ErrInvalidSeedLength
+	}
+	mac := hmac.New(sha512.New, masterKeySalt)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	key := new(big.Int).SetBytes(sum[:32])
+	if key.Sign() == 0 || key.Cmp(secp256k1Order) >= 0 {
+		return nil, ErrInvalidChild
+	}
+	return &ExtendedKey{
+		key:       sum[:32],
+		chainCode: sum[32:],
+		parentFP:  []byte{0, 0, 0, 0},
+	}, nil
+}
+
+// Child derives the child extended key at index i,
+// indexes from HardenedKeyStart derive hardened children
+func (k *ExtendedKey) Child(i uint32) (*ExtendedKey, error) {
+	pubKey, err := k.compressedPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, 37)
+	if i >= HardenedKeyStart {
+		data = append(data, 0x0)
+		data = append(data, k.key...)
+	} else {
+		data = append(data, pubKey...)
+	}
+	data = binary.BigEndian.AppendUint32(data, i)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(secp256k1Order) >= 0 {
+		return nil, ErrInvalidChild
+	}
+	childKey := il.Add(il, new(big.Int).SetBytes(k.key))
+	childKey.Mod(childKey, secp256k1Order)
+	if childKey.Sign() == 0 {
+		return nil, ErrInvalidChild
+	}
+
+	return &ExtendedKey{
+		key:       childKey.FillBytes(make([]byte, 32)),
+		chainCode: sum[32:],
+		depth:     k.depth + 1,
+		parentFP:  hash160(pubKey)[:4],
+		childNum:  i,
+	}, nil
+}
+
+// Derive derives the extended key along the path from this key
+func (k *ExtendedKey) Derive(path accounts.DerivationPath) (*ExtendedKey, error) {
+	key := k
+	for _, i := range path {
+		var err error
+		key, err = key.Child(i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// PrivateKey returns the ecdsa private key of the extended key
+func (k *ExtendedKey) PrivateKey() (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(k.key)
+}
+
+// String returns the base58 serialized extended private key (xprv...)
+func (k *ExtendedKey) String() string {
+	data := make([]byte, 0, 82)
+	data = append(data, xprvVersion...)
+	data = append(data, k.depth)
+	data = append(data, k.parentFP...)
+	data = binary.BigEndian.AppendUint32(data, k.childNum)
+	data = append(data, k.chainCode...)
+	data = append(data, 0x0)
+	data = append(data, k.key...)
+
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return base58Encode(append(data, second[:4]...))
+}
+
+func (k *ExtendedKey) compressedPubKey() ([]byte, error) {
+	privateKey, err := k.PrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return crypto.CompressPubkey(&privateKey.PublicKey), nil
+}
+
+// Wallet derives account signers from a BIP-32 master key
+type Wallet struct {
+	master *ExtendedKey
+}
+
+// NewFromMnemonic creates a wallet from BIP-39 mnemonic and passphrase
+func NewFromMnemonic(mnemonic, passphrase string) (*Wallet, error) {
+	seed, err := NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromSeed(seed)
+}
+
+// NewFromSeed creates a wallet from seed
+func NewFromSeed(seed []byte) (*Wallet, error) {
+	master, err := NewMaster(seed)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{master: master}, nil
+}
+
+// MasterKey returns the master extended key of the wallet
+func (w *Wallet) MasterKey() *ExtendedKey {
+	return w.master
+}
+
+// Derive returns the signer at path such as "m/44'/60'/0'/0/1"
+func (w *Wallet) Derive(path string) (*eth.LocalSigner, error) {
+	p, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return w.DerivePath(p)
+}
+
+// DerivePath returns the signer at path
+func (w *Wallet) DerivePath(path accounts.DerivationPath) (*eth.LocalSigner, error) {
+	key, err := w.master.Derive(path)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := key.PrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return eth.NewLocalSigner(privateKey), nil
+}
+
+// Account returns the signer at m/44'/60'/0'/0/index
+func (w *Wallet) Account(index uint32) (*eth.LocalSigner, error) {
+	if index >= HardenedKeyStart {
+		return nil, fmt.Errorf("account index %d out of range", index)
+	}
+	path := make(accounts.DerivationPath, len(DefaultRootDerivationPath), len(DefaultRootDerivationPath)+1)
+	copy(path, DefaultRootDerivationPath)
+	return w.DerivePath(append(path, index))
+}
+
+// Accounts returns the signers of the first n accounts along m/44'/60'/0'/0/i
+func (w *Wallet) Accounts(n int) ([]*eth.LocalSigner, error) {
+	signers := make([]*eth.LocalSigner, 0, n)
+	for i := 0; i < n; i++ {
+		signer, err := w.Account(uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
+func hash160(data []byte) []byte {
+	sha := sha256.Sum256(data)
+	h := ripemd160.New()
+	h.Write(sha[:])
+	return h.Sum(nil)
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+func base58Encode(data []byte) string {
+	x := new(big.Int).SetBytes(data)
+	radix := big.NewInt(58)
+	mod := new(big.Int)
+
+	out := make([]byte, 0, len(data)*138/100+1)
+	for x.Sign() > 0 {
+		x.DivMod(x, radix, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for _, b := range data {
+		if b != 0 {
+			break
+		}
+		out = append(out, base58Alphabet[0])
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
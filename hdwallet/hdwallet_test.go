@@ -0,0 +1,142 @@
+package hdwallet
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BIP-39 vectors from https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+func TestMnemonicVectors(t *testing.T) {
+	vectors := []struct {
+		entropy  string
+		mnemonic string
+		seed     string
+	}{
+		{
+			"00000000000000000000000000000000",
+			"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+			"legal winner thank year wave sausage worth useful legal winner thank yellow",
+			"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+		},
+		{
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+			"dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad",
+		},
+	}
+	for _, v := range vectors {
+		entropy, _ := hex.DecodeString(v.entropy)
+		mnemonic, err := NewMnemonicFromEntropy(entropy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mnemonic != v.mnemonic {
+			t.Fatalf("mnemonic %q, expected %q", mnemonic, v.mnemonic)
+		}
+		decoded, err := MnemonicToEntropy(mnemonic)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(decoded) != v.entropy {
+			t.Fatalf("entropy %x, expected %s", decoded, v.entropy)
+		}
+		seed, err := NewSeedWithErrorChecking(mnemonic, "TREZOR")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(seed) != v.seed {
+			t.Fatalf("seed %x, expected %s", seed, v.seed)
+		}
+	}
+}
+
+func TestMnemonicValidation(t *testing.T) {
+	mnemonic, err := NewMnemonic(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsMnemonicValid(mnemonic) {
+		t.Fatalf("generated mnemonic %q is invalid", mnemonic)
+	}
+	if _, err := MnemonicToEntropy("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon"); err != ErrChecksumIncorrect {
+		t.Fatalf("expected checksum error, got %v", err)
+	}
+	if IsMnemonicValid("abandon abandon abandon") {
+		t.Fatal("short mnemonic is valid")
+	}
+	if IsMnemonicValid("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon web3") {
+		t.Fatal("mnemonic with unknown word is valid")
+	}
+	if _, err := NewMnemonic(100); err != ErrInvalidEntropyLength {
+		t.Fatalf("expected entropy length error, got %v", err)
+	}
+}
+
+// BIP-32 test vector 1 from https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki
+func TestBIP32Vector(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	master, err := NewMaster(seed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vectors := []struct {
+		path string
+		xprv string
+	}{
+		{"m", "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"},
+		{"m/0'", "xprv9uHRZZhk6KAJC1avXpDAp4MDc3sQKNxDiPvvkX8Br5ngLNv1TxvUxt4cV1rGL5hj6KCesnDYUhd7oWgT11eZG7XnxHrnYeSvkzY7d2bhkJ7"},
+		{"m/0'/1", "xprv9wTYmMFdV23N2TdNG573QoEsfRrWKQgWeibmLntzniatZvR9BmLnvSxqu53Kw1UmYPxLgboyZQaXwTCg8MSY3H2EU4pWcQDnRnrVA1xe8fs"},
+		{"m/0'/1/2'", "xprv9z4pot5VBttmtdRTWfWQmoH1taj2axGVzFqSb8C9xaxKymcFzXBDptWmT7FwuEzG3ryjH4ktypQSAewRiNMjANTtpgP4mLTj34bhnZX7UiM"},
+	}
+	for _, v := range vectors {
+		var path accounts.DerivationPath
+		if v.path != "m" {
+			path, err = accounts.ParseDerivationPath(v.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		key, err := master.Derive(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key.String() != v.xprv {
+			t.Fatalf("%s: got %s, expected %s", v.path, key.String(), v.xprv)
+		}
+	}
+}
+
+func TestWalletAccounts(t *testing.T) {
+	wallet, err := NewFromMnemonic("test test test test test test test test test test test junk", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signers, err := wallet.Accounts(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []common.Address{
+		common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+		common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+	}
+	for i, signer := range signers {
+		if signer.Address() != expected[i] {
+			t.Fatalf("account %d: got %v, expected %v", i, signer.Address(), expected[i])
+		}
+	}
+
+	signer, err := wallet.Derive("m/44'/60'/0'/0/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.Address() != expected[1] {
+		t.Fatalf("custom path: got %v, expected %v", signer.Address(), expected[1])
+	}
+}
@@ -0,0 +1,146 @@
+package hdwallet
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// english is the BIP-39 English wordlist
+// https://github.com/bitcoin/bips/blob/master/bip-0039/english.txt
+//
+//go:embed english.txt
+var english string
+
+var (
+	wordList  = strings.Split(strings.TrimSpace(english), "\n")
+	wordIndex = func() map[string]int {
+		m := make(map[string]int, len(wordList))
+		for i, w := range wordList {
+			m[w] = i
+		}
+		return m
+	}()
+)
+
+var (
+	ErrInvalidEntropyLength = errors.New("entropy length must be 128 to 256 bits and a multiple of 32")
+	ErrInvalidMnemonic      = errors.New("invalid mnemonic")
+	ErrChecksumIncorrect    = errors.New("mnemonic checksum incorrect")
+)
+
+// NewEntropy generates random entropy of bitSize bits for a mnemonic
+func NewEntropy(bitSize int) ([]byte, error) {
+	if err := validateEntropyBitSize(bitSize); err != nil {
+		return nil, err
+	}
+	entropy := make([]byte, bitSize/8)
+	if _, err := rand.Read(entropy); err != nil {
+		return nil, err
+	}
+	return entropy, nil
+}
+
+// NewMnemonic generates a random mnemonic of bitSize bits entropy,
+// 128 bits for 12 words up to 256 bits for 24 words
+func NewMnemonic(bitSize int) (string, error) {
+	entropy, err := NewEntropy(bitSize)
+	if err != nil {
+		return "", err
+	}
+	return NewMnemonicFromEntropy(entropy)
+}
+
+// NewMnemonicFromEntropy encodes the entropy and its checksum as mnemonic words
+func NewMnemonicFromEntropy(entropy []byte) (string, error) {
+	entropyBitSize := len(entropy) * 8
+	if err := validateEntropyBitSize(entropyBitSize); err != nil {
+		return "", err
+	}
+	checksumBitSize := entropyBitSize / 32
+	wordCount := (entropyBitSize + checksumBitSize) / 11
+
+	checksum := sha256.Sum256(entropy)
+	data := new(big.Int).SetBytes(entropy)
+	data.Lsh(data, uint(checksumBitSize))
+	data.Or(data, big.NewInt(int64(checksum[0]>>(8-checksumBitSize))))
+
+	words := make([]string, wordCount)
+	mask := big.NewInt(2047)
+	for i := wordCount - 1; i >= 0; i-- {
+		idx := new(big.Int).And(data, mask).Int64()
+		words[i] = wordList[idx]
+		data.Rsh(data, 11)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// MnemonicToEntropy decodes the mnemonic words and verifies the checksum
+func MnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	wordCount := len(words)
+	if wordCount < 12 || wordCount > 24 || wordCount%3 != 0 {
+		return nil, ErrInvalidMnemonic
+	}
+
+	data := new(big.Int)
+	for _, w := range words {
+		idx, ok := wordIndex[w]
+		if !ok {
+			return nil, fmt.Errorf("word %q not found in wordlist", w)
+		}
+		data.Lsh(data, 11)
+		data.Or(data, big.NewInt(int64(idx)))
+	}
+
+	checksumBitSize := wordCount / 3
+	entropyBitSize := wordCount*11 - checksumBitSize
+	checksum := new(big.Int).And(data, big.NewInt(int64(1<<checksumBitSize-1)))
+	data.Rsh(data, uint(checksumBitSize))
+
+	entropy := make([]byte, entropyBitSize/8)
+	data.FillBytes(entropy)
+
+	expected := sha256.Sum256(entropy)
+	if checksum.Int64() != int64(expected[0]>>(8-checksumBitSize)) {
+		return nil, ErrChecksumIncorrect
+	}
+	return entropy, nil
+}
+
+// IsMnemonicValid reports whether the mnemonic has valid words and checksum
+func IsMnemonicValid(mnemonic string) bool {
+	_, err := MnemonicToEntropy(mnemonic)
+	return err == nil
+}
+
+// NewSeed derives the 64 bytes BIP-39 seed from mnemonic and passphrase.
+// The mnemonic is not validated, see NewSeedWithErrorChecking.
+func NewSeed(mnemonic, passphrase string) []byte {
+	mnemonic = norm.NFKD.String(strings.Join(strings.Fields(mnemonic), " "))
+	salt := norm.NFKD.String("mnemonic" + passphrase)
+	return pbkdf2.Key([]byte(mnemonic), []byte(salt), 2048, 64, sha512.New)
+}
+
+// NewSeedWithErrorChecking validates the mnemonic before deriving the seed
+func NewSeedWithErrorChecking(mnemonic, passphrase string) ([]byte, error) {
+	if _, err := MnemonicToEntropy(mnemonic); err != nil {
+		return nil, err
+	}
+	return NewSeed(mnemonic, passphrase), nil
+}
+
+func validateEntropyBitSize(bitSize int) error {
+	if bitSize%32 != 0 || bitSize < 128 || bitSize > 256 {
+		return ErrInvalidEntropyLength
+	}
+	return nil
+}
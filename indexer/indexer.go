@@ -0,0 +1,300 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/chenzhijie/go-web3/eth"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	defaultPollInterval = 4 * time.Second
+	defaultReorgWindow  = 128
+)
+
+// Handler handles a decoded event. Events of blocks dropped by a reorg are delivered
+// again with Log.Removed set, newest first, so derived state can be rolled back.
+// Returning an error stops the indexer; events of the chunk being handled are delivered
+// again after a restart.
+type Handler func(event *eth.EventLog) error
+
+// Config is the config of an indexer
+type Config struct {
+	// Name keys the checkpoint in the store
+	Name     string
+	Contract *eth.Contract
+	// Events are the indexed event names, all events of the contract if empty
+	Events []string
+	// StartBlock is the first block of the backfill without checkpoint
+	StartBlock uint64
+	// Confirmations is the number of blocks kept behind the head
+	Confirmations uint64
+	// ReorgWindow is the number of recent blocks kept to detect reorgs and bounds the
+	// depth of the reorgs rolled back, default 128
+	ReorgWindow  uint64
+	PollInterval time.Duration
+	// Store persists the checkpoint, in memory if nil
+	Store    CheckpointStore
+	ScanOpts *eth.LogScanOpts
+}
+
+// Indexer backfills the events of a contract from a start block and then follows the head
+type Indexer struct {
+	e       *eth.Eth
+	cfg     Config
+	filter  *types.FilterQuery
+	handler Handler
+	cp      *Checkpoint
+}
+
+// New creates an indexer of the events of cfg.Contract
+func New(e *eth.Eth, cfg Config, handler Handler) (*Indexer, error) {
+	if cfg.Contract == nil {
+		return nil, errors.New("indexer contract is required")
+	}
+	if cfg.Name == "" {
+		cfg.Name = cfg.Contract.Address().Hex()
+	}
+	if cfg.ReorgWindow == 0 {
+		cfg.ReorgWindow = defaultReorgWindow
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+	if cfg.Store == nil {
+		cfg.Store = NewMemoryStore()
+	}
+
+	events := cfg.Events
+	if len(events) == 0 {
+		events = cfg.Contract.AllEvents()
+		sort.Strings(events)
+	}
+	ids := make([]common.Hash, 0, len(events))
+	for _, name := range events {
+		event := cfg.Contract.Event(name)
+		if event.Name == "" {
+			return nil, fmt.Errorf("event %v not found", name)
+		}
+		if !event.Anonymous {
+			ids = append(ids, event.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no events to index")
+	}
+
+	return &Indexer{
+		e:       e,
+		cfg:     cfg,
+		filter:  types.NewFilterQuery().SetAddresses(cfg.Contract.Address()).SetTopic(0, ids...),
+		handler: handler,
+	}, nil
+}
+
+// Run indexes until ctx is done or the handler fails
+func (ix *Indexer) Run(ctx context.Context) error {
+	for {
+		if err := ix.Step(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(ix.cfg.PollInterval):
+		}
+	}
+}
+
+// Checkpoint returns the next block to process
+func (ix *Indexer) Checkpoint() uint64 {
+	if ix.cp == nil {
+		return ix.cfg.StartBlock
+	}
+	return ix.cp.Next
+}
+
+// Step rolls back reorged blocks and processes the confirmed blocks after the checkpoint once
+func (ix *Indexer) Step(ctx context.Context) error {
+	if ix.cp == nil {
+		cp, err := ix.cfg.Store.Load(ix.cfg.Name)
+		if err != nil {
+			return err
+		}
+		if cp == nil {
+			cp = &Checkpoint{Next: ix.cfg.StartBlock}
+		}
+		ix.cp = cp
+	}
+
+	head, err := ix.e.GetBlockNumber()
+	if err != nil {
+		return err
+	}
+	if head < ix.cfg.Confirmations {
+		return nil
+	}
+	target := head - ix.cfg.Confirmations
+
+	if err := ix.checkReorg(); err != nil {
+		return err
+	}
+	if ix.cp.Next > target {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	from := ix.cp.Next
+	s := ix.e.ScanLogs(ctx, ix.filter, from, target, ix.cfg.ScanOpts)
+	for chunk := range s.Chunks() {
+		for _, log := range chunk.Logs {
+			if err := ix.handle(log); err != nil {
+				return err
+			}
+		}
+		ix.record(chunk.Logs, target)
+		ix.cp.Next = chunk.To + 1
+		if err := ix.save(); err != nil {
+			return err
+		}
+	}
+	if err := s.Err(); err != nil {
+		return err
+	}
+
+	hash, err := ix.e.GetBlockHash(new(big.Int).SetUint64(target))
+	if err != nil {
+		return err
+	}
+	if hash != nil {
+		ix.addRef(&BlockRef{Number: target, Hash: *hash})
+	}
+
+	// logs of this step may come from a fork replaced before the head was read, the
+	// blocks of the step without logs are not recorded so the whole step is rescanned
+	for _, ref := range ix.cp.Blocks {
+		if ref.Number < from {
+			continue
+		}
+		canonical, err := ix.canonical(ref)
+		if err != nil {
+			return err
+		}
+		if !canonical {
+			return ix.rollback(from)
+		}
+	}
+	return ix.save()
+}
+
+func (ix *Indexer) handle(log *types.Log) error {
+	event, err := ix.cfg.Contract.ParseLog(log)
+	if err != nil {
+		return fmt.Errorf("parse log %d of tx %v: %w", log.LogIndex, log.TransactionHash, err)
+	}
+	return ix.handler(event)
+}
+
+// record keeps the logs of blocks within the reorg window
+func (ix *Indexer) record(logs []*types.Log, target uint64) {
+	for _, log := range logs {
+		if uint64(log.BlockNumber)+ix.cfg.ReorgWindow <= target {
+			continue
+		}
+		n := len(ix.cp.Blocks)
+		if n > 0 && ix.cp.Blocks[n-1].Number == uint64(log.BlockNumber) {
+			ix.cp.Blocks[n-1].Logs = append(ix.cp.Blocks[n-1].Logs, log)
+			continue
+		}
+		ix.addRef(&BlockRef{Number: uint64(log.BlockNumber), Hash: log.BlockHash, Logs: []*types.Log{log}})
+	}
+	ix.prune(target)
+}
+
+func (ix *Indexer) addRef(ref *BlockRef) {
+	n := len(ix.cp.Blocks)
+	if n > 0 && ix.cp.Blocks[n-1].Number == ref.Number {
+		return
+	}
+	ix.cp.Blocks = append(ix.cp.Blocks, ref)
+}
+
+func (ix *Indexer) prune(target uint64) {
+	i := 0
+	for i < len(ix.cp.Blocks) && ix.cp.Blocks[i].Number+ix.cfg.ReorgWindow <= target {
+		i++
+	}
+	ix.cp.Blocks = ix.cp.Blocks[i:]
+}
+
+func (ix *Indexer) canonical(ref *BlockRef) (bool, error) {
+	hash, err := ix.e.GetBlockHash(new(big.Int).SetUint64(ref.Number))
+	if err != nil {
+		return false, err
+	}
+	return hash != nil && *hash == ref.Hash, nil
+}
+
+// checkReorg walks back the recent blocks until one is canonical and rolls back the others
+func (ix *Indexer) checkReorg() error {
+	dropped := -1
+	for i := len(ix.cp.Blocks) - 1; i >= 0; i-- {
+		canonical, err := ix.canonical(ix.cp.Blocks[i])
+		if err != nil {
+			return err
+		}
+		if canonical {
+			break
+		}
+		dropped = i
+	}
+	if dropped < 0 {
+		return nil
+	}
+	// blocks without logs are not recorded, rescan from the block after the canonical one
+	var number uint64
+	if dropped > 0 {
+		number = ix.cp.Blocks[dropped-1].Number + 1
+	} else {
+		// no known ancestor, rescan the whole window, deeper reorgs are not detected
+		number = ix.cfg.StartBlock
+		if ix.cp.Next > ix.cfg.StartBlock+ix.cfg.ReorgWindow {
+			number = ix.cp.Next - ix.cfg.ReorgWindow
+		}
+	}
+	return ix.rollback(number)
+}
+
+// rollback delivers the logs of blocks from number on as removed and rewinds the checkpoint
+func (ix *Indexer) rollback(number uint64) error {
+	i := len(ix.cp.Blocks)
+	for i > 0 && ix.cp.Blocks[i-1].Number >= number {
+		i--
+	}
+	for j := len(ix.cp.Blocks) - 1; j >= i; j-- {
+		logs := ix.cp.Blocks[j].Logs
+		for k := len(logs) - 1; k >= 0; k-- {
+			removed := *logs[k]
+			removed.Removed = true
+			if err := ix.handle(&removed); err != nil {
+				return err
+			}
+		}
+	}
+	ix.cp.Blocks = ix.cp.Blocks[:i]
+	if number < ix.cp.Next {
+		ix.cp.Next = number
+	}
+	return ix.save()
+}
+
+func (ix *Indexer) save() error {
+	return ix.cfg.Store.Save(ix.cfg.Name, ix.cp)
+}
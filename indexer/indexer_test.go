@@ -0,0 +1,239 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/eth"
+	"github.com/chenzhijie/go-web3/rpc"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const transferABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+var token = common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+// mockChain is a chain with a Transfer log of value n in some blocks n
+type mockChain struct {
+	lock   sync.Mutex
+	head   uint64
+	fork   byte
+	forkAt uint64
+	logs   map[uint64]bool
+}
+
+func (c *mockChain) hash(n uint64) common.Hash {
+	if n >= c.forkAt {
+		return common.Hash{c.fork, byte(n)}
+	}
+	return common.Hash{0, byte(n)}
+}
+
+func (c *mockChain) serve(method string, params []json.RawMessage) (interface{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	switch method {
+	case "eth_blockNumber":
+		return hexutil.Uint64(c.head), nil
+	case "eth_getBlockByNumber":
+		var n hexutil.Uint64
+		if err := json.Unmarshal(params[0], &n); err != nil {
+			return nil, err
+		}
+		if uint64(n) > c.head {
+			return nil, nil
+		}
+		return map[string]interface{}{"hash": c.hash(uint64(n))}, nil
+	case "eth_getLogs":
+		var filter struct {
+			FromBlock hexutil.Uint64 `json:"fromBlock"`
+			ToBlock   hexutil.Uint64 `json:"toBlock"`
+		}
+		if err := json.Unmarshal(params[0], &filter); err != nil {
+			return nil, err
+		}
+		logs := make([]*types.Log, 0)
+		for n := uint64(filter.FromBlock); n <= uint64(filter.ToBlock); n++ {
+			if !c.logs[n] {
+				continue
+			}
+			logs = append(logs, &types.Log{
+				Address:     token,
+				Topics:      []common.Hash{crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)")), {}, {}},
+				Data:        common.BigToHash(new(big.Int).SetUint64(n)).Bytes(),
+				BlockNumber: hexutil.Uint64(n),
+				BlockHash:   c.hash(n),
+			})
+		}
+		return logs, nil
+	}
+	return nil, nil
+}
+
+func newMockEth(t *testing.T, chain *mockChain) *eth.Eth {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if result, err := chain.serve(req.Method, req.Params); err != nil {
+			resp["error"] = map[string]interface{}{"code": -32000, "message": err.Error()}
+		} else {
+			resp["result"] = result
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(s.Close)
+
+	c, err := rpc.NewClient(s.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return eth.NewEth(c)
+}
+
+type delivered struct {
+	value   int64
+	removed bool
+}
+
+func TestIndexerReorg(t *testing.T) {
+	chain := &mockChain{head: 20, forkAt: 1000, logs: map[uint64]bool{5: true, 15: true, 17: true}}
+	e := newMockEth(t, chain)
+	contr, err := e.NewContract(transferABI, token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var events []delivered
+	handler := func(ev *eth.EventLog) error {
+		events = append(events, delivered{ev.Args["value"].(*big.Int).Int64(), ev.Log.Removed})
+		return nil
+	}
+	cfg := Config{Name: "transfers", Contract: contr, StartBlock: 1, Confirmations: 2, Store: store}
+	ix, err := New(e, cfg, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ix.Step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if ix.Checkpoint() != 19 || len(events) != 3 {
+		t.Fatalf("unexpected checkpoint %d events %v", ix.Checkpoint(), events)
+	}
+
+	// blocks from 17 on are replaced, the log of 17 moves to 19
+	chain.lock.Lock()
+	chain.head, chain.fork, chain.forkAt = 22, 1, 17
+	chain.logs = map[uint64]bool{5: true, 15: true, 19: true}
+	chain.lock.Unlock()
+
+	if err := ix.Step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want := []delivered{{5, false}, {15, false}, {17, false}, {17, true}, {19, false}}
+	if len(events) != len(want) {
+		t.Fatalf("unexpected events %v", events)
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Fatalf("unexpected events %v, want %v", events, want)
+		}
+	}
+	if ix.Checkpoint() != 21 {
+		t.Fatalf("unexpected checkpoint %d", ix.Checkpoint())
+	}
+
+	// a restarted indexer resumes from the stored checkpoint
+	ix, err = New(e, cfg, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ix.Step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if ix.Checkpoint() != 21 || len(events) != len(want) {
+		t.Fatalf("unexpected resume at %d with events %v", ix.Checkpoint(), events)
+	}
+}
+
+func TestIndexerReorgWithoutLogs(t *testing.T) {
+	chain := &mockChain{head: 20, forkAt: 1000, logs: map[uint64]bool{5: true, 15: true, 17: true}}
+	e := newMockEth(t, chain)
+	contr, err := e.NewContract(transferABI, token.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var events []delivered
+	handler := func(ev *eth.EventLog) error {
+		events = append(events, delivered{ev.Args["value"].(*big.Int).Int64(), ev.Log.Removed})
+		return nil
+	}
+	ix, err := New(e, Config{Contract: contr, StartBlock: 1, Confirmations: 2}, handler)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ix.Step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// blocks from 16 on are replaced, 16 had no log and gets one
+	chain.lock.Lock()
+	chain.head, chain.fork, chain.forkAt = 22, 1, 16
+	chain.logs = map[uint64]bool{5: true, 15: true, 16: true, 17: true}
+	chain.lock.Unlock()
+
+	if err := ix.Step(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	want := []delivered{{5, false}, {15, false}, {17, false}, {17, true}, {16, false}, {17, false}}
+	if len(events) != len(want) {
+		t.Fatalf("unexpected events %v, want %v", events, want)
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Fatalf("unexpected events %v, want %v", events, want)
+		}
+	}
+	if ix.Checkpoint() != 21 {
+		t.Fatalf("unexpected checkpoint %d", ix.Checkpoint())
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	s := NewMemoryStore()
+	if cp, err := s.Load("a"); err != nil || cp != nil {
+		t.Fatalf("unexpected checkpoint %v %v", cp, err)
+	}
+	cp := &Checkpoint{Next: 10, Blocks: []*BlockRef{{Number: 9, Hash: common.Hash{9}}}}
+	if err := s.Save("a", cp); err != nil {
+		t.Fatal(err)
+	}
+	cp.Blocks[0].Number = 1
+	loaded, err := s.Load("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Next != 10 || loaded.Blocks[0].Number != 9 {
+		t.Fatalf("unexpected checkpoint %+v", loaded)
+	}
+}
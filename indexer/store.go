@@ -0,0 +1,128 @@
+package indexer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BlockRef is a processed block kept to detect reorgs, with the logs delivered from it
+type BlockRef struct {
+	Number uint64       `json:"number"`
+	Hash   common.Hash  `json:"hash"`
+	Logs   []*types.Log `json:"logs,omitempty"`
+}
+
+// Checkpoint is the progress of an indexer
+type Checkpoint struct {
+	// Next is the next block to process
+	Next uint64 `json:"next"`
+	// Blocks are the recent processed blocks within the reorg window, oldest first
+	Blocks []*BlockRef `json:"blocks,omitempty"`
+}
+
+// CheckpointStore persists checkpoints by indexer name
+type CheckpointStore interface {
+	// Load returns nil without error if there is no checkpoint yet
+	Load(name string) (*Checkpoint, error)
+	Save(name string, cp *Checkpoint) error
+}
+
+// MemoryStore keeps checkpoints in memory
+type MemoryStore struct {
+	lock        sync.Mutex
+	checkpoints map[string][]byte
+}
+
+// NewMemoryStore creates an empty memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: make(map[string][]byte)}
+}
+
+func (s *MemoryStore) Load(name string) (*Checkpoint, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	data, ok := s.checkpoints[name]
+	if !ok {
+		return nil, nil
+	}
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, err
+	}
+	return &cp, nil
+}
+
+func (s *MemoryStore) Save(name string, cp *Checkpoint) error {
+	// stored encoded so callers can not mutate saved checkpoints
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.checkpoints[name] = data
+	return nil
+}
+
+// FileStore keeps a json file per checkpoint in a directory, written atomically
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore creates a file store in dir, creating it if needed
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(name string) string {
+	return filepath.Join(s.dir, name+".json")
+}
+
+func (s *FileStore) Load(name string) (*Checkpoint, error) {
+	data, err := os.ReadFile(s.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cp Checkpoint
+	if err := json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("decode checkpoint %s: %w", name, err)
+	}
+	return &cp, nil
+}
+
+func (s *FileStore) Save(name string, cp *Checkpoint) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(name))
+}
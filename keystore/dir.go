@@ -0,0 +1,206 @@
+package keystore
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chenzhijie/go-web3/eth"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// KeyStore is a directory of keystore v3 files, compatible with geth's keystore directory
+type KeyStore struct {
+	dir     string
+	scryptN int
+	scryptP int
+}
+
+// Create a directory-backed keystore, new keys are encrypted with scryptN and scryptP
+func NewKeyStore(dir string, scryptN, scryptP int) *KeyStore {
+	return &KeyStore{
+		dir:     dir,
+		scryptN: scryptN,
+		scryptP: scryptP,
+	}
+}
+
+// Accounts lists the addresses of all key files in the directory, sorted by address
+func (ks *KeyStore) Accounts() ([]common.Address, error) {
+	files, err := ks.keyFiles()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]common.Address, 0, len(files))
+	for addr := range files {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return strings.Compare(addrs[i].Hex(), addrs[j].Hex()) < 0
+	})
+	return addrs, nil
+}
+
+// HasAddress reports whether a key file for the address exists
+func (ks *KeyStore) HasAddress(addr common.Address) bool {
+	_, err := ks.find(addr)
+	return err == nil
+}
+
+// Unlock decrypts the key file of the address with password and returns its signer
+func (ks *KeyStore) Unlock(addr common.Address, password string) (*eth.LocalSigner, error) {
+	path, err := ks.find(addr)
+	if err != nil {
+		return nil, err
+	}
+	keyjson, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Decrypt(keyjson, password)
+}
+
+// NewAccount generates a new key and stores it encrypted with password
+func (ks *KeyStore) NewAccount(password string) (common.Address, error) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return ks.ImportECDSA(privateKey, password)
+}
+
+// ImportECDSA stores the private key encrypted with password
+func (ks *KeyStore) ImportECDSA(privateKey *ecdsa.PrivateKey, password string) (common.Address, error) {
+	addr := crypto.PubkeyToAddress(privateKey.PublicKey)
+	if ks.HasAddress(addr) {
+		return common.Address{}, fmt.Errorf("account %v already exists", addr)
+	}
+	keyjson, err := EncryptKey(privateKey, password, ks.scryptN, ks.scryptP)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if err := ks.writeKeyFile(filepath.Join(ks.dir, keyFileName(addr)), keyjson); err != nil {
+		return common.Address{}, err
+	}
+	return addr, nil
+}
+
+// Import stores a keystore json, re-encrypted with newPassword
+func (ks *KeyStore) Import(keyjson []byte, password, newPassword string) (common.Address, error) {
+	privateKey, err := DecryptKey(keyjson, password)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return ks.ImportECDSA(privateKey, newPassword)
+}
+
+// Export returns the key of the address as keystore json encrypted with newPassword
+func (ks *KeyStore) Export(addr common.Address, password, newPassword string) ([]byte, error) {
+	signer, err := ks.Unlock(addr, password)
+	if err != nil {
+		return nil, err
+	}
+	return Encrypt(signer, newPassword, ks.scryptN, ks.scryptP)
+}
+
+// ChangePassword re-encrypts the key file of the address with newPassword
+func (ks *KeyStore) ChangePassword(addr common.Address, password, newPassword string) error {
+	path, err := ks.find(addr)
+	if err != nil {
+		return err
+	}
+	keyjson, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	newKeyjson, err := ChangePassword(keyjson, password, newPassword, ks.scryptN, ks.scryptP)
+	if err != nil {
+		return err
+	}
+	return ks.writeKeyFile(path, newKeyjson)
+}
+
+// Delete removes the key file of the address if password is correct
+func (ks *KeyStore) Delete(addr common.Address, password string) error {
+	path, err := ks.find(addr)
+	if err != nil {
+		return err
+	}
+	if _, err := ks.Unlock(addr, password); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+func (ks *KeyStore) find(addr common.Address) (string, error) {
+	files, err := ks.keyFiles()
+	if err != nil {
+		return "", err
+	}
+	path, ok := files[addr]
+	if !ok {
+		return "", fmt.Errorf("no key for account %v", addr)
+	}
+	return path, nil
+}
+
+// keyFiles maps addresses to key file paths, skipping hidden and invalid files
+func (ks *KeyStore) keyFiles() (map[common.Address]string, error) {
+	entries, err := os.ReadDir(ks.dir)
+	if os.IsNotExist(err) {
+		return map[common.Address]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[common.Address]string)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+			continue
+		}
+		path := filepath.Join(ks.dir, name)
+		keyjson, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		addr, err := KeyAddress(keyjson)
+		if err != nil {
+			continue
+		}
+		files[addr] = path
+	}
+	return files, nil
+}
+
+// writeKeyFile writes the key file atomically through a temporary file
+func (ks *KeyStore) writeKeyFile(path string, content []byte) error {
+	if err := os.MkdirAll(ks.dir, 0700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(ks.dir, "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// keyFileName returns geth style file name UTC--<created_at UTC ISO8601>--<address hex>
+func keyFileName(addr common.Address) string {
+	ts := time.Now().UTC()
+	return fmt.Sprintf("UTC--%s--%x", ts.Format("2006-01-02T15-04-05.000000000Z"), addr[:])
+}
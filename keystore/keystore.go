@@ -0,0 +1,185 @@
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/chenzhijie/go-web3/eth"
+	eKeystore "github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+const (
+	version = 3
+
+	// StandardScryptN is the N parameter of Scrypt encryption algorithm, using 256MB
+	// memory and taking approximately 1s CPU time on a modern processor.
+	StandardScryptN = eKeystore.StandardScryptN
+	// StandardScryptP is the P parameter of Scrypt encryption algorithm, using 256MB
+	// memory and taking approximately 1s CPU time on a modern processor.
+	StandardScryptP = eKeystore.StandardScryptP
+
+	// LightScryptN is the N parameter of Scrypt encryption algorithm, using 4MB
+	// memory and taking approximately 100ms CPU time on a modern processor.
+	LightScryptN = eKeystore.LightScryptN
+	// LightScryptP is the P parameter of Scrypt encryption algorithm, using 4MB
+	// memory and taking approximately 100ms CPU time on a modern processor.
+	LightScryptP = eKeystore.LightScryptP
+)
+
+var (
+	ErrDecrypt = eKeystore.ErrDecrypt
+)
+
+// Decrypt decrypts a keystore v3 json with password and returns a local signer.
+// Both scrypt and pbkdf2 key derivation functions are supported.
+func Decrypt(keyjson []byte, password string) (*eth.LocalSigner, error) {
+	privateKey, err := DecryptKey(keyjson, password)
+	if err != nil {
+		return nil, err
+	}
+	return eth.NewLocalSigner(privateKey), nil
+}
+
+// DecryptKey decrypts a keystore v3 json with password and returns the private key
+func DecryptKey(keyjson []byte, password string) (*ecdsa.PrivateKey, error) {
+	var k struct {
+		Address string               `json:"address"`
+		Crypto  eKeystore.CryptoJSON `json:"crypto"`
+		Version int                  `json:"version"`
+	}
+	if err := json.Unmarshal(keyjson, &k); err != nil {
+		return nil, err
+	}
+	if k.Version != version {
+		return nil, fmt.Errorf("version not supported: %v", k.Version)
+	}
+	// go-ethereum does not check the params of the key file before using them
+	if err := checkParams(k.Crypto); err != nil {
+		return nil, err
+	}
+	key, err := eKeystore.DecryptKey(keyjson, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(k.Address) > 0 {
+		if !strings.EqualFold(strings.TrimPrefix(k.Address, "0x"), hex.EncodeToString(key.Address[:])) {
+			return nil, fmt.Errorf("key content mismatch: have account %x, want contents of %v", key.Address, k.Address)
+		}
+	}
+	return key.PrivateKey, nil
+}
+
+// Encrypt encrypts the private key of a local signer into keystore v3 json with scrypt
+func Encrypt(signer *eth.LocalSigner, password string, scryptN, scryptP int) ([]byte, error) {
+	return EncryptKey(signer.PrivateKey(), password, scryptN, scryptP)
+}
+
+// EncryptKey encrypts a private key into keystore v3 json with scrypt
+func EncryptKey(privateKey *ecdsa.PrivateKey, password string, scryptN, scryptP int) ([]byte, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	key := &eKeystore.Key{
+		Id:         id,
+		Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+		PrivateKey: privateKey,
+	}
+	return eKeystore.EncryptKey(key, password, scryptN, scryptP)
+}
+
+// NewKey generates a new private key and returns its signer and keystore v3 json
+func NewKey(password string, scryptN, scryptP int) (*eth.LocalSigner, []byte, error) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	keyjson, err := EncryptKey(privateKey, password, scryptN, scryptP)
+	if err != nil {
+		return nil, nil, err
+	}
+	return eth.NewLocalSigner(privateKey), keyjson, nil
+}
+
+// ChangePassword re-encrypts a keystore v3 json with a new password
+func ChangePassword(keyjson []byte, password, newPassword string, scryptN, scryptP int) ([]byte, error) {
+	privateKey, err := DecryptKey(keyjson, password)
+	if err != nil {
+		return nil, err
+	}
+	return EncryptKey(privateKey, newPassword, scryptN, scryptP)
+}
+
+// KeyAddress reads the address field of a keystore json without decrypting it
+func KeyAddress(keyjson []byte) (common.Address, error) {
+	var k struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(keyjson, &k); err != nil {
+		return common.Address{}, err
+	}
+	if !common.IsHexAddress(k.Address) {
+		return common.Address{}, fmt.Errorf("invalid address %q", k.Address)
+	}
+	return common.HexToAddress(k.Address), nil
+}
+
+// checkParams rejects the cipher and kdf params go-ethereum would panic on
+func checkParams(c eKeystore.CryptoJSON) error {
+	iv, err := hex.DecodeString(c.CipherParams.IV)
+	if err != nil {
+		return err
+	}
+	if len(iv) != aes.BlockSize {
+		return fmt.Errorf("invalid iv length: %d", len(iv))
+	}
+	dkLen, err := paramInt(c.KDFParams, "dklen")
+	if err != nil {
+		return err
+	}
+	// the mac and the aes key are read from the first 32 bytes
+	if dkLen < 32 {
+		return fmt.Errorf("invalid kdf param dklen: %d, want at least 32", dkLen)
+	}
+
+	switch c.KDF {
+	case "scrypt":
+		n, err := paramInt(c.KDFParams, "n")
+		if err != nil {
+			return err
+		}
+		if n < 2 || n&(n-1) != 0 {
+			return fmt.Errorf("invalid kdf param n: %d, want a power of two", n)
+		}
+		for _, name := range []string{"r", "p"} {
+			if _, err := paramInt(c.KDFParams, name); err != nil {
+				return err
+			}
+		}
+	case "pbkdf2":
+		if _, err := paramInt(c.KDFParams, "c"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paramInt reads a positive integer kdf param
+func paramInt(params map[string]interface{}, name string) (int, error) {
+	f, ok := params[name].(float64)
+	if !ok {
+		return 0, fmt.Errorf("missing kdf param %s", name)
+	}
+	if f <= 0 || f != float64(int(f)) || f > 1<<31 {
+		return 0, fmt.Errorf("invalid kdf param %s: %v", name, params[name])
+	}
+	return int(f), nil
+}
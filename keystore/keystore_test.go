@@ -0,0 +1,173 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Test vectors from https://ethereum.org/en/developers/docs/data-structures-and-encoding/web3-secret-storage/
+const (
+	testVectorPassword   = "testpassword"
+	testVectorPrivateKey = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+
+	testVectorPBKDF2 = `{
+		"crypto" : {
+			"cipher" : "aes-128-ctr",
+			"cipherparams" : {
+				"iv" : "6087dab2f9fdbbfaddc31a909735c1e6"
+			},
+			"ciphertext" : "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+			"kdf" : "pbkdf2",
+			"kdfparams" : {
+				"c" : 262144,
+				"dklen" : 32,
+				"prf" : "hmac-sha256",
+				"salt" : "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+			},
+			"mac" : "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+		},
+		"id" : "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+		"version" : 3
+	}`
+
+	testVectorScrypt = `{
+		"crypto" : {
+			"cipher" : "aes-128-ctr",
+			"cipherparams" : {
+				"iv" : "83dbcc02d8ccb40e466191a123791e0e"
+			},
+			"ciphertext" : "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c",
+			"kdf" : "scrypt",
+			"kdfparams" : {
+				"dklen" : 32,
+				"n" : 262144,
+				"r" : 1,
+				"p" : 8,
+				"salt" : "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"
+			},
+			"mac" : "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"
+		},
+		"id" : "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+		"version" : 3
+	}`
+)
+
+func TestDecryptTestVectors(t *testing.T) {
+	for name, keyjson := range map[string]string{
+		"pbkdf2": testVectorPBKDF2,
+		"scrypt": testVectorScrypt,
+	} {
+		privateKey, err := DecryptKey([]byte(keyjson), testVectorPassword)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if hex.EncodeToString(crypto.FromECDSA(privateKey)) != testVectorPrivateKey {
+			t.Fatalf("%s: unexpected private key %x", name, crypto.FromECDSA(privateKey))
+		}
+		if _, err := DecryptKey([]byte(keyjson), "wrong"); err != ErrDecrypt {
+			t.Fatalf("%s: expected ErrDecrypt, got %v", name, err)
+		}
+	}
+}
+
+func TestDecryptInvalidParams(t *testing.T) {
+	for name, replace := range map[string][2]string{
+		"short dklen":      {`"dklen" : 32`, `"dklen" : 16`},
+		"missing dklen":    {`"dklen" : 32,`, ``},
+		"string n":         {`"n" : 262144`, `"n" : "262144"`},
+		"n not power of 2": {`"n" : 262144`, `"n" : 1000`},
+		"zero r":           {`"r" : 1`, `"r" : 0`},
+		"negative p":       {`"p" : 8`, `"p" : -8`},
+		"short iv":         {`"iv" : "83dbcc02d8ccb40e466191a123791e0e"`, `"iv" : "83dbcc02"`},
+	} {
+		keyjson := strings.Replace(testVectorScrypt, replace[0], replace[1], 1)
+		if _, err := DecryptKey([]byte(keyjson), testVectorPassword); err == nil || err == ErrDecrypt {
+			t.Fatalf("%s: unexpected error %v", name, err)
+		}
+	}
+	keyjson := strings.Replace(testVectorPBKDF2, `"c" : 262144`, `"c" : 0`, 1)
+	if _, err := DecryptKey([]byte(keyjson), testVectorPassword); err == nil || err == ErrDecrypt {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestEncryptAndChangePassword(t *testing.T) {
+	signer, keyjson, err := NewKey("foo", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := KeyAddress(keyjson)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != signer.Address() {
+		t.Fatalf("address mismatch %v != %v", addr, signer.Address())
+	}
+
+	keyjson, err = ChangePassword(keyjson, "foo", "bar", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Decrypt(keyjson, "foo"); err != ErrDecrypt {
+		t.Fatalf("old password still works: %v", err)
+	}
+	decrypted, err := Decrypt(keyjson, "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decrypted.Address() != signer.Address() {
+		t.Fatalf("address mismatch %v != %v", decrypted.Address(), signer.Address())
+	}
+}
+
+func TestKeyStoreDir(t *testing.T) {
+	ks := NewKeyStore(t.TempDir(), LightScryptN, LightScryptP)
+
+	privateKey, err := crypto.HexToECDSA(testVectorPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := ks.ImportECDSA(privateKey, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ks.ImportECDSA(privateKey, "foo"); err == nil {
+		t.Fatal("expected duplicate import to fail")
+	}
+	created, err := ks.NewAccount("bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	accounts, err := ks.Accounts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(accounts) != 2 || !ks.HasAddress(imported) || !ks.HasAddress(created) {
+		t.Fatalf("unexpected accounts %v", accounts)
+	}
+
+	if err := ks.ChangePassword(imported, "foo", "baz"); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ks.Unlock(imported, "baz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.Address() != imported {
+		t.Fatalf("unlocked %v, expected %v", signer.Address(), imported)
+	}
+
+	if err := ks.Delete(created, "wrong"); err == nil {
+		t.Fatal("expected delete with wrong password to fail")
+	}
+	if err := ks.Delete(created, "bar"); err != nil {
+		t.Fatal(err)
+	}
+	if ks.HasAddress(created) {
+		t.Fatal("deleted account still listed")
+	}
+}
@@ -0,0 +1,351 @@
+package multicall
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/chenzhijie/go-web3/eth"
+	"github.com/chenzhijie/go-web3/rpc/codec"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Multicall3Address is the Multicall3 deployment, at the same address on most chains
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// DefaultMaxCalldataSize bounds the aggregate3 calldata of a batch
+const DefaultMaxCalldataSize = 64 * 1024
+
+// ErrEmptyResult is the error of successful calls without return data, e.g. to accounts
+// without code
+var ErrEmptyResult = errors.New("multicall: empty return data")
+
+// ErrPendingBatches is returned when calls read at the pending block take several batches,
+// the pending block can not be pinned as it has no number yet
+var ErrPendingBatches = errors.New("multicall: calls at the pending block do not fit in one batch")
+
+// Opts are the options of a Multicall, zero values use the defaults
+type Opts struct {
+	// Address is the Multicall3 contract, Multicall3Address if zero
+	Address common.Address
+	// MaxCalldataSize bounds the calldata of a batch, default DefaultMaxCalldataSize
+	MaxCalldataSize int
+	// MaxGas bounds the sum of the Gas hints of the calls of a batch, unbounded if zero
+	MaxGas uint64
+	// GasLimit is the gas of each aggregate3 eth_call, the node default if zero
+	GasLimit uint64
+	// Block is the block the calls read, the latest block if nil. Calls at the pending
+	// block must fit in one batch.
+	Block *types.BlockNumberOrHash
+	// BlockInfo returns the block number and timestamp of the results
+	BlockInfo bool
+}
+
+// Call is a call of a contract method. AllowFailure lets the call revert without failing
+// the batch. Gas is an optional estimate of the gas used by the call for batch splitting.
+type Call struct {
+	Contract     *eth.Contract
+	Method       string
+	Args         []interface{}
+	AllowFailure bool
+	Gas          uint64
+
+	data []byte
+}
+
+// Result is the result of a call. Values are decoded through the ABI of the call contract;
+// Err is the decoded *eth.RevertError of failed calls or the decoding error.
+type Result struct {
+	Call       *Call
+	Success    bool
+	ReturnData []byte
+	Values     []interface{}
+	Err        error
+}
+
+// Value returns the first decoded value, nil if the call failed
+func (r *Result) Value() interface{} {
+	if len(r.Values) == 0 {
+		return nil
+	}
+	return r.Values[0]
+}
+
+// Response is the results of Multicall.Execute in the order of the calls. BlockNumber and
+// Timestamp are the block the results were read at, set when requested with
+// Opts.BlockInfo or when the calls took several batches.
+type Response struct {
+	Results     []*Result
+	BlockNumber *big.Int
+	Timestamp   uint64
+}
+
+// Multicall collects calls of any contracts and executes them with Multicall3 aggregate3,
+// split into batches by calldata size and gas
+type Multicall struct {
+	e     *eth.Eth
+	contr *eth.Contract
+	opts  Opts
+	calls []*Call
+}
+
+// call3 and result3 are the aggregate3 tuples
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// New creates an empty multicall
+func New(e *eth.Eth, opts *Opts) (*Multicall, error) {
+	m := &Multicall{e: e}
+	if opts != nil {
+		m.opts = *opts
+	}
+	if m.opts.Address == (common.Address{}) {
+		m.opts.Address = Multicall3Address
+	}
+	if m.opts.MaxCalldataSize <= 0 {
+		m.opts.MaxCalldataSize = DefaultMaxCalldataSize
+	}
+	contr, err := e.NewContract(MULTICALL3_ABI, m.opts.Address.String())
+	if err != nil {
+		return nil, err
+	}
+	m.contr = contr
+	return m, nil
+}
+
+// Add adds a call that fails the batch when it reverts and returns its result index
+func (m *Multicall) Add(contract *eth.Contract, method string, args ...interface{}) (int, error) {
+	return m.AddCall(&Call{Contract: contract, Method: method, Args: args})
+}
+
+// TryAdd adds a call allowed to fail and returns its result index
+func (m *Multicall) TryAdd(contract *eth.Contract, method string, args ...interface{}) (int, error) {
+	return m.AddCall(&Call{Contract: contract, Method: method, Args: args, AllowFailure: true})
+}
+
+// AddCall adds a call and returns its result index, the call data is encoded immediately
+func (m *Multicall) AddCall(call *Call) (int, error) {
+	if call.Contract == nil {
+		return 0, errors.New("multicall: call without contract")
+	}
+	data, err := call.Contract.EncodeABI(call.Method, call.Args...)
+	if err != nil {
+		return 0, fmt.Errorf("encode %v: %w", call.Method, err)
+	}
+	call.data = data
+	m.calls = append(m.calls, call)
+	return len(m.calls) - 1, nil
+}
+
+// Len returns the number of calls
+func (m *Multicall) Len() int {
+	return len(m.calls)
+}
+
+// Reset removes the calls
+func (m *Multicall) Reset() {
+	m.calls = nil
+}
+
+// Execute executes the calls. Batches after the first one read the block of the first
+// batch, so all results come from the same block. Batches running out of gas are split
+// again. A reverted call without AllowFailure fails Execute, calls at the pending block
+// taking several batches fail with ErrPendingBatches.
+func (m *Multicall) Execute() (*Response, error) {
+	resp := &Response{Results: make([]*Result, len(m.calls))}
+	if len(m.calls) == 0 && !m.opts.BlockInfo {
+		return resp, nil
+	}
+
+	block := types.BlockNumberOrHashWithTag(types.LatestBlock)
+	if m.opts.Block != nil {
+		block = *m.opts.Block
+	}
+
+	pending := m.split(m.calls)
+	if block.Tag() == types.PendingBlock && len(pending) > 1 {
+		return nil, ErrPendingBatches
+	}
+	info := m.opts.BlockInfo || len(pending) > 1
+	done := 0
+	for len(pending) > 0 {
+		batch := pending[0]
+		withInfo := info && resp.BlockNumber == nil
+		results, err := m.aggregate(batch, block, withInfo)
+		if isSplitError(err) && len(batch) > 1 {
+			if block.Tag() == types.PendingBlock {
+				return nil, fmt.Errorf("%w: %v", ErrPendingBatches, err)
+			}
+			half := len(batch) / 2
+			pending = append([][]*Call{batch[:half], batch[half:]}, pending[1:]...)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if withInfo {
+			number, timestamp := results[0], results[1]
+			results = results[2:]
+			resp.BlockNumber = new(big.Int).SetBytes(number.ReturnData)
+			resp.Timestamp = new(big.Int).SetBytes(timestamp.ReturnData).Uint64()
+			switch block.Tag() {
+			case types.LatestBlock, types.SafeBlock, types.FinalizedBlock:
+				block = types.BlockNumberOrHashWithNumber(resp.BlockNumber)
+			}
+		}
+		for i, call := range batch {
+			resp.Results[done+i] = decodeResult(call, results[i])
+		}
+		done += len(batch)
+		pending = pending[1:]
+	}
+	return resp, nil
+}
+
+// split splits calls into batches by calldata size and gas hints
+func (m *Multicall) split(calls []*Call) [][]*Call {
+	var batches [][]*Call
+	var batch []*Call
+	var size int
+	var gas uint64
+	for _, call := range calls {
+		callSize := encodedSize(call.data)
+		full := len(batch) > 0 &&
+			(size+callSize > m.opts.MaxCalldataSize || m.opts.MaxGas > 0 && gas+call.Gas > m.opts.MaxGas)
+		if full {
+			batches = append(batches, batch)
+			batch, size, gas = nil, 0, 0
+		}
+		batch = append(batch, call)
+		size += callSize
+		gas += call.Gas
+	}
+	if len(batch) > 0 || len(batches) == 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// encodedSize is the aggregate3 calldata size of a call: its offset, the target,
+// allowFailure, the bytes offset and length, and the padded data
+func encodedSize(data []byte) int {
+	return 5*32 + (len(data)+31)/32*32
+}
+
+// aggregate calls aggregate3 with the calls of batch, preceded by the block number and
+// timestamp calls withInfo
+func (m *Multicall) aggregate(batch []*Call, block types.BlockNumberOrHash, withInfo bool) ([]result3, error) {
+	calls := make([]call3, 0, len(batch)+2)
+	if withInfo {
+		for _, method := range []string{"getBlockNumber", "getCurrentBlockTimestamp"} {
+			data, err := m.contr.EncodeABI(method)
+			if err != nil {
+				return nil, err
+			}
+			calls = append(calls, call3{Target: m.opts.Address, CallData: data})
+		}
+	}
+	for _, call := range batch {
+		calls = append(calls, call3{
+			Target:       call.Contract.Address(),
+			AllowFailure: call.AllowFailure,
+			CallData:     call.data,
+		})
+	}
+
+	data, err := m.contr.EncodeABI("aggregate3", calls)
+	if err != nil {
+		return nil, err
+	}
+	msg := &types.CallMsg{To: m.opts.Address, Data: data}
+	if m.opts.GasLimit > 0 {
+		msg.Gas = types.NewCallMsgBigInt(new(big.Int).SetUint64(m.opts.GasLimit))
+	}
+	out, err := m.e.CallAt(msg, block)
+	if err != nil {
+		return nil, m.wrapRevert(batch, err)
+	}
+	output, err := hexutil.Decode(out)
+	if err != nil {
+		return nil, err
+	}
+	if len(output) == 0 {
+		return nil, fmt.Errorf("multicall: no contract at %v", m.opts.Address)
+	}
+	ret, err := m.contr.Methods("aggregate3").Outputs.Unpack(output)
+	if err != nil {
+		return nil, err
+	}
+	var results []result3
+	if err := m.contr.Methods("aggregate3").Outputs.Copy(&results, ret); err != nil {
+		return nil, err
+	}
+	if len(results) != len(calls) {
+		return nil, fmt.Errorf("multicall: got %d results for %d calls", len(results), len(calls))
+	}
+	return results, nil
+}
+
+// wrapRevert explains a batch reverted by a call without AllowFailure
+func (m *Multicall) wrapRevert(batch []*Call, err error) error {
+	var rev *eth.RevertError
+	if !errors.As(err, &rev) {
+		return err
+	}
+	var required []string
+	for _, call := range batch {
+		if !call.AllowFailure {
+			required = append(required, call.Method)
+		}
+	}
+	return fmt.Errorf("multicall: batch reverted by one of the calls not allowed to fail %v: %w", required, err)
+}
+
+func decodeResult(call *Call, ret result3) *Result {
+	r := &Result{Call: call, Success: ret.Success, ReturnData: ret.ReturnData}
+	if !ret.Success {
+		r.Err = call.Contract.DecodeRevert(ret.ReturnData)
+		return r
+	}
+	method := call.Contract.Methods(call.Method)
+	if len(ret.ReturnData) == 0 && len(method.Outputs) > 0 {
+		r.Err = ErrEmptyResult
+		return r
+	}
+	r.Values, r.Err = method.Outputs.Unpack(ret.ReturnData)
+	return r
+}
+
+// isSplitError reports whether err is an out of gas or request size error a smaller
+// batch can avoid
+func isSplitError(err error) bool {
+	var rpcErr *codec.ErrorObject
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	msg := strings.ToLower(rpcErr.Message)
+	for _, s := range []string{
+		"out of gas",
+		"gas required exceeds",
+		"exceeds block gas limit",
+		"gas limit reached",
+		"too large",
+		"size exceeded",
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,332 @@
+package multicall
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/chenzhijie/go-web3/eth"
+	"github.com/chenzhijie/go-web3/rpc"
+	"github.com/chenzhijie/go-web3/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const tokenABI = `[{"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"fail","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+var owner = common.HexToAddress("0x9000000000000000000000000000000000000009")
+
+// mockMulticall serves aggregate3 over tokens whose balances are the last byte of their address
+type mockMulticall struct {
+	t        *testing.T
+	abi      abi.ABI
+	token    abi.ABI
+	maxCalls int
+
+	lock    sync.Mutex
+	batches []int
+	blocks  []string
+}
+
+func (m *mockMulticall) aggregate(data []byte, block string) (interface{}, *rpcError) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.blocks = append(m.blocks, block)
+
+	args, err := m.abi.Methods["aggregate3"].Inputs.Unpack(data[4:])
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	var calls []call3
+	if err := m.abi.Methods["aggregate3"].Inputs.Copy(&calls, args); err != nil {
+		m.t.Fatal(err)
+	}
+	m.batches = append(m.batches, len(calls))
+	if m.maxCalls > 0 && len(calls) > m.maxCalls {
+		return nil, &rpcError{Code: -32000, Message: "out of gas"}
+	}
+
+	results := make([]result3, len(calls))
+	for i, call := range calls {
+		switch {
+		case call.Target == Multicall3Address:
+			value := big.NewInt(100)
+			if string(call.CallData[:4]) == string(m.abi.Methods["getCurrentBlockTimestamp"].ID) {
+				value = big.NewInt(1700000000)
+			}
+			results[i] = result3{true, common.BigToHash(value).Bytes()}
+		case string(call.CallData[:4]) == string(m.token.Methods["fail"].ID):
+			revert := append(crypto.Keccak256([]byte("Error(string)"))[:4], mustPack(m.t, "nope")...)
+			if !call.AllowFailure {
+				return nil, &rpcError{Code: 3, Message: "execution reverted: Multicall3: call failed", Data: "0x"}
+			}
+			results[i] = result3{false, revert}
+		default:
+			results[i] = result3{true, common.BigToHash(big.NewInt(int64(call.Target[19]))).Bytes()}
+		}
+	}
+	out, err := m.abi.Methods["aggregate3"].Outputs.Pack(results)
+	if err != nil {
+		m.t.Fatal(err)
+	}
+	return hexutil.Bytes(out), nil
+}
+
+func mustPack(t *testing.T, reason string) []byte {
+	ty, _ := abi.NewType("string", "", nil)
+	data, err := abi.Arguments{{Type: ty}}.Pack(reason)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func newMockMulticall(t *testing.T) (*mockMulticall, *eth.Eth) {
+	m := &mockMulticall{t: t}
+	var err error
+	if m.abi, err = abi.JSON(strings.NewReader(MULTICALL3_ABI)); err != nil {
+		t.Fatal(err)
+	}
+	if m.token, err = abi.JSON(strings.NewReader(tokenABI)); err != nil {
+		t.Fatal(err)
+	}
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		var msg struct {
+			To   common.Address `json:"to"`
+			Data hexutil.Bytes  `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_call" {
+			http.Error(w, "unexpected request", http.StatusBadRequest)
+			return
+		}
+		if err := json.Unmarshal(req.Params[0], &msg); err != nil || msg.To != Multicall3Address {
+			http.Error(w, "unexpected call", http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if result, rpcErr := m.aggregate(msg.Data, string(req.Params[1])); rpcErr != nil {
+			resp["error"] = rpcErr
+		} else {
+			resp["result"] = result
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(s.Close)
+
+	c, err := rpc.NewClient(s.URL, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m, eth.NewEth(c)
+}
+
+func newTokens(t *testing.T, e *eth.Eth, n int) []*eth.Contract {
+	tokens := make([]*eth.Contract, n)
+	for i := range tokens {
+		contr, err := e.NewContract(tokenABI, common.BigToAddress(big.NewInt(int64(0x1000+i))).String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens[i] = contr
+	}
+	return tokens
+}
+
+func TestMulticall(t *testing.T) {
+	mock, e := newMockMulticall(t)
+	tokens := newTokens(t, e, 3)
+
+	m, err := New(e, &Opts{BlockInfo: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range tokens {
+		if _, err := m.Add(token, "balanceOf", owner); err != nil {
+			t.Fatal(err)
+		}
+	}
+	failing, err := m.TryAdd(tokens[0], "fail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Add(tokens[0], "balanceOf"); err == nil {
+		t.Fatal("expected encoding error")
+	}
+
+	resp, err := m.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.BlockNumber.Int64() != 100 || resp.Timestamp != 1700000000 || len(resp.Results) != 4 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	for i := range tokens {
+		r := resp.Results[i]
+		if !r.Success || r.Err != nil || r.Value().(*big.Int).Int64() != int64(i) {
+			t.Fatalf("unexpected result %d %+v", i, r)
+		}
+	}
+	var rev *eth.RevertError
+	if r := resp.Results[failing]; r.Success || !errors.As(r.Err, &rev) || rev.Reason != "nope" {
+		t.Fatalf("unexpected failed result %+v", r)
+	}
+	if len(mock.batches) != 1 || mock.batches[0] != 6 {
+		t.Fatalf("unexpected batches %v", mock.batches)
+	}
+
+	// a reverting call not allowed to fail reverts the batch
+	m.Reset()
+	if _, err := m.Add(tokens[1], "fail"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Execute(); !errors.As(err, &rev) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestMulticallSplit(t *testing.T) {
+	mock, e := newMockMulticall(t)
+	tokens := newTokens(t, e, 10)
+
+	// 36 bytes of calldata per call take 224 bytes of aggregate3 calldata
+	m, err := New(e, &Opts{MaxCalldataSize: 4 * 224})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range tokens {
+		if _, err := m.AddCall(&Call{Contract: token, Method: "balanceOf", Args: []interface{}{owner}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := m.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range resp.Results {
+		if r.Value().(*big.Int).Int64() != int64(i) {
+			t.Fatalf("unexpected result %d %+v", i, r)
+		}
+	}
+	// the first batch also reads the block the other batches are pinned to
+	if strings.Join(mock.blocks, " ") != `"latest" "0x64" "0x64"` || resp.BlockNumber.Int64() != 100 {
+		t.Fatalf("unexpected blocks %v", mock.blocks)
+	}
+	if len(mock.batches) != 3 || mock.batches[0] != 6 || mock.batches[1] != 4 || mock.batches[2] != 2 {
+		t.Fatalf("unexpected batches %v", mock.batches)
+	}
+
+	// gas hints and out of gas errors split batches
+	mock.batches, mock.blocks, mock.maxCalls = nil, nil, 3
+	block := types.BlockNumberOrHashWithNumber(big.NewInt(50))
+	m, err = New(e, &Opts{MaxGas: 100000, Block: &block})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range tokens {
+		if _, err := m.AddCall(&Call{Contract: token, Method: "balanceOf", Args: []interface{}{owner}, Gas: 25000}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if resp, err = m.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	for i, r := range resp.Results {
+		if r.Value().(*big.Int).Int64() != int64(i) {
+			t.Fatalf("unexpected result %d %+v", i, r)
+		}
+	}
+	// batches of 4 calls by gas, bisected down to 3 calls with the block info calls
+	if strings.Join(mock.blocks, " ") != strings.TrimSpace(strings.Repeat(`"0x32" `, len(mock.batches))) ||
+		len(mock.batches) != 9 || mock.batches[0] != 6 || mock.batches[5] != 4 {
+		t.Fatalf("unexpected batches %v at %v", mock.batches, mock.blocks)
+	}
+}
+
+func TestMulticallPending(t *testing.T) {
+	mock, e := newMockMulticall(t)
+	tokens := newTokens(t, e, 10)
+	block := types.BlockNumberOrHashWithTag(types.PendingBlock)
+
+	// the pending block is read by the single batch
+	m, err := New(e, &Opts{Block: &block, BlockInfo: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range tokens {
+		if _, err := m.Add(token, "balanceOf", owner); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(mock.blocks, " ") != `"pending"` {
+		t.Fatalf("unexpected blocks %v", mock.blocks)
+	}
+
+	// several batches would read different pending blocks
+	mock.blocks = nil
+	m, err = New(e, &Opts{Block: &block, MaxCalldataSize: 4 * 224})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range tokens {
+		if _, err := m.Add(token, "balanceOf", owner); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.Execute(); !errors.Is(err, ErrPendingBatches) || len(mock.blocks) != 0 {
+		t.Fatalf("unexpected error %v after %v", err, mock.blocks)
+	}
+	mock.maxCalls = 3
+	m, err = New(e, &Opts{Block: &block})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range tokens {
+		if _, err := m.Add(token, "balanceOf", owner); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.Execute(); !errors.Is(err, ErrPendingBatches) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// safe blocks are pinned like the latest block
+	mock.blocks, mock.maxCalls = nil, 0
+	safe := types.BlockNumberOrHashWithTag(types.SafeBlock)
+	m, err = New(e, &Opts{Block: &safe, MaxCalldataSize: 4 * 224})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, token := range tokens {
+		if _, err := m.Add(token, "balanceOf", owner); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := m.Execute(); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(mock.blocks, " ") != `"safe" "0x64" "0x64"` {
+		t.Fatalf("unexpected blocks %v", mock.blocks)
+	}
+}
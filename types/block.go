@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/chenzhijie/go-web3/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlockTag is a named block of the block parameter
+type BlockTag string
+
+const (
+	LatestBlock    BlockTag = "latest"
+	PendingBlock   BlockTag = "pending"
+	EarliestBlock  BlockTag = "earliest"
+	SafeBlock      BlockTag = "safe"
+	FinalizedBlock BlockTag = "finalized"
+)
+
+// BlockNumberOrHash is the block parameter of block-scoped methods: a tag, a number,
+// or a hash with requireCanonical (EIP-1898). The zero value is the latest block.
+type BlockNumberOrHash struct {
+	tag              BlockTag
+	number           *big.Int
+	hash             *common.Hash
+	requireCanonical bool
+}
+
+// BlockNumberOrHashWithTag selects a block by tag
+func BlockNumberOrHashWithTag(tag BlockTag) BlockNumberOrHash {
+	return BlockNumberOrHash{tag: tag}
+}
+
+// BlockNumberOrHashWithNumber selects a block by number, nil is the latest block
+// and -1 the pending block like utils.ToBlockNumArg
+func BlockNumberOrHashWithNumber(number *big.Int) BlockNumberOrHash {
+	switch {
+	case number == nil:
+		return BlockNumberOrHash{tag: LatestBlock}
+	case number.Cmp(big.NewInt(-1)) == 0:
+		return BlockNumberOrHash{tag: PendingBlock}
+	}
+	return BlockNumberOrHash{number: new(big.Int).Set(number)}
+}
+
+// BlockNumberOrHashWithHash selects a block by hash, with requireCanonical the node
+// fails if the block is not in the canonical chain
+func BlockNumberOrHashWithHash(hash common.Hash, requireCanonical bool) BlockNumberOrHash {
+	return BlockNumberOrHash{hash: &hash, requireCanonical: requireCanonical}
+}
+
+// Tag returns the tag of the block, empty if selected by number or hash
+func (b BlockNumberOrHash) Tag() BlockTag {
+	if b.number == nil && b.hash == nil && b.tag == "" {
+		return LatestBlock
+	}
+	return b.tag
+}
+
+// Number returns the number of blocks selected by number
+func (b BlockNumberOrHash) Number() (*big.Int, bool) {
+	if b.number == nil {
+		return nil, false
+	}
+	return new(big.Int).Set(b.number), true
+}
+
+// Hash returns the hash of blocks selected by hash
+func (b BlockNumberOrHash) Hash() (common.Hash, bool) {
+	if b.hash == nil {
+		return common.Hash{}, false
+	}
+	return *b.hash, true
+}
+
+// RequireCanonical reports whether a block selected by hash must be canonical
+func (b BlockNumberOrHash) RequireCanonical() bool {
+	return b.requireCanonical
+}
+
+func (b BlockNumberOrHash) String() string {
+	switch {
+	case b.hash != nil:
+		return b.hash.Hex()
+	case b.number != nil:
+		return utils.ToBlockNumArg(b.number)
+	}
+	return string(b.Tag())
+}
+
+func (b BlockNumberOrHash) MarshalJSON() ([]byte, error) {
+	if b.hash != nil {
+		return json.Marshal(struct {
+			BlockHash        common.Hash `json:"blockHash"`
+			RequireCanonical bool        `json:"requireCanonical,omitempty"`
+		}{*b.hash, b.requireCanonical})
+	}
+	if b.number != nil {
+		return json.Marshal(hexutil.EncodeBig(b.number))
+	}
+	return json.Marshal(b.Tag())
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestBlockNumberOrHashJSON(t *testing.T) {
+	hash := common.HexToHash("0x01")
+	cases := []struct {
+		block BlockNumberOrHash
+		want  string
+	}{
+		{BlockNumberOrHash{}, `"latest"`},
+		{BlockNumberOrHashWithTag(FinalizedBlock), `"finalized"`},
+		{BlockNumberOrHashWithNumber(nil), `"latest"`},
+		{BlockNumberOrHashWithNumber(big.NewInt(-1)), `"pending"`},
+		{BlockNumberOrHashWithNumber(big.NewInt(16)), `"0x10"`},
+		{BlockNumberOrHashWithHash(hash, false), `{"blockHash":"` + hash.Hex() + `"}`},
+		{BlockNumberOrHashWithHash(hash, true), `{"blockHash":"` + hash.Hex() + `","requireCanonical":true}`},
+	}
+	for _, c := range cases {
+		out, err := json.Marshal(c.block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != c.want {
+			t.Fatalf("marshal %v: got %s, want %s", c.block, out, c.want)
+		}
+	}
+}
+
+func TestFilterQuerySetBlocks(t *testing.T) {
+	q := NewFilterQuery()
+	if err := q.SetBlocks(BlockNumberOrHashWithNumber(big.NewInt(1)), BlockNumberOrHashWithTag(FinalizedBlock)); err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"fromBlock":"0x1","toBlock":"finalized"}` {
+		t.Fatalf("unexpected filter %s", out)
+	}
+
+	hash := common.HexToHash("0x02")
+	// ranges ending at a block hash are rejected
+	if err := q.SetBlocks(BlockNumberOrHashWithNumber(big.NewInt(1)), BlockNumberOrHashWithHash(hash, true)); err == nil {
+		t.Fatal("expected error for a range ending at a hash")
+	}
+	if err := q.SetBlocks(BlockNumberOrHashWithHash(hash, true), BlockNumberOrHashWithTag(FinalizedBlock)); err == nil {
+		t.Fatal("expected error for a block hash with a range end")
+	}
+	if q.ToTag != FinalizedBlock {
+		t.Fatalf("filter changed on error %+v", q)
+	}
+
+	if err := q.SetBlocks(BlockNumberOrHashWithHash(hash, true), BlockNumberOrHash{}); err != nil {
+		t.Fatal(err)
+	}
+	if q.BlockHash == nil || *q.BlockHash != hash || q.FromBlock != nil || q.ToTag != "" {
+		t.Fatalf("unexpected filter %+v", q)
+	}
+
+	q.FromTag = SafeBlock
+	if _, err := json.Marshal(q); err == nil {
+		t.Fatal("expected error for tags with blockHash")
+	}
+}
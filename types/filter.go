@@ -0,0 +1,147 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+
+	"github.com/chenzhijie/go-web3/utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FilterQuery is the full eth_getLogs filter.
+// Logs of any of Addresses match, all addresses if empty. Topics[i] matches any of its
+// hashes and an empty position matches any topic. BlockHash is exclusive with the block range.
+// FromTag and ToTag select the range bounds by tag, e.g. up to the finalized block, when
+// FromBlock and ToBlock are nil.
+type FilterQuery struct {
+	BlockHash *common.Hash
+	FromBlock *big.Int
+	ToBlock   *big.Int
+	FromTag   BlockTag
+	ToTag     BlockTag
+	Addresses []common.Address
+	Topics    [][]common.Hash
+}
+
+// NewFilterQuery creates a filter matching every log of the latest block
+func NewFilterQuery() *FilterQuery {
+	return &FilterQuery{}
+}
+
+// SetAddresses matches logs emitted by any of addrs
+func (q *FilterQuery) SetAddresses(addrs ...common.Address) *FilterQuery {
+	q.Addresses = addrs
+	return q
+}
+
+// AddAddress adds addr to the matched addresses
+func (q *FilterQuery) AddAddress(addr common.Address) *FilterQuery {
+	q.Addresses = append(q.Addresses, addr)
+	return q
+}
+
+// SetBlockRange matches logs of blocks [from, to], nil means latest
+func (q *FilterQuery) SetBlockRange(from, to *big.Int) *FilterQuery {
+	q.BlockHash = nil
+	q.FromTag, q.ToTag = "", ""
+	q.FromBlock, q.ToBlock = from, to
+	return q
+}
+
+// SetBlocks matches logs of blocks [from, to] selected by number or tag.
+// A from block selected by hash matches the logs of that block only, to must then be
+// the same hash or the zero value. eth_getLogs has no range ending at a block hash,
+// so any other to selected by hash is an error and q is left unchanged.
+func (q *FilterQuery) SetBlocks(from, to BlockNumberOrHash) error {
+	toHash, toIsHash := to.Hash()
+	if hash, ok := from.Hash(); ok {
+		if toIsHash && toHash != hash || !toIsHash && to != (BlockNumberOrHash{}) {
+			return errors.New("filter of a block hash can not have a range end")
+		}
+		q.SetBlockHash(hash)
+		return nil
+	}
+	if toIsHash {
+		return errors.New("filter range can not end at a block hash")
+	}
+	q.SetBlockRange(nil, nil)
+	if number, ok := from.Number(); ok {
+		q.FromBlock = number
+	} else {
+		q.FromTag = from.Tag()
+	}
+	if number, ok := to.Number(); ok {
+		q.ToBlock = number
+	} else {
+		q.ToTag = to.Tag()
+	}
+	return nil
+}
+
+// SetBlockHash matches logs of a single block
+func (q *FilterQuery) SetBlockHash(hash common.Hash) *FilterQuery {
+	q.FromBlock, q.ToBlock = nil, nil
+	q.FromTag, q.ToTag = "", ""
+	q.BlockHash = &hash
+	return q
+}
+
+// SetTopic matches logs with any of hashes at position, no hashes is a wildcard
+func (q *FilterQuery) SetTopic(position int, hashes ...common.Hash) *FilterQuery {
+	for len(q.Topics) <= position {
+		q.Topics = append(q.Topics, nil)
+	}
+	q.Topics[position] = hashes
+	return q
+}
+
+func (q *FilterQuery) MarshalJSON() ([]byte, error) {
+	arg := map[string]interface{}{}
+	if q.BlockHash != nil {
+		if q.FromBlock != nil || q.ToBlock != nil || q.FromTag != "" || q.ToTag != "" {
+			return nil, errors.New("filter with blockHash can not have a block range")
+		}
+		arg["blockHash"] = *q.BlockHash
+	} else {
+		if q.FromBlock != nil {
+			arg["fromBlock"] = utils.ToBlockNumArg(q.FromBlock)
+		} else if q.FromTag != "" {
+			arg["fromBlock"] = q.FromTag
+		}
+		if q.ToBlock != nil {
+			arg["toBlock"] = utils.ToBlockNumArg(q.ToBlock)
+		} else if q.ToTag != "" {
+			arg["toBlock"] = q.ToTag
+		}
+	}
+
+	switch len(q.Addresses) {
+	case 0:
+	case 1:
+		arg["address"] = q.Addresses[0]
+	default:
+		arg["address"] = q.Addresses
+	}
+
+	// trailing wildcards are dropped, inner ones are null
+	topics := q.Topics
+	for len(topics) > 0 && len(topics[len(topics)-1]) == 0 {
+		topics = topics[:len(topics)-1]
+	}
+	if len(topics) > 0 {
+		out := make([]interface{}, len(topics))
+		for i, position := range topics {
+			switch len(position) {
+			case 0:
+				out[i] = nil
+			case 1:
+				out[i] = position[0]
+			default:
+				out[i] = position
+			}
+		}
+		arg["topics"] = out
+	}
+	return json.Marshal(arg)
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestFilterQueryJSON(t *testing.T) {
+	a, b := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	t0, t1, t2 := common.Hash{1}, common.Hash{2}, common.Hash{3}
+
+	q := NewFilterQuery().
+		SetAddresses(a, b).
+		SetBlockRange(big.NewInt(16), nil).
+		SetTopic(0, t0).
+		SetTopic(2, t1, t2).
+		SetTopic(4)
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	topics := got["topics"].([]interface{})
+	if got["fromBlock"] != "0x10" || got["toBlock"] != nil || len(got["address"].([]interface{})) != 2 {
+		t.Fatalf("unexpected filter %s", data)
+	}
+	if len(topics) != 3 || topics[0] != t0.Hex() || topics[1] != nil || len(topics[2].([]interface{})) != 2 {
+		t.Fatalf("unexpected topics %s", data)
+	}
+
+	data, err = json.Marshal(NewFilterQuery().AddAddress(a).SetBlockHash(t0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"address":"0x0000000000000000000000000000000000000001","blockHash":"0x0100000000000000000000000000000000000000000000000000000000000000"}` {
+		t.Fatalf("unexpected filter %s", data)
+	}
+
+	q = NewFilterQuery().SetBlockHash(t0)
+	q.FromBlock = big.NewInt(1)
+	if _, err := json.Marshal(q); err == nil {
+		t.Fatal("expected error for blockHash with block range")
+	}
+}
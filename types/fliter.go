@@ -19,12 +19,15 @@ type Fliter struct {
 }
 
 type Event struct {
-	Address         common.Address `json:"address"`
-	BlockHash       common.Hash    `json:"blockHash"`
-	BlockNumber     string         `json:"blockNumber"`
-	Topics          []string       `json:"topics,omitempty"`
-	TransactionHash common.Hash    `json:"transactionHash"`
-	Data            string         `json:"data,omitempty"`
+	Address          common.Address `json:"address"`
+	BlockHash        common.Hash    `json:"blockHash"`
+	BlockNumber      string         `json:"blockNumber"`
+	Topics           []string       `json:"topics,omitempty"`
+	TransactionHash  common.Hash    `json:"transactionHash"`
+	TransactionIndex string         `json:"transactionIndex,omitempty"`
+	LogIndex         string         `json:"logIndex,omitempty"`
+	Removed          bool           `json:"removed,omitempty"`
+	Data             string         `json:"data,omitempty"`
 }
 
 type EventData struct {
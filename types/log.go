@@ -0,0 +1,77 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Log is a log returned by eth_getLogs with every field of the node.
+// BlockTimestamp is only returned by recent nodes.
+type Log struct {
+	Address          common.Address  `json:"address"`
+	Topics           []common.Hash   `json:"topics"`
+	Data             hexutil.Bytes   `json:"data"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+	BlockHash        common.Hash     `json:"blockHash"`
+	BlockTimestamp   *hexutil.Uint64 `json:"blockTimestamp,omitempty"`
+	TransactionHash  common.Hash     `json:"transactionHash"`
+	TransactionIndex hexutil.Uint    `json:"transactionIndex"`
+	LogIndex         hexutil.Uint    `json:"logIndex"`
+	Removed          bool            `json:"removed"`
+}
+
+// NewLog converts a go-ethereum log, e.g. of a receipt
+func NewLog(l *eTypes.Log) *Log {
+	return &Log{
+		Address:          l.Address,
+		Topics:           l.Topics,
+		Data:             l.Data,
+		BlockNumber:      hexutil.Uint64(l.BlockNumber),
+		BlockHash:        l.BlockHash,
+		TransactionHash:  l.TxHash,
+		TransactionIndex: hexutil.Uint(l.TxIndex),
+		LogIndex:         hexutil.Uint(l.Index),
+		Removed:          l.Removed,
+	}
+}
+
+// Log converts the event to a typed log
+func (e *Event) Log() (*Log, error) {
+	l := &Log{
+		Address:         e.Address,
+		BlockHash:       e.BlockHash,
+		TransactionHash: e.TransactionHash,
+		Removed:         e.Removed,
+		Topics:          make([]common.Hash, len(e.Topics)),
+	}
+	for i, topic := range e.Topics {
+		b, err := hexutil.Decode(topic)
+		if err != nil {
+			return nil, err
+		}
+		l.Topics[i] = common.BytesToHash(b)
+	}
+	var err error
+	if e.Data != "" {
+		if l.Data, err = hexutil.Decode(e.Data); err != nil {
+			return nil, err
+		}
+	}
+	if e.BlockNumber != "" {
+		if err := l.BlockNumber.UnmarshalText([]byte(e.BlockNumber)); err != nil {
+			return nil, err
+		}
+	}
+	if e.TransactionIndex != "" {
+		if err := l.TransactionIndex.UnmarshalText([]byte(e.TransactionIndex)); err != nil {
+			return nil, err
+		}
+	}
+	if e.LogIndex != "" {
+		if err := l.LogIndex.UnmarshalText([]byte(e.LogIndex)); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
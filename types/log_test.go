@@ -0,0 +1,42 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestEventLog(t *testing.T) {
+	raw := `{
+		"address": "0x2000000000000000000000000000000000000002",
+		"topics": ["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],
+		"data": "0x2a",
+		"blockNumber": "0x10",
+		"blockHash": "0x0100000000000000000000000000000000000000000000000000000000000000",
+		"transactionHash": "0x0200000000000000000000000000000000000000000000000000000000000000",
+		"transactionIndex": "0x2",
+		"logIndex": "0x5",
+		"removed": true
+	}`
+	var event Event
+	if err := json.Unmarshal([]byte(raw), &event); err != nil {
+		t.Fatal(err)
+	}
+	var want Log
+	if err := json.Unmarshal([]byte(raw), &want); err != nil {
+		t.Fatal(err)
+	}
+	l, err := event.Log()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := json.Marshal(l)
+	expected, _ := json.Marshal(&want)
+	if string(got) != string(expected) {
+		t.Fatalf("unexpected log %s, want %s", got, expected)
+	}
+	if !l.Removed || l.LogIndex != 5 || l.TransactionIndex != 2 || l.BlockNumber != 16 || l.Topics[0] != common.HexToHash(event.Topics[0]) {
+		t.Fatalf("unexpected log %+v", l)
+	}
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// OverrideAccount overrides the state of an account in eth_call.
+// State replaces the whole storage, StateDiff only the given slots; they are exclusive.
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64             `json:"nonce,omitempty"`
+	Code      *hexutil.Bytes              `json:"code,omitempty"`
+	Balance   *hexutil.Big                `json:"balance,omitempty"`
+	State     map[common.Hash]common.Hash `json:"state,omitempty"`
+	StateDiff map[common.Hash]common.Hash `json:"stateDiff,omitempty"`
+}
+
+// StateOverride is the state override set of eth_call
+type StateOverride map[common.Address]*OverrideAccount
+
+func (s StateOverride) account(addr common.Address) *OverrideAccount {
+	acc, ok := s[addr]
+	if !ok {
+		acc = &OverrideAccount{}
+		s[addr] = acc
+	}
+	return acc
+}
+
+// SetBalance overrides the balance of addr
+func (s StateOverride) SetBalance(addr common.Address, balance *big.Int) StateOverride {
+	s.account(addr).Balance = (*hexutil.Big)(new(big.Int).Set(balance))
+	return s
+}
+
+// SetNonce overrides the nonce of addr
+func (s StateOverride) SetNonce(addr common.Address, nonce uint64) StateOverride {
+	n := hexutil.Uint64(nonce)
+	s.account(addr).Nonce = &n
+	return s
+}
+
+// SetCode overrides the code of addr, e.g. to replace a contract implementation
+func (s StateOverride) SetCode(addr common.Address, code []byte) StateOverride {
+	c := hexutil.Bytes(common.CopyBytes(code))
+	s.account(addr).Code = &c
+	return s
+}
+
+// SetState sets a slot of the storage replacing the whole storage of addr
+func (s StateOverride) SetState(addr common.Address, slot, value common.Hash) StateOverride {
+	acc := s.account(addr)
+	if acc.State == nil {
+		acc.State = make(map[common.Hash]common.Hash)
+	}
+	acc.State[slot] = value
+	return s
+}
+
+// SetStateDiff overrides a single storage slot of addr keeping the rest of the storage
+func (s StateOverride) SetStateDiff(addr common.Address, slot, value common.Hash) StateOverride {
+	acc := s.account(addr)
+	if acc.StateDiff == nil {
+		acc.StateDiff = make(map[common.Hash]common.Hash)
+	}
+	acc.StateDiff[slot] = value
+	return s
+}
+
+// BlockOverrides overrides the block context of eth_call and eth_simulateV1
+type BlockOverrides struct {
+	Number      *hexutil.Big    `json:"number,omitempty"`
+	Time        *hexutil.Uint64 `json:"time,omitempty"`
+	GasLimit    *hexutil.Uint64 `json:"gasLimit,omitempty"`
+	Coinbase    *common.Address `json:"feeRecipient,omitempty"`
+	Random      *common.Hash    `json:"prevRandao,omitempty"`
+	BaseFee     *hexutil.Big    `json:"baseFeePerGas,omitempty"`
+	BlobBaseFee *hexutil.Big    `json:"blobBaseFee,omitempty"`
+}
+
+// MappingSlot returns the storage slot of mapping[key] for a Solidity mapping declared
+// at slot, e.g. MappingSlot(holder.Bytes(), 0) for balanceOf of many ERC20 tokens
+func MappingSlot(key []byte, slot uint64) common.Hash {
+	return crypto.Keccak256Hash(
+		common.LeftPadBytes(key, 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(slot).Bytes(), 32),
+	)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestStateOverrideJSON(t *testing.T) {
+	addr := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	override := StateOverride{}
+	override.SetBalance(addr, big.NewInt(16)).
+		SetNonce(addr, 2).
+		SetCode(addr, []byte{0x60, 0x00}).
+		SetStateDiff(addr, common.Hash{1}, common.Hash{2})
+
+	data, err := json.Marshal(override)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"0x1000000000000000000000000000000000000001":{"nonce":"0x2","code":"0x6000","balance":"0x10","stateDiff":{"0x0100000000000000000000000000000000000000000000000000000000000000":"0x0200000000000000000000000000000000000000000000000000000000000000"}}}`
+	if string(data) != expected {
+		t.Fatalf("unexpected json %s", data)
+	}
+}
+
+func TestMappingSlot(t *testing.T) {
+	// keccak256(abi.encode(address(0x01), uint256(0)))
+	slot := MappingSlot(common.HexToAddress("0x01").Bytes(), 0)
+	if slot.Hex() != "0xada5013122d395ba3c54772283fb069b10426056ef8ca54750cb9bb552a59e7d" {
+		t.Fatalf("unexpected slot %s", slot.Hex())
+	}
+}
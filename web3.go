@@ -1,47 +1,71 @@
 package web3
 
 import (
-	"strings"
+	"fmt"
 
+	"github.com/chenzhijie/go-web3/chains"
+	"github.com/chenzhijie/go-web3/debug"
 	"github.com/chenzhijie/go-web3/eth"
 	"github.com/chenzhijie/go-web3/rpc"
 	"github.com/chenzhijie/go-web3/utils"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type Web3 struct {
 	Eth   *eth.Eth
+	Debug *debug.Debug
 	Utils *utils.Utils
 	c     *rpc.Client
 }
 
-func NewWeb3(provider string) (*Web3, error) {
-	return NewWeb3WithProxy(provider, "")
+type options struct {
+	chainId *int64
 }
 
-func NewWeb3WithProxy(provider, proxy string) (*Web3, error) {
+// Option configures NewWeb3
+type Option func(*options)
+
+// WithChainId sets the chain id explicitly instead of querying eth_chainId
+func WithChainId(chainId int64) Option {
+	return func(o *options) {
+		o.chainId = &chainId
+	}
+}
+
+// NewWeb3 connects to provider, the chain id is queried with eth_chainId unless WithChainId is given
+func NewWeb3(provider string, opts ...Option) (*Web3, error) {
+	return NewWeb3WithProxy(provider, "", opts...)
+}
+
+func NewWeb3WithProxy(provider, proxy string, opts ...Option) (*Web3, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
 	c, err := rpc.NewClient(provider, proxy)
 	if err != nil {
 		return nil, err
 	}
 	e := eth.NewEth(c)
 
-	providerLowerStr := strings.ToLower(provider)
-
-	if strings.Contains(providerLowerStr, "ropsten") {
-		e.SetChainId(3)
-	} else if strings.Contains(providerLowerStr, "kovan") {
-		e.SetChainId(42)
-	} else if strings.Contains(providerLowerStr, "rinkeby") {
-		e.SetChainId(4)
-	} else if strings.Contains(providerLowerStr, "goerli") {
-		e.SetChainId(5)
+	if o.chainId != nil {
+		e.SetChainId(*o.chainId)
 	} else {
-		e.SetChainId(1)
+		chainId, err := e.ChainID()
+		if err != nil {
+			return nil, fmt.Errorf("query chain id failed, use WithChainId to set it explicitly: %v", err)
+		}
+		if !chainId.IsInt64() {
+			return nil, fmt.Errorf("unsupported chain id %v", chainId)
+		}
+		e.SetChainId(chainId.Int64())
 	}
 
 	u := utils.NewUtils()
 	w := &Web3{
 		Eth:   e,
+		Debug: debug.NewDebug(c),
 		Utils: u,
 		c:     c,
 	}
@@ -56,3 +80,27 @@ func (w *Web3) Version() (string, error) {
 	err := w.c.Call("web3_clientVersion", &out)
 	return out, err
 }
+
+// Chain returns the registry metadata of the connected chain
+func (w *Web3) Chain() (chains.Chain, bool) {
+	chainId := w.Eth.GetChainId()
+	if chainId == nil || !chainId.IsInt64() {
+		return chains.Chain{}, false
+	}
+	return chains.Get(chainId.Int64())
+}
+
+// WithAccount returns a view of Web3 whose default account is addr,
+// see eth.Eth.WithAccount. Apps created from the view send from addr.
+func (w *Web3) WithAccount(addr common.Address) (*Web3, error) {
+	e, err := w.Eth.WithAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+	return &Web3{
+		Eth:   e,
+		Debug: w.Debug,
+		Utils: w.Utils,
+		c:     w.c,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package web3
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func newChainIdServer(t *testing.T, chainId string, calls *int32) *httptest.Server {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     uint64 `json:"id"`
+			Method string `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.Method == "eth_chainId" {
+			atomic.AddInt32(calls, 1)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  chainId,
+		})
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestNewWeb3QueriesChainId(t *testing.T) {
+	var calls int32
+	s := newChainIdServer(t, "0x38", &calls)
+
+	w, err := NewWeb3(s.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Eth.GetChainId().Int64() != 56 {
+		t.Fatalf("unexpected chain id %v", w.Eth.GetChainId())
+	}
+	chain, ok := w.Chain()
+	if !ok || chain.NativeCurrency.Symbol != "BNB" {
+		t.Fatalf("unexpected chain %+v", chain)
+	}
+
+	w, err = NewWeb3(s.URL, WithChainId(137))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Eth.GetChainId().Int64() != 137 {
+		t.Fatalf("unexpected chain id %v", w.Eth.GetChainId())
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("expected eth_chainId to be queried once, got %d", calls)
+	}
+}